@@ -297,6 +297,18 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		// When replaying VCR cassettes no AWS API calls are made, so the shared provider is configured
+		// with fake credentials and without calling AWS. Tests that are replayed must use ProviderMeta
+		// for checks that call AWS.
+		if isVCRReplaying() {
+			diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(vcrReplayProviderConfig()))
+			if err := sdkdiag.DiagnosticsError(diags); err != nil {
+				t.Fatalf("configuring provider: %s", err)
+			}
+
+			return
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
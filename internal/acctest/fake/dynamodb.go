@@ -0,0 +1,749 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// DynamoDB is a fake of the Amazon DynamoDB API. Tables and indexes are active as soon as they're created,
+// and streams, global tables and backups aren't supported.
+type DynamoDB struct {
+	jsonService
+
+	tables *store[*dynamoDBTable]
+}
+
+type dynamoDBTable struct {
+	mu                        sync.Mutex
+	name                      string
+	arn                       string
+	id                        string
+	createdDate               time.Time
+	attributeDefinitions      []json.RawMessage
+	keySchema                 []dynamoDBKeySchemaElement
+	billingMode               string
+	provisionedThroughput     dynamoDBProvisionedThroughput
+	globalSecondaryIndexes    []map[string]any
+	localSecondaryIndexes     []map[string]any
+	tableClass                string
+	deletionProtectionEnabled bool
+	pointInTimeRecovery       bool
+	ttlAttributeName          string
+	ttlEnabled                bool
+	tags                      map[string]string
+	// items are keyed by the JSON encoding of their primary key.
+	items map[string]map[string]json.RawMessage
+}
+
+type dynamoDBKeySchemaElement struct {
+	AttributeName string `json:"AttributeName"`
+	KeyType       string `json:"KeyType"`
+}
+
+type dynamoDBProvisionedThroughput struct {
+	ReadCapacityUnits  int64 `json:"ReadCapacityUnits"`
+	WriteCapacityUnits int64 `json:"WriteCapacityUnits"`
+}
+
+// NewDynamoDB returns a fake of the Amazon DynamoDB API.
+func NewDynamoDB() *DynamoDB {
+	s := &DynamoDB{
+		tables: newStore[*dynamoDBTable](),
+	}
+	s.jsonService = jsonService{
+		targetPrefix: "DynamoDB_20120810",
+		operations: map[string]jsonHandler{
+			"BatchGetItem":              jsonOperation(s.batchGetItem),
+			"BatchWriteItem":            jsonOperation(s.batchWriteItem),
+			"CreateTable":               jsonOperation(s.createTable),
+			"DeleteItem":                jsonOperation(s.deleteItem),
+			"DeleteTable":               jsonOperation(s.deleteTable),
+			"DescribeContinuousBackups": jsonOperation(s.describeContinuousBackups),
+			"DescribeTable":             jsonOperation(s.describeTable),
+			"DescribeTimeToLive":        jsonOperation(s.describeTimeToLive),
+			"GetItem":                   jsonOperation(s.getItem),
+			"ListTables":                jsonOperation(s.listTables),
+			"ListTagsOfResource":        jsonOperation(s.listTagsOfResource),
+			"PutItem":                   jsonOperation(s.putItem),
+			"Scan":                      jsonOperation(s.scan),
+			"TagResource":               jsonOperation(s.tagResource),
+			"UntagResource":             jsonOperation(s.untagResource),
+			"UpdateContinuousBackups":   jsonOperation(s.updateContinuousBackups),
+			"UpdateTable":               jsonOperation(s.updateTable),
+			"UpdateTimeToLive":          jsonOperation(s.updateTimeToLive),
+		},
+	}
+
+	return s
+}
+
+func (s *DynamoDB) EndpointID() string {
+	return names.DynamoDB
+}
+
+func errDynamoDBResourceNotFound(format string, a ...any) error {
+	return newAPIError(http.StatusBadRequest, "ResourceNotFoundException", format, a...)
+}
+
+// table returns the table with the specified name or ARN.
+func (s *DynamoDB) table(nameOrARN string) (*dynamoDBTable, error) {
+	name := nameOrARN
+	if strings.HasPrefix(nameOrARN, "arn:") {
+		name = nameOrARN[strings.LastIndex(nameOrARN, "/")+1:]
+	}
+
+	t, ok := s.tables.get(name)
+	if !ok {
+		return nil, errDynamoDBResourceNotFound("Requested resource not found: Table: %s not found", name)
+	}
+
+	return t, nil
+}
+
+// key returns the item's primary key. The caller must hold the table's lock.
+func (t *dynamoDBTable) key(item map[string]json.RawMessage) (string, error) {
+	key := make(map[string]json.RawMessage, len(t.keySchema))
+
+	for _, v := range t.keySchema {
+		value, ok := item[v.AttributeName]
+		if !ok {
+			return "", errValidation("One of the required keys was not given a value")
+		}
+		key[v.AttributeName] = value
+	}
+
+	// Map keys are marshaled in sorted order.
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+type dynamoDBTableDescription struct {
+	AttributeDefinitions      []json.RawMessage          `json:"AttributeDefinitions"`
+	BillingModeSummary        map[string]any             `json:"BillingModeSummary"`
+	CreationDateTime          float64                    `json:"CreationDateTime"`
+	DeletionProtectionEnabled bool                       `json:"DeletionProtectionEnabled"`
+	GlobalSecondaryIndexes    []map[string]any           `json:"GlobalSecondaryIndexes,omitempty"`
+	ItemCount                 int                        `json:"ItemCount"`
+	KeySchema                 []dynamoDBKeySchemaElement `json:"KeySchema"`
+	LocalSecondaryIndexes     []map[string]any           `json:"LocalSecondaryIndexes,omitempty"`
+	ProvisionedThroughput     map[string]any             `json:"ProvisionedThroughput"`
+	TableARN                  string                     `json:"TableArn"`
+	TableClassSummary         map[string]string          `json:"TableClassSummary,omitempty"`
+	TableID                   string                     `json:"TableId"`
+	TableName                 string                     `json:"TableName"`
+	TableSizeBytes            int                        `json:"TableSizeBytes"`
+	TableStatus               string                     `json:"TableStatus"`
+}
+
+// describe returns the table's description. The caller must hold the table's lock.
+func (t *dynamoDBTable) describe(status string) *dynamoDBTableDescription {
+	return &dynamoDBTableDescription{
+		AttributeDefinitions:      t.attributeDefinitions,
+		BillingModeSummary:        map[string]any{"BillingMode": t.billingMode},
+		CreationDateTime:          epochSeconds(t.createdDate),
+		DeletionProtectionEnabled: t.deletionProtectionEnabled,
+		GlobalSecondaryIndexes:    t.globalSecondaryIndexes,
+		ItemCount:                 len(t.items),
+		KeySchema:                 t.keySchema,
+		LocalSecondaryIndexes:     t.localSecondaryIndexes,
+		ProvisionedThroughput: map[string]any{
+			"NumberOfDecreasesToday": 0,
+			"ReadCapacityUnits":      t.provisionedThroughput.ReadCapacityUnits,
+			"WriteCapacityUnits":     t.provisionedThroughput.WriteCapacityUnits,
+		},
+		TableARN:          t.arn,
+		TableClassSummary: map[string]string{"TableClass": t.tableClass},
+		TableID:           t.id,
+		TableName:         t.name,
+		TableStatus:       status,
+	}
+}
+
+// indexes returns the descriptions of the secondary indexes in a request. The caller must hold the table's lock.
+func (t *dynamoDBTable) indexes(input []map[string]any, global bool) []map[string]any {
+	var indexes []map[string]any
+
+	for _, v := range input {
+		index := maps.Clone(v)
+		name, _ := index["IndexName"].(string)
+		index["IndexArn"] = t.arn + "/index/" + name
+		index["IndexSizeBytes"] = 0
+		index["ItemCount"] = 0
+		if global {
+			index["IndexStatus"] = "ACTIVE"
+			if _, ok := index["ProvisionedThroughput"]; !ok {
+				index["ProvisionedThroughput"] = map[string]any{"ReadCapacityUnits": 0, "WriteCapacityUnits": 0}
+			}
+		}
+		indexes = append(indexes, index)
+	}
+
+	return indexes
+}
+
+type dynamoDBCreateTableInput struct {
+	AttributeDefinitions      []json.RawMessage              `json:"AttributeDefinitions"`
+	BillingMode               string                         `json:"BillingMode"`
+	DeletionProtectionEnabled bool                           `json:"DeletionProtectionEnabled"`
+	GlobalSecondaryIndexes    []map[string]any               `json:"GlobalSecondaryIndexes"`
+	KeySchema                 []dynamoDBKeySchemaElement     `json:"KeySchema"`
+	LocalSecondaryIndexes     []map[string]any               `json:"LocalSecondaryIndexes"`
+	ProvisionedThroughput     *dynamoDBProvisionedThroughput `json:"ProvisionedThroughput"`
+	TableClass                string                         `json:"TableClass"`
+	TableName                 string                         `json:"TableName"`
+	Tags                      []jsonTag                      `json:"Tags"`
+}
+
+type dynamoDBTableDescriptionOutput struct {
+	TableDescription *dynamoDBTableDescription `json:"TableDescription"`
+}
+
+func (s *DynamoDB) createTable(input *dynamoDBCreateTableInput) (*dynamoDBTableDescriptionOutput, error) {
+	if input.TableName == "" || len(input.KeySchema) == 0 {
+		return nil, errValidation("TableName and KeySchema are required")
+	}
+
+	t := &dynamoDBTable{
+		name:                      input.TableName,
+		arn:                       arn("dynamodb", Region, "table/"+input.TableName),
+		id:                        newRequestID(),
+		createdDate:               now(),
+		attributeDefinitions:      input.AttributeDefinitions,
+		keySchema:                 input.KeySchema,
+		billingMode:               input.BillingMode,
+		deletionProtectionEnabled: input.DeletionProtectionEnabled,
+		tableClass:                input.TableClass,
+		tags:                      tagsFromJSON(input.Tags),
+		items:                     make(map[string]map[string]json.RawMessage),
+	}
+	if t.billingMode == "" {
+		t.billingMode = "PROVISIONED"
+	}
+	if t.tableClass == "" {
+		t.tableClass = "STANDARD"
+	}
+	if input.ProvisionedThroughput != nil {
+		t.provisionedThroughput = *input.ProvisionedThroughput
+	} else if t.billingMode == "PROVISIONED" {
+		return nil, errValidation("One or more parameter values were invalid: ReadCapacityUnits and WriteCapacityUnits must both be specified when BillingMode is PROVISIONED")
+	}
+	t.globalSecondaryIndexes = t.indexes(input.GlobalSecondaryIndexes, true)
+	t.localSecondaryIndexes = t.indexes(input.LocalSecondaryIndexes, false)
+
+	if !s.tables.create(t.name, t) {
+		return nil, newAPIError(http.StatusBadRequest, "ResourceInUseException", "Table already exists: %s", input.TableName)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return &dynamoDBTableDescriptionOutput{TableDescription: t.describe("ACTIVE")}, nil
+}
+
+type dynamoDBTableNameInput struct {
+	TableName string `json:"TableName"`
+}
+
+type dynamoDBDescribeTableOutput struct {
+	Table *dynamoDBTableDescription `json:"Table"`
+}
+
+func (s *DynamoDB) describeTable(input *dynamoDBTableNameInput) (*dynamoDBDescribeTableOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return &dynamoDBDescribeTableOutput{Table: t.describe("ACTIVE")}, nil
+}
+
+type dynamoDBUpdateTableInput struct {
+	AttributeDefinitions        []json.RawMessage              `json:"AttributeDefinitions"`
+	BillingMode                 string                         `json:"BillingMode"`
+	DeletionProtectionEnabled   *bool                          `json:"DeletionProtectionEnabled"`
+	GlobalSecondaryIndexUpdates []map[string]map[string]any    `json:"GlobalSecondaryIndexUpdates"`
+	ProvisionedThroughput       *dynamoDBProvisionedThroughput `json:"ProvisionedThroughput"`
+	TableClass                  string                         `json:"TableClass"`
+	TableName                   string                         `json:"TableName"`
+}
+
+func (s *DynamoDB) updateTable(input *dynamoDBUpdateTableInput) (*dynamoDBTableDescriptionOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(input.AttributeDefinitions) > 0 {
+		t.attributeDefinitions = input.AttributeDefinitions
+	}
+	if input.BillingMode != "" {
+		t.billingMode = input.BillingMode
+	}
+	if input.DeletionProtectionEnabled != nil {
+		t.deletionProtectionEnabled = *input.DeletionProtectionEnabled
+	}
+	if input.ProvisionedThroughput != nil {
+		t.provisionedThroughput = *input.ProvisionedThroughput
+	}
+	if input.TableClass != "" {
+		t.tableClass = input.TableClass
+	}
+
+	for _, update := range input.GlobalSecondaryIndexUpdates {
+		if v, ok := update["Create"]; ok {
+			t.globalSecondaryIndexes = append(t.globalSecondaryIndexes, t.indexes([]map[string]any{v}, true)...)
+		}
+		if v, ok := update["Delete"]; ok {
+			t.globalSecondaryIndexes = slices.DeleteFunc(t.globalSecondaryIndexes, func(index map[string]any) bool {
+				return index["IndexName"] == v["IndexName"]
+			})
+		}
+		if v, ok := update["Update"]; ok {
+			for _, index := range t.globalSecondaryIndexes {
+				if index["IndexName"] == v["IndexName"] {
+					maps.Copy(index, v)
+				}
+			}
+		}
+	}
+
+	return &dynamoDBTableDescriptionOutput{TableDescription: t.describe("ACTIVE")}, nil
+}
+
+// deleteTable deletes the table immediately.
+func (s *DynamoDB) deleteTable(input *dynamoDBTableNameInput) (*dynamoDBTableDescriptionOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.deletionProtectionEnabled {
+		return nil, errValidation("Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")
+	}
+
+	s.tables.delete(t.name)
+
+	return &dynamoDBTableDescriptionOutput{TableDescription: t.describe("DELETING")}, nil
+}
+
+type dynamoDBListTablesOutput struct {
+	TableNames []string `json:"TableNames"`
+}
+
+func (s *DynamoDB) listTables(*struct{}) (*dynamoDBListTablesOutput, error) {
+	output := &dynamoDBListTablesOutput{
+		TableNames: []string{},
+	}
+
+	for _, t := range s.tables.list(nil) {
+		output.TableNames = append(output.TableNames, t.name)
+	}
+
+	return output, nil
+}
+
+type dynamoDBResourceInput struct {
+	ResourceARN string    `json:"ResourceArn"`
+	TagKeys     []string  `json:"TagKeys"`
+	Tags        []jsonTag `json:"Tags"`
+}
+
+type dynamoDBListTagsOfResourceOutput struct {
+	Tags []jsonTag `json:"Tags"`
+}
+
+type dynamoDBEmptyOutput struct{}
+
+func (s *DynamoDB) listTagsOfResource(input *dynamoDBResourceInput) (*dynamoDBListTagsOfResourceOutput, error) {
+	t, err := s.table(input.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return &dynamoDBListTagsOfResourceOutput{Tags: jsonTags(t.tags)}, nil
+}
+
+func (s *DynamoDB) tagResource(input *dynamoDBResourceInput) (*dynamoDBEmptyOutput, error) {
+	t, err := s.table(input.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = mergeTags(t.tags, tagsFromJSON(input.Tags), nil)
+
+	return &dynamoDBEmptyOutput{}, nil
+}
+
+func (s *DynamoDB) untagResource(input *dynamoDBResourceInput) (*dynamoDBEmptyOutput, error) {
+	t, err := s.table(input.ResourceARN)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = mergeTags(t.tags, nil, input.TagKeys)
+
+	return &dynamoDBEmptyOutput{}, nil
+}
+
+type dynamoDBContinuousBackupsOutput struct {
+	ContinuousBackupsDescription map[string]any `json:"ContinuousBackupsDescription"`
+}
+
+// continuousBackups returns the table's continuous backups description. The caller must hold the table's lock.
+func (t *dynamoDBTable) continuousBackups() *dynamoDBContinuousBackupsOutput {
+	status := "DISABLED"
+	if t.pointInTimeRecovery {
+		status = "ENABLED"
+	}
+
+	return &dynamoDBContinuousBackupsOutput{
+		ContinuousBackupsDescription: map[string]any{
+			"ContinuousBackupsStatus": "ENABLED",
+			"PointInTimeRecoveryDescription": map[string]any{
+				"PointInTimeRecoveryStatus": status,
+			},
+		},
+	}
+}
+
+func (s *DynamoDB) describeContinuousBackups(input *dynamoDBTableNameInput) (*dynamoDBContinuousBackupsOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, errDynamoDBResourceNotFound("Table not found: %s", input.TableName)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.continuousBackups(), nil
+}
+
+type dynamoDBUpdateContinuousBackupsInput struct {
+	PointInTimeRecoverySpecification struct {
+		PointInTimeRecoveryEnabled bool `json:"PointInTimeRecoveryEnabled"`
+	} `json:"PointInTimeRecoverySpecification"`
+	TableName string `json:"TableName"`
+}
+
+func (s *DynamoDB) updateContinuousBackups(input *dynamoDBUpdateContinuousBackupsInput) (*dynamoDBContinuousBackupsOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, errDynamoDBResourceNotFound("Table not found: %s", input.TableName)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pointInTimeRecovery = input.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled
+
+	return t.continuousBackups(), nil
+}
+
+type dynamoDBTimeToLiveSpecification struct {
+	AttributeName string `json:"AttributeName"`
+	Enabled       bool   `json:"Enabled"`
+}
+
+type dynamoDBDescribeTimeToLiveOutput struct {
+	TimeToLiveDescription map[string]string `json:"TimeToLiveDescription"`
+}
+
+func (s *DynamoDB) describeTimeToLive(input *dynamoDBTableNameInput) (*dynamoDBDescribeTimeToLiveOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	description := map[string]string{
+		"TimeToLiveStatus": "DISABLED",
+	}
+	if t.ttlEnabled {
+		description["AttributeName"] = t.ttlAttributeName
+		description["TimeToLiveStatus"] = "ENABLED"
+	}
+
+	return &dynamoDBDescribeTimeToLiveOutput{TimeToLiveDescription: description}, nil
+}
+
+type dynamoDBUpdateTimeToLiveInput struct {
+	TableName               string                          `json:"TableName"`
+	TimeToLiveSpecification dynamoDBTimeToLiveSpecification `json:"TimeToLiveSpecification"`
+}
+
+type dynamoDBUpdateTimeToLiveOutput struct {
+	TimeToLiveSpecification dynamoDBTimeToLiveSpecification `json:"TimeToLiveSpecification"`
+}
+
+func (s *DynamoDB) updateTimeToLive(input *dynamoDBUpdateTimeToLiveInput) (*dynamoDBUpdateTimeToLiveOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.ttlEnabled == input.TimeToLiveSpecification.Enabled {
+		return nil, errValidation("TimeToLive is already %t", t.ttlEnabled)
+	}
+	t.ttlEnabled = input.TimeToLiveSpecification.Enabled
+	t.ttlAttributeName = input.TimeToLiveSpecification.AttributeName
+
+	return &dynamoDBUpdateTimeToLiveOutput{TimeToLiveSpecification: input.TimeToLiveSpecification}, nil
+}
+
+type dynamoDBItemInput struct {
+	ConditionExpression string                     `json:"ConditionExpression"`
+	Item                map[string]json.RawMessage `json:"Item"`
+	Key                 map[string]json.RawMessage `json:"Key"`
+	TableName           string                     `json:"TableName"`
+}
+
+type dynamoDBItemOutput struct {
+	Item map[string]json.RawMessage `json:"Item,omitempty"`
+}
+
+// putItem stores the item. The only condition expression supported is attribute_not_exists on a key attribute.
+func (s *DynamoDB) putItem(input *dynamoDBItemInput) (*dynamoDBItemOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key, err := t.key(input.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := t.items[key]; ok && strings.HasPrefix(input.ConditionExpression, "attribute_not_exists(") {
+		return nil, newAPIError(http.StatusBadRequest, "ConditionalCheckFailedException", "The conditional request failed")
+	}
+	t.items[key] = input.Item
+
+	return &dynamoDBItemOutput{}, nil
+}
+
+func (s *DynamoDB) getItem(input *dynamoDBItemInput) (*dynamoDBItemOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key, err := t.key(input.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamoDBItemOutput{Item: t.items[key]}, nil
+}
+
+func (s *DynamoDB) deleteItem(input *dynamoDBItemInput) (*dynamoDBItemOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key, err := t.key(input.Key)
+	if err != nil {
+		return nil, err
+	}
+	delete(t.items, key)
+
+	return &dynamoDBItemOutput{}, nil
+}
+
+type dynamoDBScanOutput struct {
+	Count        int                          `json:"Count"`
+	Items        []map[string]json.RawMessage `json:"Items"`
+	ScannedCount int                          `json:"ScannedCount"`
+}
+
+// scan returns all of the table's items, ordered by primary key, in a single page.
+func (s *DynamoDB) scan(input *dynamoDBTableNameInput) (*dynamoDBScanOutput, error) {
+	t, err := s.table(input.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	output := &dynamoDBScanOutput{
+		Items: []map[string]json.RawMessage{},
+	}
+	for _, k := range slices.Sorted(maps.Keys(t.items)) {
+		output.Items = append(output.Items, t.items[k])
+	}
+	output.Count = len(output.Items)
+	output.ScannedCount = output.Count
+
+	return output, nil
+}
+
+const (
+	// dynamoDBBatchGetItemProcessed is the number of keys a BatchGetItem request processes per table.
+	// The remaining keys are returned as unprocessed, as they would be if DynamoDB were throttling requests.
+	dynamoDBBatchGetItemProcessed = 50
+	// dynamoDBBatchWriteItemProcessed is the number of requests a BatchWriteItem request processes.
+	// The remaining requests are returned as unprocessed, as they would be if DynamoDB were throttling requests.
+	dynamoDBBatchWriteItemProcessed = 10
+)
+
+type dynamoDBKeysAndAttributes struct {
+	Keys []map[string]json.RawMessage `json:"Keys"`
+}
+
+type dynamoDBBatchGetItemInput struct {
+	RequestItems map[string]dynamoDBKeysAndAttributes `json:"RequestItems"`
+}
+
+type dynamoDBBatchGetItemOutput struct {
+	Responses       map[string][]map[string]json.RawMessage `json:"Responses"`
+	UnprocessedKeys map[string]dynamoDBKeysAndAttributes    `json:"UnprocessedKeys"`
+}
+
+// batchGetItem returns the items with the specified keys, ignoring projection expressions.
+func (s *DynamoDB) batchGetItem(input *dynamoDBBatchGetItemInput) (*dynamoDBBatchGetItemOutput, error) {
+	output := &dynamoDBBatchGetItemOutput{
+		Responses:       map[string][]map[string]json.RawMessage{},
+		UnprocessedKeys: map[string]dynamoDBKeysAndAttributes{},
+	}
+
+	for name, v := range input.RequestItems {
+		t, err := s.table(name)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := v.Keys
+		if len(keys) > dynamoDBBatchGetItemProcessed {
+			output.UnprocessedKeys[name] = dynamoDBKeysAndAttributes{Keys: keys[dynamoDBBatchGetItemProcessed:]}
+			keys = keys[:dynamoDBBatchGetItemProcessed]
+		}
+
+		items := []map[string]json.RawMessage{}
+		t.mu.Lock()
+		for _, v := range keys {
+			key, err := t.key(v)
+			if err != nil {
+				t.mu.Unlock()
+				return nil, err
+			}
+			if item, ok := t.items[key]; ok {
+				items = append(items, item)
+			}
+		}
+		t.mu.Unlock()
+		output.Responses[name] = items
+	}
+
+	return output, nil
+}
+
+type dynamoDBWriteRequest struct {
+	DeleteRequest *struct {
+		Key map[string]json.RawMessage `json:"Key"`
+	} `json:"DeleteRequest,omitempty"`
+	PutRequest *struct {
+		Item map[string]json.RawMessage `json:"Item"`
+	} `json:"PutRequest,omitempty"`
+}
+
+type dynamoDBBatchWriteItemInput struct {
+	RequestItems map[string][]dynamoDBWriteRequest `json:"RequestItems"`
+}
+
+type dynamoDBBatchWriteItemOutput struct {
+	UnprocessedItems map[string][]dynamoDBWriteRequest `json:"UnprocessedItems"`
+}
+
+// batchWriteItem puts and deletes items.
+func (s *DynamoDB) batchWriteItem(input *dynamoDBBatchWriteItemInput) (*dynamoDBBatchWriteItemOutput, error) {
+	output := &dynamoDBBatchWriteItemOutput{
+		UnprocessedItems: map[string][]dynamoDBWriteRequest{},
+	}
+
+	processed := 0
+	for _, name := range slices.Sorted(maps.Keys(input.RequestItems)) {
+		t, err := s.table(name)
+		if err != nil {
+			return nil, err
+		}
+
+		t.mu.Lock()
+		for _, v := range input.RequestItems[name] {
+			if processed == dynamoDBBatchWriteItemProcessed {
+				output.UnprocessedItems[name] = append(output.UnprocessedItems[name], v)
+				continue
+			}
+
+			var err error
+			switch {
+			case v.PutRequest != nil:
+				var key string
+				if key, err = t.key(v.PutRequest.Item); err == nil {
+					t.items[key] = v.PutRequest.Item
+				}
+			case v.DeleteRequest != nil:
+				var key string
+				if key, err = t.key(v.DeleteRequest.Key); err == nil {
+					delete(t.items, key)
+				}
+			default:
+				err = errValidation("Supplied AttributeValue has more than one datatypes set, must contain exactly one of the supported datatypes")
+			}
+			if err != nil {
+				t.mu.Unlock()
+				return nil, err
+			}
+			processed++
+		}
+		t.mu.Unlock()
+	}
+
+	return output, nil
+}
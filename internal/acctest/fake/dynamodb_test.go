@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake_test
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+)
+
+func TestDynamoDBTable(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewDynamoDB())
+
+	status, output := doJSON(t, server, "dynamodb", "DynamoDB_20120810.CreateTable", map[string]any{
+		"TableName":            "test",
+		"BillingMode":          "PAY_PER_REQUEST",
+		"AttributeDefinitions": []any{map[string]any{"AttributeName": "pk", "AttributeType": "S"}},
+		"KeySchema":            []any{map[string]any{"AttributeName": "pk", "KeyType": "HASH"}},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("CreateTable: status = %d, output = %v", status, output)
+	}
+	description := output["TableDescription"].(map[string]any)
+	if description["TableStatus"] != "ACTIVE" || description["TableArn"] != "arn:aws:dynamodb:us-west-2:123456789012:table/test" {
+		t.Errorf("CreateTable: output = %v", output)
+	}
+
+	if status, output := doJSON(t, server, "dynamodb", "DynamoDB_20120810.CreateTable", map[string]any{
+		"TableName":   "test",
+		"BillingMode": "PAY_PER_REQUEST",
+		"KeySchema":   []any{map[string]any{"AttributeName": "pk", "KeyType": "HASH"}},
+	}); status != http.StatusBadRequest || output["__type"] != "ResourceInUseException" {
+		t.Errorf("CreateTable again: status = %d, output = %v", status, output)
+	}
+
+	for _, v := range []string{"b", "a", "b"} {
+		doJSON(t, server, "dynamodb", "DynamoDB_20120810.PutItem", map[string]any{
+			"TableName": "test",
+			"Item":      map[string]any{"pk": map[string]string{"S": v}, "value": map[string]string{"N": "1"}},
+		})
+	}
+
+	_, output = doJSON(t, server, "dynamodb", "DynamoDB_20120810.Scan", map[string]any{"TableName": "test"})
+	if output["Count"] != float64(2) {
+		t.Errorf("Scan: output = %v", output)
+	}
+
+	_, output = doJSON(t, server, "dynamodb", "DynamoDB_20120810.GetItem", map[string]any{
+		"TableName": "test",
+		"Key":       map[string]any{"pk": map[string]string{"S": "a"}},
+	})
+	if output["Item"] == nil {
+		t.Errorf("GetItem: output = %v", output)
+	}
+
+	doJSON(t, server, "dynamodb", "DynamoDB_20120810.DeleteTable", map[string]any{"TableName": "test"})
+
+	if status, output := doJSON(t, server, "dynamodb", "DynamoDB_20120810.DescribeTable", map[string]any{"TableName": "test"}); status != http.StatusBadRequest || output["__type"] != "ResourceNotFoundException" {
+		t.Errorf("DescribeTable after DeleteTable: status = %d, output = %v", status, output)
+	}
+}
+
+func TestDynamoDBBatch(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewDynamoDB())
+
+	doJSON(t, server, "dynamodb", "DynamoDB_20120810.CreateTable", map[string]any{
+		"TableName":            "test",
+		"BillingMode":          "PAY_PER_REQUEST",
+		"AttributeDefinitions": []any{map[string]any{"AttributeName": "pk", "AttributeType": "S"}},
+		"KeySchema":            []any{map[string]any{"AttributeName": "pk", "KeyType": "HASH"}},
+	})
+
+	var requests, keys []any
+	for i := range 25 {
+		pk := map[string]string{"S": strconv.Itoa(i)}
+		requests = append(requests, map[string]any{"PutRequest": map[string]any{"Item": map[string]any{"pk": pk}}})
+		keys = append(keys, map[string]any{"pk": pk})
+	}
+
+	status, output := doJSON(t, server, "dynamodb", "DynamoDB_20120810.BatchWriteItem", map[string]any{
+		"RequestItems": map[string]any{"test": requests},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("BatchWriteItem: status = %d, output = %v", status, output)
+	}
+	if got := len(output["UnprocessedItems"].(map[string]any)["test"].([]any)); got != 15 {
+		t.Errorf("BatchWriteItem: %d unprocessed items, want 15", got)
+	}
+
+	status, output = doJSON(t, server, "dynamodb", "DynamoDB_20120810.BatchGetItem", map[string]any{
+		"RequestItems": map[string]any{"test": map[string]any{"Keys": keys}},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("BatchGetItem: status = %d, output = %v", status, output)
+	}
+	if got := len(output["Responses"].(map[string]any)["test"].([]any)); got != 10 {
+		t.Errorf("BatchGetItem: %d items, want 10", got)
+	}
+
+	doJSON(t, server, "dynamodb", "DynamoDB_20120810.BatchWriteItem", map[string]any{
+		"RequestItems": map[string]any{"test": []any{map[string]any{"DeleteRequest": map[string]any{"Key": keys[0]}}}},
+	})
+
+	_, output = doJSON(t, server, "dynamodb", "DynamoDB_20120810.Scan", map[string]any{"TableName": "test"})
+	if output["Count"] != float64(9) {
+		t.Errorf("Scan: output = %v", output)
+	}
+}
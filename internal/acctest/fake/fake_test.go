@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+)
+
+// doJSON sends an awsJson protocol request to the server, returning the response status and decoded body.
+func doJSON(t *testing.T, server *fake.Server, endpointID, target string, input any) (int, map[string]any) {
+	t.Helper()
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := http.NewRequest(http.MethodPost, server.Endpoints()[endpointID]+"/", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	r.Header.Set("X-Amz-Target", target)
+
+	response, err := server.Client().Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	var output map[string]any
+	if err := json.NewDecoder(response.Body).Decode(&output); err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, output
+}
+
+// doQuery sends an awsQuery protocol request to the server, returning the response status and body.
+func doQuery(t *testing.T, server *fake.Server, endpointID string, form url.Values) (int, string) {
+	t.Helper()
+
+	response, err := server.Client().PostForm(server.Endpoints()[endpointID]+"/", form)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, string(body)
+}
+
+func TestServerEndpoints(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewSQS(), fake.NewS3())
+
+	want := map[string]string{
+		"s3":  "http://s3.fake.test",
+		"sqs": "http://sqs.fake.test",
+		"sts": "http://sts.fake.test",
+	}
+	got := server.Endpoints()
+
+	if len(got) != len(want) {
+		t.Fatalf("endpoints = %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("endpoints[%q] = %q, want %q", k, got[k], v)
+		}
+	}
+
+	config := server.ProviderConfig()
+	for _, v := range []string{`sqs = "http://sqs.fake.test"`, `region     = "us-west-2"`, `s3_use_path_style       = true`} {
+		if !strings.Contains(config, v) {
+			t.Errorf("provider configuration does not contain %q:\n%s", v, config)
+		}
+	}
+}
+
+func TestServerUnknownHost(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer()
+
+	for _, v := range []string{"http://sqs.fake.test/", "https://sqs.us-west-2.amazonaws.com/"} {
+		if _, err := server.Client().Get(v); err == nil {
+			t.Errorf("GET %s: expected error", v)
+		}
+	}
+}
+
+func TestSTSGetCallerIdentity(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer()
+
+	status, body := doQuery(t, server, "sts", url.Values{"Action": {"GetCallerIdentity"}, "Version": {"2011-06-15"}})
+
+	if status != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", status, body)
+	}
+	for _, v := range []string{"<GetCallerIdentityResponse", "<Account>" + fake.AccountID + "</Account>", "<Arn>arn:aws:iam::123456789012:user/fake</Arn>", "<RequestId>"} {
+		if !strings.Contains(body, v) {
+			t.Errorf("body does not contain %q: %s", v, body)
+		}
+	}
+}
+
+func TestQueryUnknownAction(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer()
+
+	status, body := doQuery(t, server, "sts", url.Values{"Action": {"AssumeRole"}})
+
+	if status != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", status, http.StatusBadRequest)
+	}
+	if !strings.Contains(body, "<Code>InvalidAction</Code>") {
+		t.Errorf("body = %s", body)
+	}
+}
+
+func TestSQSQueue(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewSQS())
+
+	status, output := doJSON(t, server, "sqs", "AmazonSQS.CreateQueue", map[string]any{
+		"QueueName":  "test",
+		"Attributes": map[string]string{"VisibilityTimeout": "60"},
+		"tags":       map[string]string{"Name": "test"},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("CreateQueue: status = %d, output = %v", status, output)
+	}
+	queueURL := output["QueueUrl"].(string)
+	if want := "http://sqs.fake.test/123456789012/test"; queueURL != want {
+		t.Errorf("QueueUrl = %q, want %q", queueURL, want)
+	}
+
+	_, output = doJSON(t, server, "sqs", "AmazonSQS.GetQueueAttributes", map[string]any{
+		"QueueUrl":       queueURL,
+		"AttributeNames": []string{"All"},
+	})
+	attributes := output["Attributes"].(map[string]any)
+	if got, want := attributes["VisibilityTimeout"], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %v, want %v", got, want)
+	}
+	if got, want := attributes["QueueArn"], "arn:aws:sqs:us-west-2:123456789012:test"; got != want {
+		t.Errorf("QueueArn = %v, want %v", got, want)
+	}
+
+	status, output = doJSON(t, server, "sqs", "AmazonSQS.CreateQueue", map[string]any{
+		"QueueName":  "test",
+		"Attributes": map[string]string{"VisibilityTimeout": "30"},
+	})
+	if status != http.StatusBadRequest || output["__type"] != "QueueNameExists" {
+		t.Errorf("CreateQueue with different attributes: status = %d, output = %v", status, output)
+	}
+
+	doJSON(t, server, "sqs", "AmazonSQS.DeleteQueue", map[string]any{"QueueUrl": queueURL})
+
+	status, output = doJSON(t, server, "sqs", "AmazonSQS.GetQueueAttributes", map[string]any{"QueueUrl": queueURL})
+	if status != http.StatusBadRequest || output["__type"] != "QueueDoesNotExist" {
+		t.Errorf("GetQueueAttributes after DeleteQueue: status = %d, output = %v", status, output)
+	}
+}
+
+func TestJSONUnknownOperation(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewSQS())
+
+	status, output := doJSON(t, server, "sqs", "AmazonSQS.PurgeQueue", map[string]any{})
+
+	if status != http.StatusBadRequest || output["__type"] != "UnknownOperationException" {
+		t.Errorf("status = %d, output = %v", status, output)
+	}
+}
+
+func TestIAMRole(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewIAM())
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	status, body := doQuery(t, server, "iam", url.Values{
+		"Action":                   {"CreateRole"},
+		"RoleName":                 {"test"},
+		"AssumeRolePolicyDocument": {policy},
+	})
+	if status != http.StatusOK {
+		t.Fatalf("CreateRole: status = %d, body = %s", status, body)
+	}
+
+	status, body = doQuery(t, server, "iam", url.Values{
+		"Action":                   {"CreateRole"},
+		"RoleName":                 {"test"},
+		"AssumeRolePolicyDocument": {policy},
+	})
+	if status != http.StatusConflict || !strings.Contains(body, "<Code>EntityAlreadyExists</Code>") {
+		t.Errorf("CreateRole again: status = %d, body = %s", status, body)
+	}
+
+	status, body = doQuery(t, server, "iam", url.Values{"Action": {"GetRole"}, "RoleName": {"test"}})
+	if status != http.StatusOK {
+		t.Fatalf("GetRole: status = %d, body = %s", status, body)
+	}
+	for _, v := range []string{"<Arn>arn:aws:iam::123456789012:role/test</Arn>", "<AssumeRolePolicyDocument>" + url.QueryEscape(policy) + "</AssumeRolePolicyDocument>"} {
+		if !strings.Contains(body, v) {
+			t.Errorf("GetRole: body does not contain %q: %s", v, body)
+		}
+	}
+
+	doQuery(t, server, "iam", url.Values{"Action": {"DeleteRole"}, "RoleName": {"test"}})
+
+	status, body = doQuery(t, server, "iam", url.Values{"Action": {"GetRole"}, "RoleName": {"test"}})
+	if status != http.StatusNotFound || !strings.Contains(body, "<Code>NoSuchEntity</Code>") {
+		t.Errorf("GetRole after DeleteRole: status = %d, body = %s", status, body)
+	}
+}
+
+func TestSSMParameter(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewSSM())
+
+	status, output := doJSON(t, server, "ssm", "AmazonSSM.PutParameter", map[string]any{
+		"Name":  "/test/a",
+		"Type":  "String",
+		"Value": "v1",
+	})
+	if status != http.StatusOK || output["Version"] != float64(1) {
+		t.Fatalf("PutParameter: status = %d, output = %v", status, output)
+	}
+
+	status, output = doJSON(t, server, "ssm", "AmazonSSM.PutParameter", map[string]any{
+		"Name":  "/test/a",
+		"Type":  "String",
+		"Value": "v2",
+	})
+	if status != http.StatusBadRequest || output["__type"] != "ParameterAlreadyExists" {
+		t.Errorf("PutParameter without Overwrite: status = %d, output = %v", status, output)
+	}
+
+	_, output = doJSON(t, server, "ssm", "AmazonSSM.PutParameter", map[string]any{
+		"Name":      "/test/a",
+		"Value":     "v2",
+		"Overwrite": true,
+	})
+	if output["Version"] != float64(2) {
+		t.Errorf("PutParameter with Overwrite: output = %v", output)
+	}
+
+	_, output = doJSON(t, server, "ssm", "AmazonSSM.GetParameter", map[string]any{"Name": "/test/a"})
+	parameter := output["Parameter"].(map[string]any)
+	if parameter["Value"] != "v2" || parameter["ARN"] != "arn:aws:ssm:us-west-2:123456789012:parameter/test/a" {
+		t.Errorf("GetParameter: output = %v", output)
+	}
+
+	doJSON(t, server, "ssm", "AmazonSSM.DeleteParameter", map[string]any{"Name": "/test/a"})
+
+	status, output = doJSON(t, server, "ssm", "AmazonSSM.GetParameter", map[string]any{"Name": "/test/a"})
+	if status != http.StatusBadRequest || output["__type"] != "ParameterNotFound" {
+		t.Errorf("GetParameter after DeleteParameter: status = %d, output = %v", status, output)
+	}
+}
@@ -0,0 +1,868 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// IAM is a fake of the AWS Identity and Access Management API. It supports roles and customer managed policies.
+type IAM struct {
+	queryService
+
+	roles    *store[*iamRole]
+	policies *store[*iamPolicy]
+}
+
+type iamRole struct {
+	mu                       sync.Mutex
+	name                     string
+	path                     string
+	id                       string
+	arn                      string
+	createDate               time.Time
+	assumeRolePolicyDocument string
+	description              string
+	maxSessionDuration       int
+	permissionsBoundary      string
+	tags                     map[string]string
+	inlinePolicies           map[string]string
+	attachedPolicies         []string
+}
+
+type iamPolicy struct {
+	mu               sync.Mutex
+	name             string
+	path             string
+	id               string
+	arn              string
+	createDate       time.Time
+	description      string
+	defaultVersionID string
+	versions         map[string]string // Document by version ID.
+	nextVersion      int
+	tags             map[string]string
+}
+
+// NewIAM returns a fake of the AWS Identity and Access Management API.
+func NewIAM() *IAM {
+	s := &IAM{
+		roles:    newStore[*iamRole](),
+		policies: newStore[*iamPolicy](),
+	}
+	s.queryService = queryService{
+		xmlns: "https://iam.amazonaws.com/doc/2010-05-08/",
+		operations: map[string]queryHandler{
+			"AttachRolePolicy":              s.attachRolePolicy,
+			"CreatePolicy":                  s.createPolicy,
+			"CreatePolicyVersion":           s.createPolicyVersion,
+			"CreateRole":                    s.createRole,
+			"DeletePolicy":                  s.deletePolicy,
+			"DeletePolicyVersion":           s.deletePolicyVersion,
+			"DeleteRole":                    s.deleteRole,
+			"DeleteRolePermissionsBoundary": s.deleteRolePermissionsBoundary,
+			"DeleteRolePolicy":              s.deleteRolePolicy,
+			"DetachRolePolicy":              s.detachRolePolicy,
+			"GetPolicy":                     s.getPolicy,
+			"GetPolicyVersion":              s.getPolicyVersion,
+			"GetRole":                       s.getRole,
+			"GetRolePolicy":                 s.getRolePolicy,
+			"ListAttachedRolePolicies":      s.listAttachedRolePolicies,
+			"ListEntitiesForPolicy":         s.listEntitiesForPolicy,
+			"ListInstanceProfilesForRole":   s.listInstanceProfilesForRole,
+			"ListPolicyTags":                s.listPolicyTags,
+			"ListPolicyVersions":            s.listPolicyVersions,
+			"ListRolePolicies":              s.listRolePolicies,
+			"ListRoleTags":                  s.listRoleTags,
+			"ListRoles":                     s.listRoles,
+			"PutRolePermissionsBoundary":    s.putRolePermissionsBoundary,
+			"PutRolePolicy":                 s.putRolePolicy,
+			"TagPolicy":                     s.tagPolicy,
+			"TagRole":                       s.tagRole,
+			"UntagPolicy":                   s.untagPolicy,
+			"UntagRole":                     s.untagRole,
+			"UpdateAssumeRolePolicy":        s.updateAssumeRolePolicy,
+			"UpdateRole":                    s.updateRole,
+			"UpdateRoleDescription":         s.updateRoleDescription,
+		},
+	}
+
+	return s
+}
+
+func (s *IAM) EndpointID() string {
+	return names.IAM
+}
+
+func errNoSuchEntity(format string, a ...any) error {
+	return newAPIError(http.StatusNotFound, "NoSuchEntity", format, a...)
+}
+
+func iamPath(form url.Values) string {
+	if v := form.Get("Path"); v != "" {
+		return v
+	}
+
+	return "/"
+}
+
+func (s *IAM) role(name string) (*iamRole, error) {
+	r, ok := s.roles.get(name)
+	if !ok {
+		return nil, errNoSuchEntity("The role with name %s cannot be found.", name)
+	}
+
+	return r, nil
+}
+
+// iamRoleXML is a role in an XML response.
+type iamRoleXML struct {
+	ARN                      string                     `xml:"Arn"`
+	AssumeRolePolicyDocument string                     `xml:"AssumeRolePolicyDocument"`
+	CreateDate               time.Time                  `xml:"CreateDate"`
+	Description              string                     `xml:"Description,omitempty"`
+	MaxSessionDuration       int                        `xml:"MaxSessionDuration"`
+	Path                     string                     `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundaryXML `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                     `xml:"RoleId"`
+	RoleName                 string                     `xml:"RoleName"`
+	Tags                     []xmlTag                   `xml:"Tags>member,omitempty"`
+}
+
+type iamPermissionsBoundaryXML struct {
+	PermissionsBoundaryARN  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+// xml returns the role's XML representation. The caller must hold the role's lock.
+func (r *iamRole) xml() *iamRoleXML {
+	v := &iamRoleXML{
+		ARN:                      r.arn,
+		AssumeRolePolicyDocument: url.QueryEscape(r.assumeRolePolicyDocument),
+		CreateDate:               r.createDate,
+		Description:              r.description,
+		MaxSessionDuration:       r.maxSessionDuration,
+		Path:                     r.path,
+		RoleID:                   r.id,
+		RoleName:                 r.name,
+		Tags:                     xmlTags(r.tags),
+	}
+
+	if r.permissionsBoundary != "" {
+		v.PermissionsBoundary = &iamPermissionsBoundaryXML{
+			PermissionsBoundaryARN:  r.permissionsBoundary,
+			PermissionsBoundaryType: "PermissionsBoundaryPolicy",
+		}
+	}
+
+	return v
+}
+
+func (s *IAM) createRole(form url.Values) (any, error) {
+	name := form.Get("RoleName")
+	if name == "" {
+		return nil, errValidation("RoleName is required")
+	}
+
+	maxSessionDuration := 3600
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 3600 || n > 43200 {
+			return nil, errValidation("MaxSessionDuration must be between 3600 and 43200")
+		}
+		maxSessionDuration = n
+	}
+
+	path := iamPath(form)
+	r := &iamRole{
+		name:                     name,
+		path:                     path,
+		id:                       strings.ToUpper(newID("AROA", 17)),
+		arn:                      arn("iam", "", "role"+path+name),
+		createDate:               now(),
+		assumeRolePolicyDocument: form.Get("AssumeRolePolicyDocument"),
+		description:              form.Get("Description"),
+		maxSessionDuration:       maxSessionDuration,
+		permissionsBoundary:      form.Get("PermissionsBoundary"),
+		tags:                     queryMap(form, "Tags.member", "Key", "Value"),
+		inlinePolicies:           make(map[string]string),
+	}
+
+	if !s.roles.create(name, r) {
+		return nil, newAPIError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type result struct {
+		Role *iamRoleXML `xml:"Role"`
+	}
+
+	return &result{Role: r.xml()}, nil
+}
+
+func (s *IAM) getRole(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type result struct {
+		Role *iamRoleXML `xml:"Role"`
+	}
+
+	return &result{Role: r.xml()}, nil
+}
+
+func (s *IAM) updateRole(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if form.Has("Description") {
+		r.description = form.Get("Description")
+	}
+	if v := form.Get("MaxSessionDuration"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 3600 || n > 43200 {
+			return nil, errValidation("MaxSessionDuration must be between 3600 and 43200")
+		}
+		r.maxSessionDuration = n
+	}
+
+	return nil, nil
+}
+
+func (s *IAM) updateRoleDescription(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.description = form.Get("Description")
+
+	type result struct {
+		Role *iamRoleXML `xml:"Role"`
+	}
+
+	return &result{Role: r.xml()}, nil
+}
+
+func (s *IAM) updateAssumeRolePolicy(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.assumeRolePolicyDocument = form.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *IAM) putRolePermissionsBoundary(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.permissionsBoundary = form.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (s *IAM) deleteRolePermissionsBoundary(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *IAM) deleteRole(form url.Values) (any, error) {
+	name := form.Get("RoleName")
+	r, err := s.role(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.inlinePolicies) > 0 || len(r.attachedPolicies) > 0 {
+		return nil, newAPIError(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must delete policies first.")
+	}
+
+	s.roles.delete(name)
+
+	return nil, nil
+}
+
+func (s *IAM) listRoles(form url.Values) (any, error) {
+	pathPrefix := form.Get("PathPrefix")
+
+	type result struct {
+		IsTruncated bool          `xml:"IsTruncated"`
+		Roles       []*iamRoleXML `xml:"Roles>member"`
+	}
+
+	var v result
+	for _, r := range s.roles.list(nil) {
+		r.mu.Lock()
+		if strings.HasPrefix(r.path, pathPrefix) {
+			role := r.xml()
+			// ListRoles doesn't return tags or permissions boundaries.
+			role.Tags, role.PermissionsBoundary = nil, nil
+			v.Roles = append(v.Roles, role)
+		}
+		r.mu.Unlock()
+	}
+
+	return &v, nil
+}
+
+func (s *IAM) listRoleTags(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type result struct {
+		IsTruncated bool     `xml:"IsTruncated"`
+		Tags        []xmlTag `xml:"Tags>member"`
+	}
+
+	return &result{Tags: xmlTags(r.tags)}, nil
+}
+
+func (s *IAM) tagRole(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tags = mergeTags(r.tags, queryMap(form, "Tags.member", "Key", "Value"), nil)
+
+	return nil, nil
+}
+
+func (s *IAM) untagRole(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tags = mergeTags(r.tags, nil, queryMembers(form, "TagKeys.member"))
+
+	return nil, nil
+}
+
+func (s *IAM) listInstanceProfilesForRole(form url.Values) (any, error) {
+	if _, err := s.role(form.Get("RoleName")); err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		InstanceProfiles string `xml:"InstanceProfiles"`
+		IsTruncated      bool   `xml:"IsTruncated"`
+	}
+
+	return &result{}, nil
+}
+
+func (s *IAM) putRolePolicy(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.inlinePolicies[form.Get("PolicyName")] = form.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *IAM) getRolePolicy(form url.Values) (any, error) {
+	roleName, policyName := form.Get("RoleName"), form.Get("PolicyName")
+	r, err := s.role(roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	document, ok := r.inlinePolicies[policyName]
+	if !ok {
+		return nil, errNoSuchEntity("The role policy with name %s cannot be found.", policyName)
+	}
+
+	type result struct {
+		PolicyDocument string `xml:"PolicyDocument"`
+		PolicyName     string `xml:"PolicyName"`
+		RoleName       string `xml:"RoleName"`
+	}
+
+	return &result{
+		PolicyDocument: url.QueryEscape(document),
+		PolicyName:     policyName,
+		RoleName:       roleName,
+	}, nil
+}
+
+func (s *IAM) deleteRolePolicy(form url.Values) (any, error) {
+	policyName := form.Get("PolicyName")
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.inlinePolicies[policyName]; !ok {
+		return nil, errNoSuchEntity("The role policy with name %s cannot be found.", policyName)
+	}
+	delete(r.inlinePolicies, policyName)
+
+	return nil, nil
+}
+
+func (s *IAM) listRolePolicies(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type result struct {
+		IsTruncated bool     `xml:"IsTruncated"`
+		PolicyNames []string `xml:"PolicyNames>member"`
+	}
+
+	return &result{PolicyNames: slices.Sorted(maps.Keys(r.inlinePolicies))}, nil
+}
+
+func (s *IAM) attachRolePolicy(form url.Values) (any, error) {
+	policyARN := form.Get("PolicyArn")
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	// AWS managed policies aren't modeled.
+	if !strings.Contains(policyARN, ":aws:policy/") {
+		if _, err := s.policy(policyARN); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !slices.Contains(r.attachedPolicies, policyARN) {
+		r.attachedPolicies = append(r.attachedPolicies, policyARN)
+	}
+
+	return nil, nil
+}
+
+func (s *IAM) detachRolePolicy(form url.Values) (any, error) {
+	policyARN := form.Get("PolicyArn")
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := slices.Index(r.attachedPolicies, policyARN)
+	if i < 0 {
+		return nil, errNoSuchEntity("Policy %s was not found.", policyARN)
+	}
+	r.attachedPolicies = slices.Delete(r.attachedPolicies, i, i+1)
+
+	return nil, nil
+}
+
+type iamAttachedPolicyXML struct {
+	PolicyARN  string `xml:"PolicyArn"`
+	PolicyName string `xml:"PolicyName"`
+}
+
+func (s *IAM) listAttachedRolePolicies(form url.Values) (any, error) {
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type result struct {
+		AttachedPolicies []iamAttachedPolicyXML `xml:"AttachedPolicies>member"`
+		IsTruncated      bool                   `xml:"IsTruncated"`
+	}
+
+	var v result
+	for _, policyARN := range r.attachedPolicies {
+		v.AttachedPolicies = append(v.AttachedPolicies, iamAttachedPolicyXML{
+			PolicyARN:  policyARN,
+			PolicyName: policyARN[strings.LastIndex(policyARN, "/")+1:],
+		})
+	}
+
+	return &v, nil
+}
+
+func (s *IAM) policy(policyARN string) (*iamPolicy, error) {
+	p, ok := s.policies.get(policyARN)
+	if !ok {
+		return nil, errNoSuchEntity("Policy %s was not found.", policyARN)
+	}
+
+	return p, nil
+}
+
+// iamPolicyXML is a managed policy in an XML response.
+type iamPolicyXML struct {
+	ARN                           string    `xml:"Arn"`
+	AttachmentCount               int       `xml:"AttachmentCount"`
+	CreateDate                    time.Time `xml:"CreateDate"`
+	DefaultVersionID              string    `xml:"DefaultVersionId"`
+	Description                   string    `xml:"Description,omitempty"`
+	IsAttachable                  bool      `xml:"IsAttachable"`
+	Path                          string    `xml:"Path"`
+	PermissionsBoundaryUsageCount int       `xml:"PermissionsBoundaryUsageCount"`
+	PolicyID                      string    `xml:"PolicyId"`
+	PolicyName                    string    `xml:"PolicyName"`
+	Tags                          []xmlTag  `xml:"Tags>member,omitempty"`
+	UpdateDate                    time.Time `xml:"UpdateDate"`
+}
+
+// xml returns the policy's XML representation. The caller must hold the policy's lock.
+func (p *iamPolicy) xml(attachmentCount int) *iamPolicyXML {
+	return &iamPolicyXML{
+		ARN:              p.arn,
+		AttachmentCount:  attachmentCount,
+		CreateDate:       p.createDate,
+		DefaultVersionID: p.defaultVersionID,
+		Description:      p.description,
+		IsAttachable:     true,
+		Path:             p.path,
+		PolicyID:         p.id,
+		PolicyName:       p.name,
+		Tags:             xmlTags(p.tags),
+		UpdateDate:       p.createDate,
+	}
+}
+
+// attachments returns the names of the roles the policy is attached to.
+func (s *IAM) attachments(policyARN string) []string {
+	var roleNames []string
+
+	for _, r := range s.roles.list(nil) {
+		r.mu.Lock()
+		if slices.Contains(r.attachedPolicies, policyARN) {
+			roleNames = append(roleNames, r.name)
+		}
+		r.mu.Unlock()
+	}
+
+	return roleNames
+}
+
+func (s *IAM) createPolicy(form url.Values) (any, error) {
+	name := form.Get("PolicyName")
+	if name == "" {
+		return nil, errValidation("PolicyName is required")
+	}
+
+	path := iamPath(form)
+	p := &iamPolicy{
+		name:             name,
+		path:             path,
+		id:               strings.ToUpper(newID("ANPA", 17)),
+		arn:              arn("iam", "", "policy"+path+name),
+		createDate:       now(),
+		description:      form.Get("Description"),
+		defaultVersionID: "v1",
+		versions:         map[string]string{"v1": form.Get("PolicyDocument")},
+		nextVersion:      2,
+		tags:             queryMap(form, "Tags.member", "Key", "Value"),
+	}
+
+	if !s.policies.create(p.arn, p) {
+		return nil, newAPIError(http.StatusConflict, "EntityAlreadyExists", "A policy called %s already exists.", name)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	type result struct {
+		Policy *iamPolicyXML `xml:"Policy"`
+	}
+
+	return &result{Policy: p.xml(0)}, nil
+}
+
+func (s *IAM) getPolicy(form url.Values) (any, error) {
+	policyARN := form.Get("PolicyArn")
+	p, err := s.policy(policyARN)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentCount := len(s.attachments(policyARN))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	type result struct {
+		Policy *iamPolicyXML `xml:"Policy"`
+	}
+
+	return &result{Policy: p.xml(attachmentCount)}, nil
+}
+
+func (s *IAM) deletePolicy(form url.Values) (any, error) {
+	policyARN := form.Get("PolicyArn")
+	if _, err := s.policy(policyARN); err != nil {
+		return nil, err
+	}
+
+	if len(s.attachments(policyARN)) > 0 {
+		return nil, newAPIError(http.StatusConflict, "DeleteConflict", "Cannot delete a policy attached to entities.")
+	}
+
+	s.policies.delete(policyARN)
+
+	return nil, nil
+}
+
+type iamPolicyVersionXML struct {
+	CreateDate       time.Time `xml:"CreateDate"`
+	Document         string    `xml:"Document,omitempty"`
+	IsDefaultVersion bool      `xml:"IsDefaultVersion"`
+	VersionID        string    `xml:"VersionId"`
+}
+
+func (s *IAM) createPolicyVersion(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.versions) >= 5 {
+		return nil, newAPIError(http.StatusConflict, "LimitExceeded", "A managed policy can have up to 5 versions.")
+	}
+
+	versionID := "v" + strconv.Itoa(p.nextVersion)
+	p.nextVersion++
+	p.versions[versionID] = form.Get("PolicyDocument")
+	if form.Get("SetAsDefault") == "true" {
+		p.defaultVersionID = versionID
+	}
+
+	type result struct {
+		PolicyVersion iamPolicyVersionXML `xml:"PolicyVersion"`
+	}
+
+	return &result{PolicyVersion: iamPolicyVersionXML{
+		CreateDate:       now(),
+		IsDefaultVersion: p.defaultVersionID == versionID,
+		VersionID:        versionID,
+	}}, nil
+}
+
+func (s *IAM) getPolicyVersion(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	versionID := form.Get("VersionId")
+	document, ok := p.versions[versionID]
+	if !ok {
+		return nil, errNoSuchEntity("Policy %s version %s does not exist.", p.arn, versionID)
+	}
+
+	type result struct {
+		PolicyVersion iamPolicyVersionXML `xml:"PolicyVersion"`
+	}
+
+	return &result{PolicyVersion: iamPolicyVersionXML{
+		CreateDate:       p.createDate,
+		Document:         url.QueryEscape(document),
+		IsDefaultVersion: p.defaultVersionID == versionID,
+		VersionID:        versionID,
+	}}, nil
+}
+
+func (s *IAM) deletePolicyVersion(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	versionID := form.Get("VersionId")
+	if _, ok := p.versions[versionID]; !ok {
+		return nil, errNoSuchEntity("Policy %s version %s does not exist.", p.arn, versionID)
+	}
+	if versionID == p.defaultVersionID {
+		return nil, newAPIError(http.StatusConflict, "DeleteConflict", "Cannot delete the default version of a policy.")
+	}
+	delete(p.versions, versionID)
+
+	return nil, nil
+}
+
+func (s *IAM) listPolicyVersions(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	type result struct {
+		IsTruncated bool                  `xml:"IsTruncated"`
+		Versions    []iamPolicyVersionXML `xml:"Versions>member"`
+	}
+
+	var v result
+	for _, versionID := range slices.Sorted(maps.Keys(p.versions)) {
+		v.Versions = append(v.Versions, iamPolicyVersionXML{
+			CreateDate:       p.createDate,
+			IsDefaultVersion: p.defaultVersionID == versionID,
+			VersionID:        versionID,
+		})
+	}
+
+	return &v, nil
+}
+
+func (s *IAM) listEntitiesForPolicy(form url.Values) (any, error) {
+	policyARN := form.Get("PolicyArn")
+	if _, err := s.policy(policyARN); err != nil {
+		return nil, err
+	}
+
+	type policyRole struct {
+		RoleName string `xml:"RoleName"`
+	}
+	type result struct {
+		IsTruncated  bool         `xml:"IsTruncated"`
+		PolicyGroups string       `xml:"PolicyGroups"`
+		PolicyRoles  []policyRole `xml:"PolicyRoles>member"`
+		PolicyUsers  string       `xml:"PolicyUsers"`
+	}
+
+	var v result
+	for _, name := range s.attachments(policyARN) {
+		v.PolicyRoles = append(v.PolicyRoles, policyRole{RoleName: name})
+	}
+
+	return &v, nil
+}
+
+func (s *IAM) listPolicyTags(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	type result struct {
+		IsTruncated bool     `xml:"IsTruncated"`
+		Tags        []xmlTag `xml:"Tags>member"`
+	}
+
+	return &result{Tags: xmlTags(p.tags)}, nil
+}
+
+func (s *IAM) tagPolicy(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tags = mergeTags(p.tags, queryMap(form, "Tags.member", "Key", "Value"), nil)
+
+	return nil, nil
+}
+
+func (s *IAM) untagPolicy(form url.Values) (any, error) {
+	p, err := s.policy(form.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tags = mergeTags(p.tags, nil, queryMembers(form, "TagKeys.member"))
+
+	return nil, nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiError is an AWS API error response.
+type apiError struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newAPIError(statusCode int, code, format string, a ...any) *apiError {
+	return &apiError{
+		Code:       code,
+		Message:    fmt.Sprintf(format, a...),
+		StatusCode: statusCode,
+	}
+}
+
+func errValidation(format string, a ...any) *apiError {
+	return newAPIError(http.StatusBadRequest, "ValidationException", format, a...)
+}
+
+func asAPIError(err error) *apiError {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	return newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+}
+
+// jsonHandler handles an awsJson1_0 or awsJson1_1 protocol operation, returning the response body.
+type jsonHandler func(body []byte) (any, error)
+
+// jsonOperation returns a handler that decodes the request body into the operation's input.
+func jsonOperation[I any, O any](f func(*I) (*O, error)) jsonHandler {
+	return func(body []byte) (any, error) {
+		input := new(I)
+
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, input); err != nil {
+				return nil, newAPIError(http.StatusBadRequest, "SerializationException", "%s", err)
+			}
+		}
+
+		return f(input)
+	}
+}
+
+// jsonService serves an awsJson1_0 or awsJson1_1 protocol API, in which the operation is named by the X-Amz-Target header.
+type jsonService struct {
+	targetPrefix string
+	operations   map[string]jsonHandler
+}
+
+func (s *jsonService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeJSONError(w, asAPIError(err))
+		return
+	}
+
+	name, ok := strings.CutPrefix(r.Header.Get("X-Amz-Target"), s.targetPrefix+".")
+	operation, exists := s.operations[name]
+	if !ok || !exists {
+		writeJSONError(w, newAPIError(http.StatusBadRequest, "UnknownOperationException", "unsupported operation: %s", r.Header.Get("X-Amz-Target")))
+		return
+	}
+
+	output, err := operation(body)
+	if err != nil {
+		writeJSONError(w, asAPIError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-Requestid", newRequestID())
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeJSONError(w http.ResponseWriter, err *apiError) {
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	w.Header().Set("X-Amzn-Requestid", newRequestID())
+	w.Header().Set("X-Amzn-Errortype", err.Code)
+	w.WriteHeader(err.StatusCode)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"__type":  err.Code,
+		"message": err.Message,
+	})
+}
+
+// queryHandler handles an awsQuery protocol operation, returning the operation's result element.
+type queryHandler func(form url.Values) (any, error)
+
+// queryService serves an awsQuery protocol API, in which the operation is named by the Action form value.
+type queryService struct {
+	xmlns      string
+	operations map[string]queryHandler
+}
+
+func (s *queryService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeQueryError(w, newAPIError(http.StatusBadRequest, "MalformedQueryString", "%s", err))
+		return
+	}
+
+	action := r.Form.Get("Action")
+	operation, ok := s.operations[action]
+	if !ok {
+		writeQueryError(w, newAPIError(http.StatusBadRequest, "InvalidAction", "unsupported action: %s", action))
+		return
+	}
+
+	result, err := operation(r.Form)
+	if err != nil {
+		writeQueryError(w, asAPIError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	enc := xml.NewEncoder(w)
+	response := xml.StartElement{
+		Name: xml.Name{Local: action + "Response"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: s.xmlns}},
+	}
+	_ = enc.EncodeToken(response)
+	if result != nil {
+		_ = enc.EncodeElement(result, xml.StartElement{Name: xml.Name{Local: action + "Result"}})
+	}
+	_ = enc.EncodeElement(struct {
+		RequestID string `xml:"RequestId"`
+	}{newRequestID()}, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}})
+	_ = enc.EncodeToken(response.End())
+	_ = enc.Flush()
+}
+
+func writeQueryError(w http.ResponseWriter, err *apiError) {
+	type errorResponse struct {
+		XMLName   xml.Name `xml:"ErrorResponse"`
+		Type      string   `xml:"Error>Type"`
+		Code      string   `xml:"Error>Code"`
+		Message   string   `xml:"Error>Message"`
+		RequestID string   `xml:"RequestId"`
+	}
+
+	errorType := "Sender"
+	if err.StatusCode >= http.StatusInternalServerError {
+		errorType = "Receiver"
+	}
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(err.StatusCode)
+	_ = xml.NewEncoder(w).Encode(errorResponse{
+		Type:      errorType,
+		Code:      err.Code,
+		Message:   err.Message,
+		RequestID: newRequestID(),
+	})
+}
+
+// queryMembers returns the values of a list serialized as prefix.1, prefix.2, ... in a query protocol request.
+func queryMembers(form url.Values, prefix string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		k := prefix + "." + strconv.Itoa(i)
+		if !form.Has(k) {
+			break
+		}
+		values = append(values, form.Get(k))
+	}
+
+	return values
+}
+
+// queryMap returns the entries of a list of key-value structures serialized as prefix.1.<key>, prefix.1.<value>, ...
+// in a query protocol request, e.g. Tags.member.1.Key and Tags.member.1.Value.
+func queryMap(form url.Values, prefix, key, value string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.%d.%s", prefix, i, key)
+		if !form.Has(k) {
+			break
+		}
+		m[form.Get(k)] = form.Get(fmt.Sprintf("%s.%d.%s", prefix, i, value))
+	}
+
+	return m
+}
+
+// xmlTag is a tag in an XML response.
+type xmlTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func xmlTags(tags map[string]string) []xmlTag {
+	keys := slices.Sorted(maps.Keys(tags))
+
+	s := make([]xmlTag, len(keys))
+	for i, k := range keys {
+		s[i] = xmlTag{Key: k, Value: tags[k]}
+	}
+
+	return s
+}
+
+// jsonTag is a tag in a JSON request or response.
+type jsonTag struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+func jsonTags(tags map[string]string) []jsonTag {
+	s := make([]jsonTag, 0, len(tags))
+	for _, v := range xmlTags(tags) {
+		s = append(s, jsonTag(v))
+	}
+
+	return s
+}
+
+func tagsFromJSON(tags []jsonTag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, v := range tags {
+		m[v.Key] = v.Value
+	}
+
+	return m
+}
+
+// mergeTags returns a copy of the tags with the specified tags added and keys removed.
+func mergeTags(tags, add map[string]string, remove []string) map[string]string {
+	m := maps.Clone(tags)
+	if m == nil {
+		m = make(map[string]string, len(add))
+	}
+	maps.Copy(m, add)
+	for _, k := range remove {
+		delete(m, k)
+	}
+
+	return m
+}
+
+// epochSeconds returns a timestamp serialized as in the awsJson protocols.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
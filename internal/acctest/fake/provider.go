@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// Test runs a unit test of the steps against a new server for the backend.
+// The provider configuration for the server is prepended to each step's configuration.
+func Test(t *testing.T, backend Backend, steps []resource.TestStep) {
+	t.Helper()
+
+	ctx := acctest.Context(t)
+	server := NewServer(backend)
+	providerConfig := server.ProviderConfig()
+
+	for i := range steps {
+		if steps[i].Config != "" {
+			steps[i].Config = providerConfig + steps[i].Config
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: server.ProtoV5ProviderFactories(ctx),
+		Steps:                    steps,
+	})
+}
+
+// ProtoV5ProviderFactories returns provider factories whose AWS API requests are sent to the server's backends.
+// The provider must also be configured with ProviderConfig.
+func (s *Server) ProtoV5ProviderFactories(ctx context.Context) map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"aws": func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = s.providerConfigureContextFunc(primary, primary.ConfigureContextFunc)
+
+			return providerServerFactory(), nil
+		},
+	}
+}
+
+// providerConfigureContextFunc returns a provider configuration function that installs the server's HTTP client
+// before the provider's AWS client is configured.
+func (s *Server) providerConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+		meta, ok := provider.Meta().(*conns.AWSClient)
+		if !ok {
+			meta = new(conns.AWSClient)
+		}
+		meta.SetHTTPClient(ctx, s.Client())
+		provider.SetMeta(meta)
+
+		return configureContextFunc(ctx, d)
+	}
+}
+
+// ProviderConfig returns a provider configuration block that sends the provider's AWS API requests to the
+// server's backends as the fake caller.
+func (s *Server) ProviderConfig() string {
+	endpoints := s.Endpoints()
+
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(endpoints)) {
+		fmt.Fprintf(&b, "    %s = %q\n", k, endpoints[k])
+	}
+
+	return fmt.Sprintf(`
+provider "aws" {
+  region     = %[1]q
+  access_key = %[2]q
+  secret_key = %[3]q
+
+  s3_use_path_style       = true
+  skip_metadata_api_check = true
+  skip_region_validation  = true
+
+  endpoints {
+%[4]s  }
+}
+`, Region, AccessKeyID, SecretAccessKey, b.String())
+}
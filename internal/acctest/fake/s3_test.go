@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+)
+
+// doS3 sends a REST request to the server's S3 backend, returning the response status and body.
+func doS3(t *testing.T, server *fake.Server, method, url, body string, header http.Header) (int, string) {
+	t.Helper()
+
+	r, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		r.Header[k] = v
+	}
+
+	response, err := server.Client().Do(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	b, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, string(b)
+}
+
+func TestS3Bucket(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewS3())
+	endpoint := server.Endpoints()["s3"]
+
+	if status, body := doS3(t, server, http.MethodPut, endpoint+"/test", "", nil); status != http.StatusOK {
+		t.Fatalf("CreateBucket: status = %d, body = %s", status, body)
+	}
+
+	if status, body := doS3(t, server, http.MethodPut, endpoint+"/test", "", nil); status != http.StatusConflict || !strings.Contains(body, "<Code>BucketAlreadyOwnedByYou</Code>") {
+		t.Errorf("CreateBucket again: status = %d, body = %s", status, body)
+	}
+
+	if status, _ := doS3(t, server, http.MethodHead, endpoint+"/test", "", nil); status != http.StatusOK {
+		t.Errorf("HeadBucket: status = %d", status)
+	}
+
+	// Virtual-hosted-style.
+	if status, _ := doS3(t, server, http.MethodHead, "http://test.s3.fake.test/", "", nil); status != http.StatusOK {
+		t.Errorf("HeadBucket (virtual-hosted-style): status = %d", status)
+	}
+
+	if status, _ := doS3(t, server, http.MethodHead, endpoint+"/missing", "", nil); status != http.StatusNotFound {
+		t.Errorf("HeadBucket (missing): status = %d", status)
+	}
+
+	if status, body := doS3(t, server, http.MethodGet, endpoint+"/test?tagging", "", nil); status != http.StatusNotFound || !strings.Contains(body, "<Code>NoSuchTagSet</Code>") {
+		t.Errorf("GetBucketTagging: status = %d, body = %s", status, body)
+	}
+
+	tagging := `<Tagging><TagSet><Tag><Key>Name</Key><Value>test</Value></Tag></TagSet></Tagging>`
+	doS3(t, server, http.MethodPut, endpoint+"/test?tagging", tagging, nil)
+
+	if status, body := doS3(t, server, http.MethodGet, endpoint+"/test?tagging", "", nil); status != http.StatusOK || body != tagging {
+		t.Errorf("GetBucketTagging after PutBucketTagging: status = %d, body = %s", status, body)
+	}
+
+	if status, body := doS3(t, server, http.MethodGet, endpoint+"/test?versioning", "", nil); status != http.StatusOK || !strings.Contains(body, "<VersioningConfiguration") {
+		t.Errorf("GetBucketVersioning: status = %d, body = %s", status, body)
+	}
+
+	doS3(t, server, http.MethodPut, endpoint+"/test/a/b.txt", "hello", nil)
+
+	if status, body := doS3(t, server, http.MethodDelete, endpoint+"/test", "", nil); status != http.StatusConflict || !strings.Contains(body, "<Code>BucketNotEmpty</Code>") {
+		t.Errorf("DeleteBucket (not empty): status = %d, body = %s", status, body)
+	}
+
+	doS3(t, server, http.MethodDelete, endpoint+"/test/a/b.txt", "", nil)
+
+	if status, _ := doS3(t, server, http.MethodDelete, endpoint+"/test", "", nil); status != http.StatusNoContent {
+		t.Errorf("DeleteBucket: status = %d", status)
+	}
+}
+
+func TestS3Object(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewS3())
+	endpoint := server.Endpoints()["s3"]
+
+	doS3(t, server, http.MethodPut, endpoint+"/test", "", nil)
+
+	testCases := map[string]struct {
+		body   string
+		header http.Header
+	}{
+		"plain": {
+			body: "hello, world",
+		},
+		"aws-chunked": {
+			body: "5\r\nhello\r\n7\r\n, world\r\n0\r\nx-amz-checksum-crc32:abc=\r\n\r\n",
+			header: http.Header{
+				"Content-Encoding":             {"aws-chunked"},
+				"X-Amz-Decoded-Content-Length": {"12"},
+			},
+		},
+		"signed aws-chunked": {
+			body: "c;chunk-signature=0123\r\nhello, world\r\n0;chunk-signature=4567\r\n\r\n",
+			header: http.Header{
+				"X-Amz-Content-Sha256": {"STREAMING-AWS4-HMAC-SHA256-PAYLOAD"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			key := endpoint + "/test/" + strings.ReplaceAll(name, " ", "-")
+
+			header := http.Header{"X-Amz-Meta-Owner": {"fake"}}
+			for k, v := range testCase.header {
+				header[k] = v
+			}
+
+			if status, body := doS3(t, server, http.MethodPut, key, testCase.body, header); status != http.StatusOK {
+				t.Fatalf("PutObject: status = %d, body = %s", status, body)
+			}
+
+			if status, body := doS3(t, server, http.MethodGet, key, "", nil); status != http.StatusOK || body != "hello, world" {
+				t.Errorf("GetObject: status = %d, body = %q", status, body)
+			}
+		})
+	}
+
+	status, body := doS3(t, server, http.MethodGet, endpoint+"/test?list-type=2&prefix=aws", "", nil)
+	if status != http.StatusOK || !strings.Contains(body, "<KeyCount>1</KeyCount>") || !strings.Contains(body, "<Key>aws-chunked</Key>") {
+		t.Errorf("ListObjectsV2: status = %d, body = %s", status, body)
+	}
+
+	if status, body := doS3(t, server, http.MethodGet, endpoint+"/test/missing", "", nil); status != http.StatusNotFound || !strings.Contains(body, "<Code>NoSuchKey</Code>") {
+		t.Errorf("GetObject (missing): status = %d, body = %s", status, body)
+	}
+}
@@ -0,0 +1,611 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SecretsManager is a fake of the AWS Secrets Manager API.
+type SecretsManager struct {
+	jsonService
+
+	// secrets are keyed by name.
+	secrets *store[*secretsManagerSecret]
+}
+
+type secretsManagerSecret struct {
+	mu                sync.Mutex
+	name              string
+	arn               string
+	description       string
+	kmsKeyID          string
+	createdDate       time.Time
+	lastChangedDate   time.Time
+	deletedDate       *time.Time
+	resourcePolicy    string
+	tags              map[string]string
+	versions          map[string]*secretsManagerSecretVersion
+	versionIDsInOrder []string
+}
+
+type secretsManagerSecretVersion struct {
+	id           string
+	secretString *string
+	secretBinary []byte
+	createdDate  time.Time
+	stages       []string
+}
+
+// NewSecretsManager returns a fake of the AWS Secrets Manager API.
+func NewSecretsManager() *SecretsManager {
+	s := &SecretsManager{
+		secrets: newStore[*secretsManagerSecret](),
+	}
+	s.jsonService = jsonService{
+		targetPrefix: "secretsmanager",
+		operations: map[string]jsonHandler{
+			"CreateSecret":         jsonOperation(s.createSecret),
+			"DeleteResourcePolicy": jsonOperation(s.deleteResourcePolicy),
+			"DeleteSecret":         jsonOperation(s.deleteSecret),
+			"DescribeSecret":       jsonOperation(s.describeSecret),
+			"GetResourcePolicy":    jsonOperation(s.getResourcePolicy),
+			"GetSecretValue":       jsonOperation(s.getSecretValue),
+			"ListSecretVersionIds": jsonOperation(s.listSecretVersionIDs),
+			"ListSecrets":          jsonOperation(s.listSecrets),
+			"PutResourcePolicy":    jsonOperation(s.putResourcePolicy),
+			"PutSecretValue":       jsonOperation(s.putSecretValue),
+			"RestoreSecret":        jsonOperation(s.restoreSecret),
+			"TagResource":          jsonOperation(s.tagResource),
+			"UntagResource":        jsonOperation(s.untagResource),
+			"UpdateSecret":         jsonOperation(s.updateSecret),
+		},
+	}
+
+	return s
+}
+
+func (s *SecretsManager) EndpointID() string {
+	return names.SecretsManager
+}
+
+func errSecretsManagerResourceNotFound() error {
+	return newAPIError(http.StatusBadRequest, "ResourceNotFoundException", "Secrets Manager can't find the specified secret.")
+}
+
+// secret returns the secret with the specified name or ARN.
+func (s *SecretsManager) secret(secretID string) (*secretsManagerSecret, error) {
+	if v, ok := s.secrets.get(secretID); ok {
+		return v, nil
+	}
+
+	if v := s.secrets.list(func(_ string, v *secretsManagerSecret) bool {
+		return v.arn == secretID
+	}); len(v) == 1 {
+		return v[0], nil
+	}
+
+	return nil, errSecretsManagerResourceNotFound()
+}
+
+// activeSecret returns the secret with the specified name or ARN if it isn't scheduled for deletion.
+// The caller must release the returned secret's lock.
+func (s *SecretsManager) activeSecret(secretID string) (*secretsManagerSecret, error) {
+	v, err := s.secret(secretID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	if v.deletedDate != nil {
+		v.mu.Unlock()
+		return nil, newAPIError(http.StatusBadRequest, "InvalidRequestException", "You can't perform this operation on the secret because it was marked for deletion.")
+	}
+
+	return v, nil
+}
+
+// putVersion adds a version to the secret, making it current. The caller must hold the secret's lock.
+func (v *secretsManagerSecret) putVersion(id string, secretString *string, secretBinary []byte, stages []string) *secretsManagerSecretVersion {
+	if id == "" {
+		id = newRequestID()
+	}
+	if len(stages) == 0 {
+		stages = []string{"AWSCURRENT"}
+	}
+
+	if slices.Contains(stages, "AWSCURRENT") {
+		for _, version := range v.versions {
+			version.stages = slices.DeleteFunc(version.stages, func(stage string) bool {
+				return stage == "AWSPREVIOUS"
+			})
+			if slices.Contains(version.stages, "AWSCURRENT") {
+				version.stages = slices.DeleteFunc(version.stages, func(stage string) bool {
+					return stage == "AWSCURRENT"
+				})
+				version.stages = append(version.stages, "AWSPREVIOUS")
+			}
+		}
+	}
+
+	version := &secretsManagerSecretVersion{
+		id:           id,
+		secretString: secretString,
+		secretBinary: secretBinary,
+		createdDate:  now(),
+		stages:       stages,
+	}
+	v.versions[id] = version
+	v.versionIDsInOrder = append(v.versionIDsInOrder, id)
+	v.lastChangedDate = now()
+
+	return version
+}
+
+// version returns the secret version with the specified ID or stage. The caller must hold the secret's lock.
+func (v *secretsManagerSecret) version(id, stage string) (*secretsManagerSecretVersion, bool) {
+	if id != "" {
+		version, ok := v.versions[id]
+		return version, ok
+	}
+
+	if stage == "" {
+		stage = "AWSCURRENT"
+	}
+	for _, version := range v.versions {
+		if slices.Contains(version.stages, stage) {
+			return version, true
+		}
+	}
+
+	return nil, false
+}
+
+type secretsManagerCreateSecretInput struct {
+	ClientRequestToken string    `json:"ClientRequestToken"`
+	Description        string    `json:"Description"`
+	KMSKeyID           string    `json:"KmsKeyId"`
+	Name               string    `json:"Name"`
+	SecretBinary       []byte    `json:"SecretBinary"`
+	SecretString       *string   `json:"SecretString"`
+	Tags               []jsonTag `json:"Tags"`
+}
+
+type secretsManagerSecretOutput struct {
+	ARN       string `json:"ARN"`
+	Name      string `json:"Name"`
+	VersionID string `json:"VersionId,omitempty"`
+}
+
+func (s *SecretsManager) createSecret(input *secretsManagerCreateSecretInput) (*secretsManagerSecretOutput, error) {
+	if input.Name == "" {
+		return nil, errValidation("Name is required")
+	}
+
+	v := &secretsManagerSecret{
+		name:            input.Name,
+		arn:             arn("secretsmanager", Region, "secret:"+input.Name+"-"+newID("", 6)),
+		description:     input.Description,
+		kmsKeyID:        input.KMSKeyID,
+		createdDate:     now(),
+		lastChangedDate: now(),
+		tags:            tagsFromJSON(input.Tags),
+		versions:        make(map[string]*secretsManagerSecretVersion),
+	}
+
+	if !s.secrets.create(v.name, v) {
+		return nil, newAPIError(http.StatusBadRequest, "ResourceExistsException", "The operation failed because the secret %s already exists.", input.Name)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	output := &secretsManagerSecretOutput{
+		ARN:  v.arn,
+		Name: v.name,
+	}
+	if input.SecretString != nil || input.SecretBinary != nil {
+		output.VersionID = v.putVersion(input.ClientRequestToken, input.SecretString, input.SecretBinary, nil).id
+	}
+
+	return output, nil
+}
+
+type secretsManagerSecretIDInput struct {
+	SecretID string `json:"SecretId"`
+}
+
+type secretsManagerDescribeSecretOutput struct {
+	ARN                string              `json:"ARN"`
+	CreatedDate        float64             `json:"CreatedDate"`
+	DeletedDate        *float64            `json:"DeletedDate,omitempty"`
+	Description        string              `json:"Description,omitempty"`
+	KMSKeyID           string              `json:"KmsKeyId,omitempty"`
+	LastChangedDate    float64             `json:"LastChangedDate"`
+	Name               string              `json:"Name"`
+	RotationEnabled    bool                `json:"RotationEnabled"`
+	Tags               []jsonTag           `json:"Tags"`
+	VersionIDsToStages map[string][]string `json:"VersionIdsToStages"`
+}
+
+// describe returns the secret's description. The caller must hold the secret's lock.
+func (v *secretsManagerSecret) describe() secretsManagerDescribeSecretOutput {
+	output := secretsManagerDescribeSecretOutput{
+		ARN:                v.arn,
+		CreatedDate:        epochSeconds(v.createdDate),
+		Description:        v.description,
+		KMSKeyID:           v.kmsKeyID,
+		LastChangedDate:    epochSeconds(v.lastChangedDate),
+		Name:               v.name,
+		Tags:               jsonTags(v.tags),
+		VersionIDsToStages: make(map[string][]string),
+	}
+	if v.deletedDate != nil {
+		deletedDate := epochSeconds(*v.deletedDate)
+		output.DeletedDate = &deletedDate
+	}
+	for id, version := range v.versions {
+		if len(version.stages) > 0 {
+			output.VersionIDsToStages[id] = slices.Clone(version.stages)
+		}
+	}
+
+	return output
+}
+
+func (s *SecretsManager) describeSecret(input *secretsManagerSecretIDInput) (*secretsManagerDescribeSecretOutput, error) {
+	v, err := s.secret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	output := v.describe()
+
+	return &output, nil
+}
+
+type secretsManagerListSecretsOutput struct {
+	SecretList []secretsManagerDescribeSecretOutput `json:"SecretList"`
+}
+
+func (s *SecretsManager) listSecrets(*struct{}) (*secretsManagerListSecretsOutput, error) {
+	output := &secretsManagerListSecretsOutput{
+		SecretList: []secretsManagerDescribeSecretOutput{},
+	}
+
+	for _, v := range s.secrets.list(nil) {
+		v.mu.Lock()
+		if v.deletedDate == nil {
+			output.SecretList = append(output.SecretList, v.describe())
+		}
+		v.mu.Unlock()
+	}
+
+	return output, nil
+}
+
+type secretsManagerGetSecretValueInput struct {
+	SecretID     string `json:"SecretId"`
+	VersionID    string `json:"VersionId"`
+	VersionStage string `json:"VersionStage"`
+}
+
+type secretsManagerGetSecretValueOutput struct {
+	ARN           string   `json:"ARN"`
+	CreatedDate   float64  `json:"CreatedDate"`
+	Name          string   `json:"Name"`
+	SecretBinary  []byte   `json:"SecretBinary,omitempty"`
+	SecretString  *string  `json:"SecretString,omitempty"`
+	VersionID     string   `json:"VersionId"`
+	VersionStages []string `json:"VersionStages"`
+}
+
+func (s *SecretsManager) getSecretValue(input *secretsManagerGetSecretValueInput) (*secretsManagerGetSecretValueOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	version, ok := v.version(input.VersionID, input.VersionStage)
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, "ResourceNotFoundException", "Secrets Manager can't find the specified secret value for staging label: %s", input.VersionStage)
+	}
+
+	return &secretsManagerGetSecretValueOutput{
+		ARN:           v.arn,
+		CreatedDate:   epochSeconds(version.createdDate),
+		Name:          v.name,
+		SecretBinary:  version.secretBinary,
+		SecretString:  version.secretString,
+		VersionID:     version.id,
+		VersionStages: slices.Clone(version.stages),
+	}, nil
+}
+
+type secretsManagerPutSecretValueInput struct {
+	ClientRequestToken string   `json:"ClientRequestToken"`
+	SecretBinary       []byte   `json:"SecretBinary"`
+	SecretID           string   `json:"SecretId"`
+	SecretString       *string  `json:"SecretString"`
+	VersionStages      []string `json:"VersionStages"`
+}
+
+type secretsManagerPutSecretValueOutput struct {
+	ARN           string   `json:"ARN"`
+	Name          string   `json:"Name"`
+	VersionID     string   `json:"VersionId"`
+	VersionStages []string `json:"VersionStages"`
+}
+
+func (s *SecretsManager) putSecretValue(input *secretsManagerPutSecretValueInput) (*secretsManagerPutSecretValueOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	if input.SecretString == nil && input.SecretBinary == nil {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidParameterException", "You must provide either SecretString or SecretBinary.")
+	}
+
+	// Retrying a request with the same token returns the existing version.
+	if version, ok := v.versions[input.ClientRequestToken]; ok && input.ClientRequestToken != "" {
+		return &secretsManagerPutSecretValueOutput{
+			ARN:           v.arn,
+			Name:          v.name,
+			VersionID:     version.id,
+			VersionStages: slices.Clone(version.stages),
+		}, nil
+	}
+
+	version := v.putVersion(input.ClientRequestToken, input.SecretString, input.SecretBinary, input.VersionStages)
+
+	return &secretsManagerPutSecretValueOutput{
+		ARN:           v.arn,
+		Name:          v.name,
+		VersionID:     version.id,
+		VersionStages: slices.Clone(version.stages),
+	}, nil
+}
+
+type secretsManagerUpdateSecretInput struct {
+	ClientRequestToken string  `json:"ClientRequestToken"`
+	Description        *string `json:"Description"`
+	KMSKeyID           *string `json:"KmsKeyId"`
+	SecretBinary       []byte  `json:"SecretBinary"`
+	SecretID           string  `json:"SecretId"`
+	SecretString       *string `json:"SecretString"`
+}
+
+func (s *SecretsManager) updateSecret(input *secretsManagerUpdateSecretInput) (*secretsManagerSecretOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	if input.Description != nil {
+		v.description = *input.Description
+	}
+	if input.KMSKeyID != nil {
+		v.kmsKeyID = *input.KMSKeyID
+	}
+	v.lastChangedDate = now()
+
+	output := &secretsManagerSecretOutput{
+		ARN:  v.arn,
+		Name: v.name,
+	}
+	if input.SecretString != nil || input.SecretBinary != nil {
+		output.VersionID = v.putVersion(input.ClientRequestToken, input.SecretString, input.SecretBinary, nil).id
+	}
+
+	return output, nil
+}
+
+type secretsManagerListSecretVersionIDsOutput struct {
+	ARN      string `json:"ARN"`
+	Name     string `json:"Name"`
+	Versions []struct {
+		CreatedDate   float64  `json:"CreatedDate"`
+		VersionID     string   `json:"VersionId"`
+		VersionStages []string `json:"VersionStages"`
+	} `json:"Versions"`
+}
+
+func (s *SecretsManager) listSecretVersionIDs(input *secretsManagerSecretIDInput) (*secretsManagerListSecretVersionIDsOutput, error) {
+	v, err := s.secret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	output := &secretsManagerListSecretVersionIDsOutput{
+		ARN:  v.arn,
+		Name: v.name,
+	}
+	for _, id := range v.versionIDsInOrder {
+		version := v.versions[id]
+		output.Versions = append(output.Versions, struct {
+			CreatedDate   float64  `json:"CreatedDate"`
+			VersionID     string   `json:"VersionId"`
+			VersionStages []string `json:"VersionStages"`
+		}{epochSeconds(version.createdDate), version.id, slices.Clone(version.stages)})
+	}
+
+	return output, nil
+}
+
+type secretsManagerDeleteSecretInput struct {
+	ForceDeleteWithoutRecovery bool   `json:"ForceDeleteWithoutRecovery"`
+	RecoveryWindowInDays       int    `json:"RecoveryWindowInDays"`
+	SecretID                   string `json:"SecretId"`
+}
+
+type secretsManagerDeleteSecretOutput struct {
+	ARN          string  `json:"ARN"`
+	DeletionDate float64 `json:"DeletionDate"`
+	Name         string  `json:"Name"`
+}
+
+// deleteSecret schedules the secret for deletion, or deletes it immediately if recovery is disabled.
+// Scheduled deletions never complete.
+func (s *SecretsManager) deleteSecret(input *secretsManagerDeleteSecretInput) (*secretsManagerDeleteSecretOutput, error) {
+	v, err := s.secret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if input.ForceDeleteWithoutRecovery {
+		if input.RecoveryWindowInDays != 0 {
+			return nil, newAPIError(http.StatusBadRequest, "InvalidParameterException", "You can't use ForceDeleteWithoutRecovery in conjunction with RecoveryWindowInDays.")
+		}
+
+		s.secrets.delete(v.name)
+
+		return &secretsManagerDeleteSecretOutput{
+			ARN:          v.arn,
+			DeletionDate: epochSeconds(now()),
+			Name:         v.name,
+		}, nil
+	}
+
+	if v.deletedDate == nil {
+		deletedDate := now()
+		v.deletedDate = &deletedDate
+	}
+
+	recoveryWindowInDays := input.RecoveryWindowInDays
+	if recoveryWindowInDays == 0 {
+		recoveryWindowInDays = 30
+	}
+
+	return &secretsManagerDeleteSecretOutput{
+		ARN:          v.arn,
+		DeletionDate: epochSeconds(v.deletedDate.AddDate(0, 0, recoveryWindowInDays)),
+		Name:         v.name,
+	}, nil
+}
+
+func (s *SecretsManager) restoreSecret(input *secretsManagerSecretIDInput) (*secretsManagerSecretOutput, error) {
+	v, err := s.secret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.deletedDate = nil
+
+	return &secretsManagerSecretOutput{
+		ARN:  v.arn,
+		Name: v.name,
+	}, nil
+}
+
+type secretsManagerResourcePolicyInput struct {
+	ResourcePolicy string `json:"ResourcePolicy"`
+	SecretID       string `json:"SecretId"`
+}
+
+type secretsManagerResourcePolicyOutput struct {
+	ARN            string `json:"ARN"`
+	Name           string `json:"Name"`
+	ResourcePolicy string `json:"ResourcePolicy,omitempty"`
+}
+
+func (s *SecretsManager) getResourcePolicy(input *secretsManagerSecretIDInput) (*secretsManagerResourcePolicyOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	return &secretsManagerResourcePolicyOutput{
+		ARN:            v.arn,
+		Name:           v.name,
+		ResourcePolicy: v.resourcePolicy,
+	}, nil
+}
+
+func (s *SecretsManager) putResourcePolicy(input *secretsManagerResourcePolicyInput) (*secretsManagerResourcePolicyOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	if strings.TrimSpace(input.ResourcePolicy) == "" {
+		return nil, newAPIError(http.StatusBadRequest, "MalformedPolicyDocumentException", "The resource policy is empty.")
+	}
+	v.resourcePolicy = input.ResourcePolicy
+
+	return &secretsManagerResourcePolicyOutput{
+		ARN:  v.arn,
+		Name: v.name,
+	}, nil
+}
+
+func (s *SecretsManager) deleteResourcePolicy(input *secretsManagerSecretIDInput) (*secretsManagerResourcePolicyOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	v.resourcePolicy = ""
+
+	return &secretsManagerResourcePolicyOutput{
+		ARN:  v.arn,
+		Name: v.name,
+	}, nil
+}
+
+type secretsManagerTagResourceInput struct {
+	SecretID string    `json:"SecretId"`
+	TagKeys  []string  `json:"TagKeys"`
+	Tags     []jsonTag `json:"Tags"`
+}
+
+type secretsManagerEmptyOutput struct{}
+
+func (s *SecretsManager) tagResource(input *secretsManagerTagResourceInput) (*secretsManagerEmptyOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	v.tags = mergeTags(v.tags, tagsFromJSON(input.Tags), nil)
+
+	return &secretsManagerEmptyOutput{}, nil
+}
+
+func (s *SecretsManager) untagResource(input *secretsManagerTagResourceInput) (*secretsManagerEmptyOutput, error) {
+	v, err := s.activeSecret(input.SecretID)
+	if err != nil {
+		return nil, err
+	}
+	defer v.mu.Unlock()
+
+	v.tags = mergeTags(v.tags, nil, input.TagKeys)
+
+	return &secretsManagerEmptyOutput{}, nil
+}
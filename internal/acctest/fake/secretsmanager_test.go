@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+)
+
+func TestSecretsManagerSecret(t *testing.T) {
+	t.Parallel()
+
+	server := fake.NewServer(fake.NewSecretsManager())
+
+	status, output := doJSON(t, server, "secretsmanager", "secretsmanager.CreateSecret", map[string]any{
+		"Name":         "test",
+		"SecretString": "v1",
+	})
+	if status != http.StatusOK {
+		t.Fatalf("CreateSecret: status = %d, output = %v", status, output)
+	}
+	secretARN := output["ARN"].(string)
+	if !strings.HasPrefix(secretARN, "arn:aws:secretsmanager:us-west-2:123456789012:secret:test-") {
+		t.Errorf("ARN = %q", secretARN)
+	}
+
+	doJSON(t, server, "secretsmanager", "secretsmanager.PutSecretValue", map[string]any{
+		"SecretId":     secretARN,
+		"SecretString": "v2",
+	})
+
+	_, output = doJSON(t, server, "secretsmanager", "secretsmanager.GetSecretValue", map[string]any{"SecretId": "test"})
+	if output["SecretString"] != "v2" {
+		t.Errorf("GetSecretValue (AWSCURRENT): output = %v", output)
+	}
+
+	_, output = doJSON(t, server, "secretsmanager", "secretsmanager.GetSecretValue", map[string]any{"SecretId": "test", "VersionStage": "AWSPREVIOUS"})
+	if output["SecretString"] != "v1" {
+		t.Errorf("GetSecretValue (AWSPREVIOUS): output = %v", output)
+	}
+
+	// Deleting a secret with a recovery window schedules it for deletion.
+	doJSON(t, server, "secretsmanager", "secretsmanager.DeleteSecret", map[string]any{"SecretId": "test"})
+
+	status, output = doJSON(t, server, "secretsmanager", "secretsmanager.DescribeSecret", map[string]any{"SecretId": "test"})
+	if status != http.StatusOK || output["DeletedDate"] == nil {
+		t.Errorf("DescribeSecret after DeleteSecret: status = %d, output = %v", status, output)
+	}
+
+	status, output = doJSON(t, server, "secretsmanager", "secretsmanager.GetSecretValue", map[string]any{"SecretId": "test"})
+	if status != http.StatusBadRequest || output["__type"] != "InvalidRequestException" {
+		t.Errorf("GetSecretValue after DeleteSecret: status = %d, output = %v", status, output)
+	}
+
+	doJSON(t, server, "secretsmanager", "secretsmanager.RestoreSecret", map[string]any{"SecretId": "test"})
+
+	if status, output := doJSON(t, server, "secretsmanager", "secretsmanager.GetSecretValue", map[string]any{"SecretId": "test"}); status != http.StatusOK {
+		t.Errorf("GetSecretValue after RestoreSecret: status = %d, output = %v", status, output)
+	}
+
+	doJSON(t, server, "secretsmanager", "secretsmanager.DeleteSecret", map[string]any{"SecretId": "test", "ForceDeleteWithoutRecovery": true})
+
+	status, output = doJSON(t, server, "secretsmanager", "secretsmanager.DescribeSecret", map[string]any{"SecretId": "test"})
+	if status != http.StatusBadRequest || output["__type"] != "ResourceNotFoundException" {
+		t.Errorf("DescribeSecret after DeleteSecret without recovery: status = %d, output = %v", status, output)
+	}
+}
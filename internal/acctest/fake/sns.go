@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SNS is a fake of the Amazon Simple Notification Service API.
+type SNS struct {
+	queryService
+
+	topics *store[*snsTopic]
+}
+
+type snsTopic struct {
+	mu         sync.Mutex
+	attributes map[string]string
+	tags       map[string]string
+}
+
+// NewSNS returns a fake of the Amazon Simple Notification Service API.
+func NewSNS() *SNS {
+	s := &SNS{
+		topics: newStore[*snsTopic](),
+	}
+	s.queryService = queryService{
+		xmlns: "http://sns.amazonaws.com/doc/2010-03-31/",
+		operations: map[string]queryHandler{
+			"CreateTopic":         s.createTopic,
+			"DeleteTopic":         s.deleteTopic,
+			"GetTopicAttributes":  s.getTopicAttributes,
+			"ListTagsForResource": s.listTagsForResource,
+			"ListTopics":          s.listTopics,
+			"SetTopicAttributes":  s.setTopicAttributes,
+			"TagResource":         s.tagResource,
+			"UntagResource":       s.untagResource,
+		},
+	}
+
+	return s
+}
+
+func (s *SNS) EndpointID() string {
+	return names.SNS
+}
+
+func (s *SNS) topic(topicARN string) (*snsTopic, error) {
+	t, ok := s.topics.get(topicARN)
+	if !ok {
+		return nil, newAPIError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return t, nil
+}
+
+type snsEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func (s *SNS) createTopic(form url.Values) (any, error) {
+	name := form.Get("Name")
+	if name == "" {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Name")
+	}
+
+	topicARN := arn("sns", Region, name)
+	attributes := map[string]string{
+		"DisplayName":             "",
+		"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+		"Owner":                   AccountID,
+		"Policy":                  fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%q,"Condition":{"StringEquals":{"AWS:SourceOwner":%q}}}]}`, topicARN, AccountID),
+		"SubscriptionsConfirmed":  "0",
+		"SubscriptionsDeleted":    "0",
+		"SubscriptionsPending":    "0",
+		"TopicArn":                topicARN,
+	}
+	if strings.HasSuffix(name, ".fifo") {
+		attributes["FifoTopic"] = "true"
+	}
+	maps.Copy(attributes, queryMap(form, "Attributes.entry", "key", "value"))
+
+	// Creating a topic that exists returns the existing topic.
+	s.topics.create(topicARN, &snsTopic{
+		attributes: attributes,
+		tags:       queryMap(form, "Tags.member", "Key", "Value"),
+	})
+
+	type result struct {
+		TopicARN string `xml:"TopicArn"`
+	}
+
+	return &result{TopicARN: topicARN}, nil
+}
+
+func (s *SNS) deleteTopic(form url.Values) (any, error) {
+	// Deleting a topic that doesn't exist succeeds.
+	s.topics.delete(form.Get("TopicArn"))
+
+	return nil, nil
+}
+
+func (s *SNS) getTopicAttributes(form url.Values) (any, error) {
+	t, err := s.topic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	type result struct {
+		Attributes []snsEntry `xml:"Attributes>entry"`
+	}
+
+	var r result
+	for _, k := range slices.Sorted(maps.Keys(t.attributes)) {
+		r.Attributes = append(r.Attributes, snsEntry{Key: k, Value: t.attributes[k]})
+	}
+
+	return &r, nil
+}
+
+func (s *SNS) setTopicAttributes(form url.Values) (any, error) {
+	t, err := s.topic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	name, value := form.Get("AttributeName"), form.Get("AttributeValue")
+	if value == "" && name != "DisplayName" {
+		delete(t.attributes, name)
+	} else {
+		t.attributes[name] = value
+	}
+
+	return nil, nil
+}
+
+func (s *SNS) listTopics(url.Values) (any, error) {
+	type topic struct {
+		TopicARN string `xml:"TopicArn"`
+	}
+	type result struct {
+		Topics []topic `xml:"Topics>member"`
+	}
+
+	var r result
+	for _, t := range s.topics.list(nil) {
+		t.mu.Lock()
+		r.Topics = append(r.Topics, topic{TopicARN: t.attributes["TopicArn"]})
+		t.mu.Unlock()
+	}
+
+	return &r, nil
+}
+
+func (s *SNS) listTagsForResource(form url.Values) (any, error) {
+	t, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newAPIError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	type result struct {
+		Tags []xmlTag `xml:"Tags>member"`
+	}
+
+	return &result{Tags: xmlTags(t.tags)}, nil
+}
+
+func (s *SNS) tagResource(form url.Values) (any, error) {
+	t, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newAPIError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = mergeTags(t.tags, queryMap(form, "Tags.member", "Key", "Value"), nil)
+
+	return nil, nil
+}
+
+func (s *SNS) untagResource(form url.Values) (any, error) {
+	t, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newAPIError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tags = mergeTags(t.tags, nil, queryMembers(form, "TagKeys.member"))
+
+	return nil, nil
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SQS is a fake of the Amazon Simple Queue Service API.
+type SQS struct {
+	jsonService
+
+	queues *store[*sqsQueue]
+}
+
+type sqsQueue struct {
+	name       string
+	url        string
+	attributes map[string]string
+	tags       map[string]string
+
+	mu       sync.Mutex
+	messages []*sqsMessage
+}
+
+type sqsMessage struct {
+	id            string
+	body          string
+	receiptHandle string
+	inFlight      bool
+}
+
+// NewSQS returns a fake of the Amazon Simple Queue Service API.
+func NewSQS() *SQS {
+	s := &SQS{
+		queues: newStore[*sqsQueue](),
+	}
+	s.jsonService = jsonService{
+		targetPrefix: "AmazonSQS",
+		operations: map[string]jsonHandler{
+			"CreateQueue":        jsonOperation(s.createQueue),
+			"DeleteMessage":      jsonOperation(s.deleteMessage),
+			"DeleteQueue":        jsonOperation(s.deleteQueue),
+			"GetQueueAttributes": jsonOperation(s.getQueueAttributes),
+			"GetQueueUrl":        jsonOperation(s.getQueueURL),
+			"ListQueueTags":      jsonOperation(s.listQueueTags),
+			"ListQueues":         jsonOperation(s.listQueues),
+			"ReceiveMessage":     jsonOperation(s.receiveMessage),
+			"SendMessage":        jsonOperation(s.sendMessage),
+			"SetQueueAttributes": jsonOperation(s.setQueueAttributes),
+			"TagQueue":           jsonOperation(s.tagQueue),
+			"UntagQueue":         jsonOperation(s.untagQueue),
+		},
+	}
+
+	return s
+}
+
+func (s *SQS) EndpointID() string {
+	return names.SQS
+}
+
+// defaultSQSQueueAttributes are the attributes of a queue that aren't set when it's created.
+var defaultSQSQueueAttributes = map[string]string{
+	"DelaySeconds":                  "0",
+	"MaximumMessageSize":            "262144",
+	"MessageRetentionPeriod":        "345600",
+	"ReceiveMessageWaitTimeSeconds": "0",
+	"SqsManagedSseEnabled":          "true",
+	"VisibilityTimeout":             "30",
+}
+
+func errQueueDoesNotExist(url string) error {
+	return newAPIError(http.StatusBadRequest, "QueueDoesNotExist", "The specified queue does not exist: %s", url)
+}
+
+func (s *SQS) queue(url string) (*sqsQueue, error) {
+	// Queues are keyed by name.
+	name := url[strings.LastIndex(url, "/")+1:]
+
+	q, ok := s.queues.get(name)
+	if !ok {
+		return nil, errQueueDoesNotExist(url)
+	}
+
+	return q, nil
+}
+
+type sqsCreateQueueInput struct {
+	Attributes map[string]string `json:"Attributes"`
+	QueueName  string            `json:"QueueName"`
+	Tags       map[string]string `json:"tags"`
+}
+
+type sqsQueueURLOutput struct {
+	QueueURL string `json:"QueueUrl"`
+}
+
+func (s *SQS) createQueue(input *sqsCreateQueueInput) (*sqsQueueURLOutput, error) {
+	if input.QueueName == "" {
+		return nil, newAPIError(http.StatusBadRequest, "MissingParameter", "QueueName is required")
+	}
+
+	attributes := maps.Clone(defaultSQSQueueAttributes)
+	maps.Copy(attributes, input.Attributes)
+	timestamp := strconv.FormatInt(now().Unix(), 10)
+	attributes["CreatedTimestamp"] = timestamp
+	attributes["LastModifiedTimestamp"] = timestamp
+	attributes["QueueArn"] = arn("sqs", Region, input.QueueName)
+	if strings.HasSuffix(input.QueueName, ".fifo") {
+		attributes["FifoQueue"] = "true"
+	}
+
+	q := &sqsQueue{
+		name:       input.QueueName,
+		url:        "http://" + names.SQS + hostSuffix + "/" + AccountID + "/" + input.QueueName,
+		attributes: attributes,
+		tags:       mergeTags(nil, input.Tags, nil),
+	}
+
+	if !s.queues.create(q.name, q) {
+		existing, _ := s.queues.get(q.name)
+		existing.mu.Lock()
+		defer existing.mu.Unlock()
+
+		for k, v := range input.Attributes {
+			if existing.attributes[k] != v {
+				return nil, newAPIError(http.StatusBadRequest, "QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+		q = existing
+	}
+
+	return &sqsQueueURLOutput{QueueURL: q.url}, nil
+}
+
+type sqsQueueInput struct {
+	QueueURL string `json:"QueueUrl"`
+}
+
+type sqsEmptyOutput struct{}
+
+func (s *SQS) deleteQueue(input *sqsQueueInput) (*sqsEmptyOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	s.queues.delete(q.name)
+
+	return &sqsEmptyOutput{}, nil
+}
+
+type sqsGetQueueAttributesInput struct {
+	AttributeNames []string `json:"AttributeNames"`
+	QueueURL       string   `json:"QueueUrl"`
+}
+
+type sqsGetQueueAttributesOutput struct {
+	Attributes map[string]string `json:"Attributes,omitempty"`
+}
+
+func (s *SQS) getQueueAttributes(input *sqsGetQueueAttributesInput) (*sqsGetQueueAttributesOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	attributes := maps.Clone(q.attributes)
+	attributes["ApproximateNumberOfMessages"] = strconv.Itoa(len(q.messages))
+	attributes["ApproximateNumberOfMessagesDelayed"] = "0"
+	attributes["ApproximateNumberOfMessagesNotVisible"] = "0"
+
+	if !slices.Contains(input.AttributeNames, "All") {
+		selected := make(map[string]string, len(input.AttributeNames))
+		for _, k := range input.AttributeNames {
+			if v, ok := attributes[k]; ok {
+				selected[k] = v
+			}
+		}
+		attributes = selected
+	}
+
+	return &sqsGetQueueAttributesOutput{Attributes: attributes}, nil
+}
+
+type sqsSetQueueAttributesInput struct {
+	Attributes map[string]string `json:"Attributes"`
+	QueueURL   string            `json:"QueueUrl"`
+}
+
+func (s *SQS) setQueueAttributes(input *sqsSetQueueAttributesInput) (*sqsEmptyOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for k, v := range input.Attributes {
+		// An empty policy or redrive policy removes it.
+		if v == "" && (k == "Policy" || k == "RedrivePolicy" || k == "RedriveAllowPolicy") {
+			delete(q.attributes, k)
+			continue
+		}
+		q.attributes[k] = v
+	}
+	q.attributes["LastModifiedTimestamp"] = strconv.FormatInt(now().Unix(), 10)
+
+	return &sqsEmptyOutput{}, nil
+}
+
+type sqsGetQueueURLInput struct {
+	QueueName string `json:"QueueName"`
+}
+
+func (s *SQS) getQueueURL(input *sqsGetQueueURLInput) (*sqsQueueURLOutput, error) {
+	q, ok := s.queues.get(input.QueueName)
+	if !ok {
+		return nil, errQueueDoesNotExist(input.QueueName)
+	}
+
+	return &sqsQueueURLOutput{QueueURL: q.url}, nil
+}
+
+type sqsListQueuesInput struct {
+	QueueNamePrefix string `json:"QueueNamePrefix"`
+}
+
+type sqsListQueuesOutput struct {
+	QueueURLs []string `json:"QueueUrls,omitempty"`
+}
+
+func (s *SQS) listQueues(input *sqsListQueuesInput) (*sqsListQueuesOutput, error) {
+	var output sqsListQueuesOutput
+
+	for _, q := range s.queues.list(func(name string, _ *sqsQueue) bool {
+		return strings.HasPrefix(name, input.QueueNamePrefix)
+	}) {
+		output.QueueURLs = append(output.QueueURLs, q.url)
+	}
+
+	return &output, nil
+}
+
+type sqsListQueueTagsOutput struct {
+	Tags map[string]string `json:"Tags,omitempty"`
+}
+
+func (s *SQS) listQueueTags(input *sqsQueueInput) (*sqsListQueueTagsOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return &sqsListQueueTagsOutput{Tags: maps.Clone(q.tags)}, nil
+}
+
+type sqsTagQueueInput struct {
+	QueueURL string            `json:"QueueUrl"`
+	Tags     map[string]string `json:"Tags"`
+}
+
+func (s *SQS) tagQueue(input *sqsTagQueueInput) (*sqsEmptyOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.tags = mergeTags(q.tags, input.Tags, nil)
+
+	return &sqsEmptyOutput{}, nil
+}
+
+type sqsUntagQueueInput struct {
+	QueueURL string   `json:"QueueUrl"`
+	TagKeys  []string `json:"TagKeys"`
+}
+
+func (s *SQS) untagQueue(input *sqsUntagQueueInput) (*sqsEmptyOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.tags = mergeTags(q.tags, nil, input.TagKeys)
+
+	return &sqsEmptyOutput{}, nil
+}
+
+type sqsSendMessageInput struct {
+	MessageBody string `json:"MessageBody"`
+	QueueURL    string `json:"QueueUrl"`
+}
+
+type sqsSendMessageOutput struct {
+	MessageID string `json:"MessageId"`
+}
+
+func (s *SQS) sendMessage(input *sqsSendMessageInput) (*sqsSendMessageOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	m := &sqsMessage{
+		id:   newRequestID(),
+		body: input.MessageBody,
+	}
+	q.messages = append(q.messages, m)
+
+	return &sqsSendMessageOutput{MessageID: m.id}, nil
+}
+
+type sqsReceiveMessageInput struct {
+	MaxNumberOfMessages int    `json:"MaxNumberOfMessages"`
+	QueueURL            string `json:"QueueUrl"`
+}
+
+type sqsReceiveMessageOutput struct {
+	Messages []sqsMessageOutput `json:"Messages,omitempty"`
+}
+
+type sqsMessageOutput struct {
+	Body          string `json:"Body"`
+	MessageID     string `json:"MessageId"`
+	ReceiptHandle string `json:"ReceiptHandle"`
+}
+
+// receiveMessage returns messages that haven't been received. Received messages never become visible again.
+func (s *SQS) receiveMessage(input *sqsReceiveMessageInput) (*sqsReceiveMessageOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := max(input.MaxNumberOfMessages, 1)
+	var output sqsReceiveMessageOutput
+	for _, m := range q.messages {
+		if len(output.Messages) == n {
+			break
+		}
+		if m.inFlight {
+			continue
+		}
+
+		m.inFlight = true
+		m.receiptHandle = newID("", 64)
+		output.Messages = append(output.Messages, sqsMessageOutput{
+			Body:          m.body,
+			MessageID:     m.id,
+			ReceiptHandle: m.receiptHandle,
+		})
+	}
+
+	return &output, nil
+}
+
+type sqsDeleteMessageInput struct {
+	QueueURL      string `json:"QueueUrl"`
+	ReceiptHandle string `json:"ReceiptHandle"`
+}
+
+func (s *SQS) deleteMessage(input *sqsDeleteMessageInput) (*sqsEmptyOutput, error) {
+	q, err := s.queue(input.QueueURL)
+	if err != nil {
+		return nil, err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.messages = slices.DeleteFunc(q.messages, func(m *sqsMessage) bool {
+		return m.receiptHandle != "" && m.receiptHandle == input.ReceiptHandle
+	})
+
+	return &sqsEmptyOutput{}, nil
+}
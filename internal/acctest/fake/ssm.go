@@ -0,0 +1,506 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"encoding/base64"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// SSM is a fake of the AWS Systems Manager API. It supports Parameter Store parameters.
+type SSM struct {
+	jsonService
+
+	parameters *store[*ssmParameter]
+}
+
+type ssmParameter struct {
+	mu               sync.Mutex
+	name             string
+	arn              string
+	parameterType    string
+	value            string
+	description      string
+	keyID            string
+	tier             string
+	allowedPattern   string
+	dataType         string
+	version          int64
+	lastModifiedDate time.Time
+	tags             map[string]string
+}
+
+// NewSSM returns a fake of the AWS Systems Manager API.
+func NewSSM() *SSM {
+	s := &SSM{
+		parameters: newStore[*ssmParameter](),
+	}
+	s.jsonService = jsonService{
+		targetPrefix: "AmazonSSM",
+		operations: map[string]jsonHandler{
+			"AddTagsToResource":      jsonOperation(s.addTagsToResource),
+			"DeleteParameter":        jsonOperation(s.deleteParameter),
+			"DeleteParameters":       jsonOperation(s.deleteParameters),
+			"DescribeParameters":     jsonOperation(s.describeParameters),
+			"GetParameter":           jsonOperation(s.getParameter),
+			"GetParameters":          jsonOperation(s.getParameters),
+			"GetParametersByPath":    jsonOperation(s.getParametersByPath),
+			"ListTagsForResource":    jsonOperation(s.listTagsForResource),
+			"PutParameter":           jsonOperation(s.putParameter),
+			"RemoveTagsFromResource": jsonOperation(s.removeTagsFromResource),
+		},
+	}
+
+	return s
+}
+
+func (s *SSM) EndpointID() string {
+	return names.SSM
+}
+
+func errParameterNotFound() error {
+	return newAPIError(http.StatusBadRequest, "ParameterNotFound", "")
+}
+
+func (s *SSM) parameter(name string) (*ssmParameter, error) {
+	p, ok := s.parameters.get(name)
+	if !ok {
+		return nil, errParameterNotFound()
+	}
+
+	return p, nil
+}
+
+// ssmParameterOutput is a parameter in a JSON response.
+type ssmParameterOutput struct {
+	ARN              string  `json:"ARN"`
+	DataType         string  `json:"DataType"`
+	LastModifiedDate float64 `json:"LastModifiedDate"`
+	Name             string  `json:"Name"`
+	Type             string  `json:"Type"`
+	Value            string  `json:"Value"`
+	Version          int64   `json:"Version"`
+}
+
+// output returns the parameter's JSON representation. The caller must hold the parameter's lock.
+func (p *ssmParameter) output(withDecryption bool) ssmParameterOutput {
+	value := p.value
+	if p.parameterType == "SecureString" && !withDecryption {
+		value = base64.StdEncoding.EncodeToString([]byte(p.keyID + ":" + p.value))
+	}
+
+	return ssmParameterOutput{
+		ARN:              p.arn,
+		DataType:         p.dataType,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		Name:             p.name,
+		Type:             p.parameterType,
+		Value:            value,
+		Version:          p.version,
+	}
+}
+
+type ssmParameterMetadataOutput struct {
+	ARN              string   `json:"ARN"`
+	AllowedPattern   string   `json:"AllowedPattern,omitempty"`
+	DataType         string   `json:"DataType"`
+	Description      string   `json:"Description,omitempty"`
+	KeyID            string   `json:"KeyId,omitempty"`
+	LastModifiedDate float64  `json:"LastModifiedDate"`
+	LastModifiedUser string   `json:"LastModifiedUser"`
+	Name             string   `json:"Name"`
+	Policies         []string `json:"Policies"`
+	Tier             string   `json:"Tier"`
+	Type             string   `json:"Type"`
+	Version          int64    `json:"Version"`
+}
+
+// metadata returns the parameter's metadata. The caller must hold the parameter's lock.
+func (p *ssmParameter) metadata() ssmParameterMetadataOutput {
+	return ssmParameterMetadataOutput{
+		ARN:              p.arn,
+		AllowedPattern:   p.allowedPattern,
+		DataType:         p.dataType,
+		Description:      p.description,
+		KeyID:            p.keyID,
+		LastModifiedDate: epochSeconds(p.lastModifiedDate),
+		LastModifiedUser: arn("iam", "", "user/fake"),
+		Name:             p.name,
+		Policies:         []string{},
+		Tier:             p.tier,
+		Type:             p.parameterType,
+		Version:          p.version,
+	}
+}
+
+type ssmPutParameterInput struct {
+	AllowedPattern string    `json:"AllowedPattern"`
+	DataType       string    `json:"DataType"`
+	Description    *string   `json:"Description"`
+	KeyID          string    `json:"KeyId"`
+	Name           string    `json:"Name"`
+	Overwrite      bool      `json:"Overwrite"`
+	Tags           []jsonTag `json:"Tags"`
+	Tier           string    `json:"Tier"`
+	Type           string    `json:"Type"`
+	Value          string    `json:"Value"`
+}
+
+type ssmPutParameterOutput struct {
+	Tier    string `json:"Tier"`
+	Version int64  `json:"Version"`
+}
+
+func (s *SSM) putParameter(input *ssmPutParameterInput) (*ssmPutParameterOutput, error) {
+	if input.Name == "" || input.Value == "" {
+		return nil, errValidation("Name and Value are required")
+	}
+
+	if p, ok := s.parameters.get(input.Name); ok {
+		if !input.Overwrite {
+			return nil, newAPIError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+		}
+		if len(input.Tags) > 0 {
+			return nil, errValidation("Invalid request: tags and overwrite can't be used together.")
+		}
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if input.Type != "" {
+			p.parameterType = input.Type
+		}
+		p.value = input.Value
+		if input.Description != nil {
+			p.description = *input.Description
+		}
+		if input.KeyID != "" {
+			p.keyID = input.KeyID
+		}
+		if input.Tier != "" {
+			p.tier = input.Tier
+		}
+		if input.AllowedPattern != "" {
+			p.allowedPattern = input.AllowedPattern
+		}
+		if input.DataType != "" {
+			p.dataType = input.DataType
+		}
+		p.version++
+		p.lastModifiedDate = now()
+
+		return &ssmPutParameterOutput{Tier: p.tier, Version: p.version}, nil
+	}
+
+	if input.Type == "" {
+		return nil, errValidation("A parameter type is required when you create a parameter.")
+	}
+
+	p := &ssmParameter{
+		name:             input.Name,
+		arn:              arn("ssm", Region, "parameter/"+strings.TrimPrefix(input.Name, "/")),
+		parameterType:    input.Type,
+		value:            input.Value,
+		allowedPattern:   input.AllowedPattern,
+		dataType:         input.DataType,
+		keyID:            input.KeyID,
+		tier:             input.Tier,
+		version:          1,
+		lastModifiedDate: now(),
+		tags:             tagsFromJSON(input.Tags),
+	}
+	if input.Description != nil {
+		p.description = *input.Description
+	}
+	if p.dataType == "" {
+		p.dataType = "text"
+	}
+	if p.tier == "" || p.tier == "Intelligent-Tiering" {
+		p.tier = "Standard"
+	}
+	if p.parameterType == "SecureString" && p.keyID == "" {
+		p.keyID = "alias/aws/ssm"
+	}
+
+	if !s.parameters.create(p.name, p) {
+		return nil, newAPIError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists.")
+	}
+
+	return &ssmPutParameterOutput{Tier: p.tier, Version: p.version}, nil
+}
+
+type ssmGetParameterInput struct {
+	Name           string `json:"Name"`
+	WithDecryption bool   `json:"WithDecryption"`
+}
+
+type ssmGetParameterOutput struct {
+	Parameter ssmParameterOutput `json:"Parameter"`
+}
+
+func (s *SSM) getParameter(input *ssmGetParameterInput) (*ssmGetParameterOutput, error) {
+	// The name may be qualified with a version or label, e.g. name:3.
+	name, _, _ := strings.Cut(input.Name, ":")
+	if strings.HasPrefix(input.Name, "arn:") {
+		name = input.Name
+	}
+
+	p, err := s.parameter(name)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return &ssmGetParameterOutput{Parameter: p.output(input.WithDecryption)}, nil
+}
+
+type ssmGetParametersInput struct {
+	Names          []string `json:"Names"`
+	WithDecryption bool     `json:"WithDecryption"`
+}
+
+type ssmGetParametersOutput struct {
+	InvalidParameters []string             `json:"InvalidParameters"`
+	Parameters        []ssmParameterOutput `json:"Parameters"`
+}
+
+func (s *SSM) getParameters(input *ssmGetParametersInput) (*ssmGetParametersOutput, error) {
+	output := &ssmGetParametersOutput{
+		InvalidParameters: []string{},
+		Parameters:        []ssmParameterOutput{},
+	}
+
+	for _, name := range input.Names {
+		p, ok := s.parameters.get(name)
+		if !ok {
+			output.InvalidParameters = append(output.InvalidParameters, name)
+			continue
+		}
+
+		p.mu.Lock()
+		output.Parameters = append(output.Parameters, p.output(input.WithDecryption))
+		p.mu.Unlock()
+	}
+
+	return output, nil
+}
+
+type ssmGetParametersByPathInput struct {
+	Path           string `json:"Path"`
+	Recursive      bool   `json:"Recursive"`
+	WithDecryption bool   `json:"WithDecryption"`
+}
+
+type ssmGetParametersByPathOutput struct {
+	Parameters []ssmParameterOutput `json:"Parameters"`
+}
+
+func (s *SSM) getParametersByPath(input *ssmGetParametersByPathInput) (*ssmGetParametersByPathOutput, error) {
+	prefix := strings.TrimSuffix(input.Path, "/") + "/"
+	output := &ssmGetParametersByPathOutput{
+		Parameters: []ssmParameterOutput{},
+	}
+
+	for _, p := range s.parameters.list(func(name string, _ *ssmParameter) bool {
+		rest, ok := strings.CutPrefix(name, prefix)
+		return ok && (input.Recursive || !strings.Contains(rest, "/"))
+	}) {
+		p.mu.Lock()
+		output.Parameters = append(output.Parameters, p.output(input.WithDecryption))
+		p.mu.Unlock()
+	}
+
+	return output, nil
+}
+
+type ssmParameterNameInput struct {
+	Name string `json:"Name"`
+}
+
+type ssmEmptyOutput struct{}
+
+func (s *SSM) deleteParameter(input *ssmParameterNameInput) (*ssmEmptyOutput, error) {
+	if !s.parameters.delete(input.Name) {
+		return nil, errParameterNotFound()
+	}
+
+	return &ssmEmptyOutput{}, nil
+}
+
+type ssmDeleteParametersInput struct {
+	Names []string `json:"Names"`
+}
+
+type ssmDeleteParametersOutput struct {
+	DeletedParameters []string `json:"DeletedParameters"`
+	InvalidParameters []string `json:"InvalidParameters"`
+}
+
+func (s *SSM) deleteParameters(input *ssmDeleteParametersInput) (*ssmDeleteParametersOutput, error) {
+	output := &ssmDeleteParametersOutput{
+		DeletedParameters: []string{},
+		InvalidParameters: []string{},
+	}
+
+	for _, name := range input.Names {
+		if s.parameters.delete(name) {
+			output.DeletedParameters = append(output.DeletedParameters, name)
+		} else {
+			output.InvalidParameters = append(output.InvalidParameters, name)
+		}
+	}
+
+	return output, nil
+}
+
+type ssmDescribeParametersInput struct {
+	Filters []struct {
+		Key    string   `json:"Key"`
+		Values []string `json:"Values"`
+	} `json:"Filters"`
+	ParameterFilters []struct {
+		Key    string   `json:"Key"`
+		Option string   `json:"Option"`
+		Values []string `json:"Values"`
+	} `json:"ParameterFilters"`
+}
+
+type ssmDescribeParametersOutput struct {
+	Parameters []ssmParameterMetadataOutput `json:"Parameters"`
+}
+
+// describeParameters supports filtering by name and type.
+func (s *SSM) describeParameters(input *ssmDescribeParametersInput) (*ssmDescribeParametersOutput, error) {
+	type filter struct {
+		key, option string
+		values      []string
+	}
+
+	var filters []filter
+	for _, v := range input.Filters {
+		filters = append(filters, filter{key: v.Key, option: "Equals", values: v.Values})
+	}
+	for _, v := range input.ParameterFilters {
+		option := v.Option
+		if option == "" {
+			option = "Equals"
+		}
+		filters = append(filters, filter{key: v.Key, option: option, values: v.Values})
+	}
+
+	output := &ssmDescribeParametersOutput{
+		Parameters: []ssmParameterMetadataOutput{},
+	}
+
+	for _, p := range s.parameters.list(nil) {
+		p.mu.Lock()
+
+		match := true
+		for _, f := range filters {
+			var v string
+			switch f.key {
+			case "Name":
+				v = p.name
+			case "Type":
+				v = p.parameterType
+			case "KeyId":
+				v = p.keyID
+			case "Tier":
+				v = p.tier
+			default:
+				p.mu.Unlock()
+				return nil, newAPIError(http.StatusBadRequest, "InvalidFilterKey", "unsupported filter key: %s", f.key)
+			}
+
+			if !slices.ContainsFunc(f.values, func(value string) bool {
+				if f.option == "BeginsWith" {
+					return strings.HasPrefix(v, value)
+				}
+				return v == value
+			}) {
+				match = false
+			}
+		}
+
+		if match {
+			output.Parameters = append(output.Parameters, p.metadata())
+		}
+
+		p.mu.Unlock()
+	}
+
+	return output, nil
+}
+
+type ssmResourceInput struct {
+	ResourceID   string    `json:"ResourceId"`
+	ResourceType string    `json:"ResourceType"`
+	TagKeys      []string  `json:"TagKeys"`
+	Tags         []jsonTag `json:"Tags"`
+}
+
+func (s *SSM) taggedParameter(input *ssmResourceInput) (*ssmParameter, error) {
+	if input.ResourceType != "Parameter" {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidResourceType", "unsupported resource type: %s", input.ResourceType)
+	}
+
+	p, ok := s.parameters.get(input.ResourceID)
+	if !ok {
+		return nil, newAPIError(http.StatusBadRequest, "InvalidResourceId", "The resource ID %s is not valid.", input.ResourceID)
+	}
+
+	return p, nil
+}
+
+type ssmListTagsForResourceOutput struct {
+	TagList []jsonTag `json:"TagList"`
+}
+
+func (s *SSM) listTagsForResource(input *ssmResourceInput) (*ssmListTagsForResourceOutput, error) {
+	p, err := s.taggedParameter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return &ssmListTagsForResourceOutput{TagList: jsonTags(p.tags)}, nil
+}
+
+func (s *SSM) addTagsToResource(input *ssmResourceInput) (*ssmEmptyOutput, error) {
+	p, err := s.taggedParameter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tags = mergeTags(p.tags, tagsFromJSON(input.Tags), nil)
+
+	return &ssmEmptyOutput{}, nil
+}
+
+func (s *SSM) removeTagsFromResource(input *ssmResourceInput) (*ssmEmptyOutput, error) {
+	p, err := s.taggedParameter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tags = mergeTags(p.tags, nil, input.TagKeys)
+
+	return &ssmEmptyOutput{}, nil
+}
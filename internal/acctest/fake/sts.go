@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fake
+
+import (
+	"net/url"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sts is a fake of the AWS Security Token Service API that reports the fake caller identity.
+type sts struct {
+	queryService
+}
+
+func newSTS() *sts {
+	s := &sts{}
+	s.queryService = queryService{
+		xmlns: "https://sts.amazonaws.com/doc/2011-06-15/",
+		operations: map[string]queryHandler{
+			"GetCallerIdentity": s.getCallerIdentity,
+		},
+	}
+
+	return s
+}
+
+func (s *sts) EndpointID() string {
+	return names.STS
+}
+
+func (s *sts) getCallerIdentity(url.Values) (any, error) {
+	type result struct {
+		Account string `xml:"Account"`
+		ARN     string `xml:"Arn"`
+		UserID  string `xml:"UserId"`
+	}
+
+	return &result{
+		Account: AccountID,
+		ARN:     arn("iam", "", "user/fake"),
+		UserID:  "AIDACKCEVSQ6C2EXAMPLE",
+	}, nil
+}
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -34,7 +36,22 @@ func CheckFrameworkResourceDisappears(
 	factory func(context.Context) (fwresource.ResourceWithConfigure, error),
 	n string,
 ) resource.TestCheckFunc {
-	return deleteFrameworkResource(ctx, provo, factory, n, rootStringStateFunc())
+	return deleteFrameworkResource(ctx, provo.Meta, factory, n, rootStringStateFunc())
+}
+
+// CheckFrameworkResourceDisappearsVCR is CheckFrameworkResourceDisappears for tests run with
+// ParallelTest or Test. The resource is deleted using the test's own provider instance state
+// (see ProviderMeta), so that when VCR is enabled the deletion is recorded in, and replayed from,
+// the test's cassette.
+func CheckFrameworkResourceDisappearsVCR(
+	ctx context.Context,
+	t *testing.T,
+	factory func(context.Context) (fwresource.ResourceWithConfigure, error),
+	n string,
+) resource.TestCheckFunc {
+	t.Helper()
+
+	return deleteFrameworkResource(ctx, func() any { return ProviderMeta(ctx, t) }, factory, n, rootStringStateFunc())
 }
 
 // CheckFrameworkResourceDisappearsWithStateFunc destroys an existing resource
@@ -46,12 +63,12 @@ func CheckFrameworkResourceDisappearsWithStateFunc(
 	n string,
 	stateFunc func(ctx context.Context, state *tfsdk.State, is *terraform.InstanceState) error,
 ) resource.TestCheckFunc {
-	return deleteFrameworkResource(ctx, provo, factory, n, stateFunc)
+	return deleteFrameworkResource(ctx, provo.Meta, factory, n, stateFunc)
 }
 
 func deleteFrameworkResource(
 	ctx context.Context,
-	provo *schema.Provider,
+	meta func() any,
 	factory func(context.Context) (fwresource.ResourceWithConfigure, error),
 	n string,
 	stateFunc func(ctx context.Context, state *tfsdk.State, is *terraform.InstanceState) error,
@@ -71,7 +88,7 @@ func deleteFrameworkResource(
 			return err
 		}
 
-		resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: provo.Meta()}, &fwresource.ConfigureResponse{})
+		resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: meta()}, &fwresource.ConfigureResponse{})
 
 		schemaResp := fwresource.SchemaResponse{}
 		resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
@@ -113,3 +130,93 @@ func rootStringStateFunc() func(ctx context.Context, state *tfsdk.State, is *ter
 		return nil
 	}
 }
+
+// CheckFrameworkResourceStateCompatibility checks that resource instance attributes recorded in Terraform state
+// by a Plugin SDK resource at the specified schema version can be upgraded (if necessary) by the equivalent
+// Plugin Framework resource and read into the resource's model of type T.
+func CheckFrameworkResourceStateCompatibility[T any](
+	ctx context.Context,
+	t *testing.T,
+	factory func(context.Context) (fwresource.ResourceWithConfigure, error),
+	schemaVersion int64,
+	attributes []byte,
+) {
+	t.Helper()
+
+	r, err := factory(ctx)
+	if err != nil {
+		t.Fatalf("creating resource: %s", err)
+	}
+
+	schemaResp := fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	if err := fwdiag.DiagnosticsError(schemaResp.Diagnostics); err != nil {
+		t.Fatalf("resource schema: %s", err)
+	}
+	s := schemaResp.Schema
+
+	var state tfsdk.State
+	if version := s.GetVersion(); schemaVersion < version {
+		v, ok := r.(fwresource.ResourceWithUpgradeState)
+		if !ok {
+			t.Fatalf("recorded schema version (%d) is less than resource schema version (%d) but resource does not upgrade state", schemaVersion, version)
+		}
+
+		upgrader, ok := v.UpgradeState(ctx)[schemaVersion]
+		if !ok {
+			t.Fatalf("no state upgrader for schema version %d", schemaVersion)
+		}
+
+		request := fwresource.UpgradeStateRequest{
+			RawState: &tfprotov6.RawState{JSON: attributes},
+		}
+		if priorSchema := upgrader.PriorSchema; priorSchema != nil {
+			raw, err := tftypes.ValueFromJSONWithOpts(attributes, priorSchema.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+			if err != nil {
+				t.Fatalf("decoding state with prior schema: %s", err)
+			}
+
+			request.State = &tfsdk.State{
+				Raw:    raw,
+				Schema: priorSchema,
+			}
+		}
+
+		response := fwresource.UpgradeStateResponse{
+			State: tfsdk.State{
+				Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+				Schema: s,
+			},
+		}
+		upgrader.StateUpgrader(ctx, request, &response)
+		if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+			t.Fatalf("upgrading state from schema version %d: %s", schemaVersion, err)
+		}
+
+		if v := response.DynamicValue; v != nil {
+			if v.JSON == nil {
+				t.Fatal("upgraded state is not JSON")
+			}
+			attributes = v.JSON
+		} else {
+			state = response.State
+		}
+	}
+
+	if state.Raw.IsNull() {
+		raw, err := tftypes.ValueFromJSONWithOpts(attributes, s.Type().TerraformType(ctx), tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true})
+		if err != nil {
+			t.Fatalf("decoding state: %s", err)
+		}
+
+		state = tfsdk.State{
+			Raw:    raw,
+			Schema: s,
+		}
+	}
+
+	var data T
+	if err := fwdiag.DiagnosticsError(state.Get(ctx, &data)); err != nil {
+		t.Fatalf("reading state into %T: %s", data, err)
+	}
+}
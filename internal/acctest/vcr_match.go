@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// vcrIdempotencyTokenMembers are the request members whose values are generated afresh for each request.
+// They are ignored, case-insensitively, when matching requests to recorded interactions.
+var vcrIdempotencyTokenMembers = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+func isVCRIdempotencyTokenMember(name string) bool {
+	return slices.ContainsFunc(vcrIdempotencyTokenMembers, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrUnorderedListMembers are the request members, matched case-insensitively, whose list elements
+// are compared ignoring order when matching requests to recorded interactions.
+// The elements of all other lists must be in the recorded order.
+// Tags are serialized from Go maps, and so in no particular order.
+var vcrUnorderedListMembers = struct {
+	sync.Mutex
+	members []string
+}{
+	members: []string{
+		"Tag",
+		"TagKeys",
+		"TagList",
+		"Tags",
+		"TagSet",
+	},
+}
+
+// AddVCRUnorderedListMembers registers request members whose list elements are compared ignoring order
+// when matching requests to recorded interactions, e.g. lists that the provider builds from a set or a map.
+// Call it from a service package's TestMain or an init function.
+func AddVCRUnorderedListMembers(names ...string) {
+	vcrUnorderedListMembers.Lock()
+	defer vcrUnorderedListMembers.Unlock()
+
+	vcrUnorderedListMembers.members = append(vcrUnorderedListMembers.members, names...)
+}
+
+func isVCRUnorderedListMember(name string) bool {
+	vcrUnorderedListMembers.Lock()
+	defer vcrUnorderedListMembers.Unlock()
+
+	return slices.ContainsFunc(vcrUnorderedListMembers.members, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrMatcher returns the function that defines how VCR matches requests to recorded interactions.
+func vcrMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsMatch(r.URL.String(), i.URL) {
+			return false
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return i.Body == ""
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+		r.Body = io.NopCloser(&b)
+
+		match, err := vcrBodiesMatch(r.Header.Get("Content-Type"), b.String(), i.Body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request body with cassette", map[string]any{
+				"error": err,
+			})
+		}
+
+		return match
+	}
+}
+
+// vcrURLsMatch returns whether two request URLs match, ignoring the order of query string parameters,
+// the order of the values of unordered list members and idempotency tokens.
+func vcrURLsMatch(requestURL, cassetteURL string) bool {
+	if requestURL == cassetteURL {
+		return true
+	}
+
+	u1, err := url.Parse(requestURL)
+	if err != nil {
+		return false
+	}
+	u2, err := url.Parse(cassetteURL)
+	if err != nil {
+		return false
+	}
+
+	if u1.Scheme != u2.Scheme || u1.Host != u2.Host || u1.EscapedPath() != u2.EscapedPath() {
+		return false
+	}
+
+	q1, q2 := u1.Query(), u2.Query()
+	for _, q := range []url.Values{q1, q2} {
+		for k, v := range q {
+			if isVCRIdempotencyTokenMember(k) {
+				delete(q, k)
+			} else if isVCRUnorderedListMember(k) {
+				slices.Sort(v)
+			}
+		}
+	}
+
+	return reflect.DeepEqual(q1, q2)
+}
+
+// vcrBodiesMatch returns whether two request bodies match.
+// Bodies in the AWS protocols' serializations (https://smithy.io/2.0/aws/protocols/index.html) match if they are
+// equivalent ignoring member order, the element order of unordered list members and idempotency tokens.
+func vcrBodiesMatch(contentType, requestBody, cassetteBody string) (bool, error) {
+	if requestBody == cassetteBody {
+		return true, nil
+	}
+
+	var normalize func(string) (any, error)
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// awsJson1_0, awsJson1_1 and restJson1.
+		normalize = normalizeVCRJSON
+	case "application/x-www-form-urlencoded":
+		// awsQuery and ec2Query.
+		normalize = normalizeVCRQuery
+	case "application/xml", "text/xml":
+		// restXml.
+		normalize = normalizeVCRXML
+	default:
+		return false, nil
+	}
+
+	v1, err := normalize(requestBody)
+	if err != nil {
+		return false, err
+	}
+	v2, err := normalize(cassetteBody)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(v1, v2), nil
+}
+
+func normalizeVCRJSON(body string) (any, error) {
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return normalizeVCRValue("", v), nil
+}
+
+// normalizeVCRValue removes idempotency tokens from a decoded value and sorts the elements of unordered list members.
+// name is the name of the member whose value v is.
+func normalizeVCRValue(name string, v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isVCRIdempotencyTokenMember(k) {
+				delete(v, k)
+			} else if k == "member" || k == "entry" {
+				// awsQuery list and map elements are named after their member.
+				v[k] = normalizeVCRValue(name, e)
+			} else {
+				v[k] = normalizeVCRValue(k, e)
+			}
+		}
+		return v
+
+	case []any:
+		for j, e := range v {
+			v[j] = normalizeVCRValue(name, e)
+		}
+		if isVCRUnorderedListMember(name) {
+			sortVCRValues(v)
+		}
+		return v
+
+	default:
+		return v
+	}
+}
+
+// normalizeVCRQuery converts a query protocol body, e.g. "Action=X&Tags.member.1.Key=k&Tags.member.1.Value=v",
+// into a tree of maps keyed by name and lists of elements indexed by number, then normalizes it.
+func normalizeVCRQuery(body string) (any, error) {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return nil, err
+	}
+
+	root := make(map[string]any)
+	for k, vs := range values {
+		node := root
+		parts := strings.Split(k, ".")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[part] = child
+			}
+			node = child
+		}
+
+		leaf := make([]any, len(vs))
+		for j, v := range vs {
+			leaf[j] = v
+		}
+		node[parts[len(parts)-1]] = leaf
+	}
+
+	return normalizeVCRValue("", vcrQueryLists(root)), nil
+}
+
+// vcrQueryLists replaces maps whose keys are all list indexes with lists, in index order.
+func vcrQueryLists(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	list := len(m) > 0
+	indexes := make(map[string]int, len(m))
+	for k, e := range m {
+		m[k] = vcrQueryLists(e)
+		if index, err := strconv.Atoi(k); err != nil {
+			list = false
+		} else {
+			indexes[k] = index
+		}
+	}
+
+	if !list {
+		return m
+	}
+
+	keys := slices.SortedFunc(maps.Keys(m), func(a, b string) int {
+		return indexes[a] - indexes[b]
+	})
+	l := make([]any, 0, len(m))
+	for _, k := range keys {
+		l = append(l, m[k])
+	}
+
+	return l
+}
+
+func normalizeVCRXML(body string) (any, error) {
+	d := xml.NewDecoder(strings.NewReader(body))
+
+	// Each element is decoded to a map of its name, attributes, text and child elements.
+	root := map[string]any{"children": []any{}}
+	stack := []map[string]any{root}
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			attrs := make(map[string]any, len(token.Attr))
+			for _, attr := range token.Attr {
+				attrs[attr.Name.Space+":"+attr.Name.Local] = attr.Value
+			}
+			element := map[string]any{
+				"name":     token.Name.Local,
+				"attrs":    attrs,
+				"text":     "",
+				"children": []any{},
+			}
+			if !isVCRIdempotencyTokenMember(token.Name.Local) {
+				parent["children"] = append(parent["children"].([]any), element)
+			}
+			stack = append(stack, element)
+
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			if text := strings.TrimSpace(string(token)); text != "" && len(stack) > 1 {
+				parent["text"] = parent["text"].(string) + text
+			}
+		}
+	}
+
+	normalizeVCRXMLElement(root)
+
+	return root, nil
+}
+
+// normalizeVCRXMLElement sorts an element's children by name, so that member order is ignored,
+// and the children of unordered list members by their content.
+func normalizeVCRXMLElement(element map[string]any) {
+	name, _ := element["name"].(string)
+	children := element["children"].([]any)
+	keys := make(map[int]string, len(children))
+	for j, child := range children {
+		normalizeVCRXMLElement(child.(map[string]any))
+		b, _ := json.Marshal(child)
+		keys[j] = string(b)
+	}
+
+	indexes := make([]int, len(children))
+	for j := range indexes {
+		indexes[j] = j
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		nameA, nameB := children[a].(map[string]any)["name"].(string), children[b].(map[string]any)["name"].(string)
+		if v := strings.Compare(nameA, nameB); v != 0 {
+			return v
+		}
+		// A wrapped list's elements are the children of the member's element, a flattened list's elements are named after the member.
+		if isVCRUnorderedListMember(name) || isVCRUnorderedListMember(nameA) {
+			return strings.Compare(keys[a], keys[b])
+		}
+		return 0
+	})
+
+	sorted := make([]any, len(children))
+	for j, k := range indexes {
+		sorted[j] = children[k]
+	}
+	element["children"] = sorted
+}
+
+// sortVCRValues sorts values by their JSON encoding, which is canonical for normalized values.
+func sortVCRValues(v []any) {
+	keys := make(map[int]string, len(v))
+	for j, e := range v {
+		b, _ := json.Marshal(e)
+		keys[j] = string(b)
+	}
+
+	indexes := make([]int, len(v))
+	for j := range indexes {
+		indexes[j] = j
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return strings.Compare(keys[a], keys[b])
+	})
+
+	sorted := make([]any, len(v))
+	for j, k := range indexes {
+		sorted[j] = v[k]
+	}
+	copy(v, sorted)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// VCRScrubber redacts sensitive values from a recorded interaction before its cassette is saved.
+// Scrubbers must replace values consistently so that the requests made when the cassette is replayed still match.
+type VCRScrubber func(*cassette.Interaction) error
+
+var vcrScrubbers struct {
+	sync.Mutex
+	scrubbers []VCRScrubber
+}
+
+// AddVCRScrubbers registers scrubbers to apply, after the default credential, account ID and caller ARN scrubbers,
+// to every cassette recorded by the test binary. Call it from a service package's TestMain or an init function.
+func AddVCRScrubbers(scrubbers ...VCRScrubber) {
+	vcrScrubbers.Lock()
+	defer vcrScrubbers.Unlock()
+
+	vcrScrubbers.scrubbers = append(vcrScrubbers.scrubbers, scrubbers...)
+}
+
+// VCRScrubRegexp returns a scrubber that replaces matches of the regular expression in request and response URLs, headers and bodies.
+// The replacement may reference submatches as in regexp.Regexp.ReplaceAllString.
+func VCRScrubRegexp(re *regexp.Regexp, replacement string) VCRScrubber {
+	return func(i *cassette.Interaction) error {
+		scrubVCRInteraction(i, func(s string) string {
+			return re.ReplaceAllString(s, replacement)
+		})
+
+		return nil
+	}
+}
+
+var (
+	// vcrAccessKeyIDRegexp matches long-term (AKIA) and temporary (ASIA) access key IDs.
+	vcrAccessKeyIDRegexp = regexache.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)
+	// vcrSecretJSONRegexp and vcrSecretXMLRegexp match secret credential members in JSON and XML bodies.
+	vcrSecretJSONRegexp = regexache.MustCompile(`("(?:SecretAccessKey|SessionToken)"\s*:\s*")[^"]*(")`)
+	vcrSecretXMLRegexp  = regexache.MustCompile(`<(SecretAccessKey|SessionToken)>[^<]*</(SecretAccessKey|SessionToken)>`)
+
+	vcrCallerIdentityAccountRegexp = regexache.MustCompile(`<Account>(\d{12})</Account>`)
+	vcrCallerIdentityARNRegexp     = regexache.MustCompile(`<Arn>(arn:[^:<]+:(?:sts|iam)::\d{12}:[^<]+)</Arn>`)
+	vcrCallerIdentityUserIDRegexp  = regexache.MustCompile(`<UserId>([^<]+)</UserId>`)
+)
+
+// defaultVCRScrubbers returns the scrubbers applied to every recorded cassette, in order.
+// The caller identity scrubber must also observe each interaction as it's captured.
+func defaultVCRScrubbers(callerIdentity *vcrCallerIdentityScrubber) []VCRScrubber {
+	return []VCRScrubber{
+		scrubVCRCredentials,
+		callerIdentity.scrub,
+	}
+}
+
+// scrubVCRCredentials removes request signatures and redacts access key IDs, secret access keys and session tokens.
+func scrubVCRCredentials(i *cassette.Interaction) error {
+	i.Request.Headers.Del("Authorization")
+	i.Request.Headers.Del("X-Amz-Security-Token")
+
+	scrubVCRInteraction(i, func(s string) string {
+		s = vcrAccessKeyIDRegexp.ReplaceAllString(s, "${1}IOSFODNN7EXAMPLE")
+		s = vcrSecretJSONRegexp.ReplaceAllString(s, "${1}REDACTED${2}")
+		s = vcrSecretXMLRegexp.ReplaceAllString(s, "<${1}>REDACTED</${2}>")
+
+		return s
+	})
+
+	return nil
+}
+
+// vcrCallerIdentityScrubber replaces the account IDs, ARNs and user IDs of the callers that made the recorded requests,
+// as reported by STS GetCallerIdentity, with placeholders.
+// Because the provider derives these values from GetCallerIdentity, the requests it makes when the scrubbed cassette
+// is replayed contain the same placeholders.
+type vcrCallerIdentityScrubber struct {
+	mu           sync.Mutex
+	replacements []string // Old, new pairs for strings.NewReplacer, ARNs and user IDs before the account IDs they contain.
+	seen         map[string]struct{}
+	accounts     int
+}
+
+func newVCRCallerIdentityScrubber() *vcrCallerIdentityScrubber {
+	return &vcrCallerIdentityScrubber{
+		seen: make(map[string]struct{}),
+	}
+}
+
+// observe records the caller identity in a GetCallerIdentity response. It doesn't modify the interaction,
+// as the response is still returned to the provider.
+func (s *vcrCallerIdentityScrubber) observe(i *cassette.Interaction) error {
+	body := i.Response.Body
+	if !strings.Contains(body, "<GetCallerIdentityResult>") {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := vcrCallerIdentityAccountRegexp.FindStringSubmatch(body)
+	if m == nil {
+		return nil
+	}
+	accountID := m[1]
+
+	if _, ok := s.seen[accountID]; !ok {
+		s.seen[accountID] = struct{}{}
+		// 123456789012, 123456789013, ... in the order the accounts are seen.
+		placeholder := strconv.Itoa(123456789012 + s.accounts)
+		s.accounts++
+
+		// Replace ARNs and user IDs before the account IDs they contain.
+		if m := vcrCallerIdentityARNRegexp.FindStringSubmatch(body); m != nil {
+			arn := m[1]
+			s.add(arn, vcrCallerARNPlaceholder(arn, accountID, placeholder))
+		}
+		if m := vcrCallerIdentityUserIDRegexp.FindStringSubmatch(body); m != nil && m[1] != accountID {
+			userID := m[1]
+			s.add(userID, vcrCallerUserIDPlaceholder(userID, placeholder))
+		}
+		s.replacements = append(s.replacements, accountID, placeholder)
+	}
+
+	return nil
+}
+
+func (s *vcrCallerIdentityScrubber) add(old, new string) {
+	if _, ok := s.seen[old]; ok {
+		return
+	}
+	s.seen[old] = struct{}{}
+
+	// URL query strings and form-encoded bodies contain the escaped value.
+	s.replacements = append(s.replacements, old, new)
+	if escaped := url.QueryEscape(old); escaped != old {
+		s.replacements = append(s.replacements, escaped, url.QueryEscape(new))
+	}
+}
+
+// scrub replaces all observed caller identities in the interaction.
+func (s *vcrCallerIdentityScrubber) scrub(i *cassette.Interaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.replacements) == 0 {
+		return nil
+	}
+
+	r := strings.NewReplacer(s.replacements...)
+	scrubVCRInteraction(i, r.Replace)
+
+	return nil
+}
+
+// vcrCallerARNPlaceholder returns the placeholder for a caller ARN, preserving its partition, service and principal type.
+func vcrCallerARNPlaceholder(arn, accountID, placeholder string) string {
+	// arn:partition:service::account-id:resource
+	prefix, resource, ok := strings.Cut(arn, "::"+accountID+":")
+	if !ok {
+		return arn
+	}
+
+	switch {
+	case strings.HasPrefix(resource, "assumed-role/"):
+		resource = "assumed-role/vcr-role/vcr-session"
+	case strings.HasPrefix(resource, "user/"):
+		resource = "user/vcr-user"
+	case strings.HasPrefix(resource, "federated-user/"):
+		resource = "federated-user/vcr-user"
+	default:
+		resource = "vcr"
+	}
+
+	return fmt.Sprintf("%s::%s:%s", prefix, placeholder, resource)
+}
+
+// vcrCallerUserIDPlaceholder returns the placeholder for a caller's unique ID, e.g. AIDA... or AROA...:session-name.
+func vcrCallerUserIDPlaceholder(userID, placeholder string) string {
+	if _, _, ok := strings.Cut(userID, ":"); ok {
+		return "AROAVCR" + placeholder + ":vcr-session"
+	}
+
+	return "AIDAVCR" + placeholder
+}
+
+// scrubVCRInteraction applies f to the URL, form values, headers and bodies of the interaction's request and response.
+func scrubVCRInteraction(i *cassette.Interaction, f func(string) string) {
+	i.Request.URL = f(i.Request.URL)
+	i.Request.Body = f(i.Request.Body)
+	i.Response.Body = f(i.Response.Body)
+
+	for _, values := range i.Request.Form {
+		for j, v := range values {
+			values[j] = f(v)
+		}
+	}
+	for _, values := range i.Request.Headers {
+		for j, v := range values {
+			values[j] = f(v)
+		}
+	}
+	for _, values := range i.Response.Headers {
+		for j, v := range values {
+			values[j] = f(v)
+		}
+	}
+}
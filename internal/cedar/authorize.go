@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cedargo "github.com/cedar-policy/cedar-go"
+)
+
+const (
+	DecisionAllow = "ALLOW"
+	DecisionDeny  = "DENY"
+)
+
+// Request is an authorization request in the Cedar CLI's JSON format.
+type Request struct {
+	Principal EntityUID       `json:"principal"`
+	Action    EntityUID       `json:"action"`
+	Resource  EntityUID       `json:"resource"`
+	Context   json.RawMessage `json:"context,omitempty"`
+}
+
+// Response is the result of evaluating an authorization request.
+type Response struct {
+	Decision string
+	// Policies are identified by their position in the policy set, e.g. policy0.
+	DeterminingPolicies []string
+	Errors              []string
+}
+
+// ValidatePolicySyntax checks that the specified text is a syntactically valid set of Cedar policies.
+func ValidatePolicySyntax(policies string) error {
+	if _, err := cedargo.NewPolicySet("", []byte(policies)); err != nil {
+		return fmt.Errorf("parsing Cedar policies: %w", err)
+	}
+
+	return nil
+}
+
+// IsAuthorized evaluates an authorization request, in JSON format, against a set of Cedar policies and entities, in JSON format.
+// If a Cedar schema, in JSON format, is specified the policies and request are validated against it first.
+func IsAuthorized(policies, schema, entities, request string) (*Response, error) {
+	ps, err := cedargo.NewPolicySet("", []byte(policies))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Cedar policies: %w", err)
+	}
+
+	var es cedargo.Entities
+	if err := json.Unmarshal([]byte(entities), &es); err != nil {
+		return nil, fmt.Errorf("parsing Cedar entities: %w", err)
+	}
+
+	var req Request
+	if err := json.Unmarshal([]byte(request), &req); err != nil {
+		return nil, fmt.Errorf("parsing authorization request: %w", err)
+	}
+
+	var context cedargo.Record
+	if len(req.Context) > 0 {
+		if err := json.Unmarshal(req.Context, &context); err != nil {
+			return nil, fmt.Errorf("parsing authorization request context: %w", err)
+		}
+	}
+
+	if schema != "" {
+		s, err := ParseSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.ValidatePolicyScopes(policies); err != nil {
+			return nil, fmt.Errorf("validating Cedar policies: %w", err)
+		}
+
+		if err := s.ValidateRequest(req.Principal, req.Action, req.Resource); err != nil {
+			return nil, fmt.Errorf("validating authorization request: %w", err)
+		}
+	}
+
+	decision, diagnostic := ps.IsAuthorized(es, cedargo.Request{
+		Principal: cedargo.EntityUID{Type: req.Principal.Type, ID: req.Principal.ID},
+		Action:    cedargo.EntityUID{Type: req.Action.Type, ID: req.Action.ID},
+		Resource:  cedargo.EntityUID{Type: req.Resource.Type, ID: req.Resource.ID},
+		Context:   context,
+	})
+
+	resp := &Response{
+		Decision:            DecisionDeny,
+		DeterminingPolicies: make([]string, 0),
+		Errors:              make([]string, 0),
+	}
+	if decision == cedargo.Allow {
+		resp.Decision = DecisionAllow
+	}
+	for _, v := range diagnostic.Reasons {
+		resp.DeterminingPolicies = append(resp.DeterminingPolicies, fmt.Sprintf("policy%d", v.Policy))
+	}
+	for _, v := range diagnostic.Errors {
+		resp.Errors = append(resp.Errors, fmt.Sprintf("policy%d: %s", v.Policy, v.Message))
+	}
+
+	return resp, nil
+}
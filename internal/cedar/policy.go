@@ -0,0 +1,459 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EntityUID uniquely identifies a Cedar entity.
+type EntityUID struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+func (uid EntityUID) String() string {
+	return uid.Type + "::" + strconv.Quote(uid.ID)
+}
+
+// scopeOperator is the operator of a policy scope constraint.
+type scopeOperator string
+
+const (
+	scopeAll  scopeOperator = ""
+	scopeEq   scopeOperator = "=="
+	scopeIn   scopeOperator = "in"
+	scopeIs   scopeOperator = "is"
+	scopeIsIn scopeOperator = "is in"
+)
+
+// scopeConstraint is a principal, action or resource constraint in a policy scope.
+type scopeConstraint struct {
+	operator   scopeOperator
+	entityType string      // For is and is in.
+	entities   []EntityUID // Empty for a template slot.
+}
+
+// policyScope is the scope of a Cedar policy, its head.
+type policyScope struct {
+	line      int
+	principal scopeConstraint
+	action    scopeConstraint
+	resource  scopeConstraint
+}
+
+// ValidatePolicyScopes checks that the scopes of the specified Cedar policies reference the entity types and actions declared in the schema,
+// and that the principal and resource types are valid for at least one of the policy's actions.
+// Policy conditions are not type-checked.
+func (s *Schema) ValidatePolicyScopes(policies string) error {
+	scopes, err := parsePolicyScopes(policies)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, scope := range scopes {
+		if err := s.validatePolicyScope(scope); err != nil {
+			errs = append(errs, fmt.Errorf("policy at line %d: %w", scope.line, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Schema) validatePolicyScope(scope policyScope) error {
+	var errs []error
+
+	// The actions that the policy applies to.
+	var actions []*schemaAction
+	for _, uid := range scope.action.entities {
+		if _, ok := s.actions[uid.String()]; !ok {
+			errs = append(errs, fmt.Errorf("undeclared action (%s)", uid))
+		}
+	}
+	switch scope.action.operator {
+	case scopeAll:
+		for _, a := range s.actions {
+			actions = append(actions, a)
+		}
+	case scopeEq:
+		actions = s.actionsIn(scope.action.entities, false)
+	case scopeIn:
+		actions = s.actionsIn(scope.action.entities, true)
+	}
+
+	for _, v := range []struct {
+		name        string
+		constraint  scopeConstraint
+		actionTypes func(*schemaAction) []string
+	}{
+		{"principal", scope.principal, func(a *schemaAction) []string { return a.principalTypes }},
+		{"resource", scope.resource, func(a *schemaAction) []string { return a.resourceTypes }},
+	} {
+		for _, uid := range v.constraint.entities {
+			if !s.hasEntityType(uid.Type) {
+				errs = append(errs, fmt.Errorf("%s: undeclared entity type (%s)", v.name, uid.Type))
+			}
+		}
+
+		var entityType string
+		switch v.constraint.operator {
+		case scopeEq:
+			if len(v.constraint.entities) > 0 {
+				entityType = v.constraint.entities[0].Type
+			}
+		case scopeIs, scopeIsIn:
+			entityType = v.constraint.entityType
+			if !s.hasEntityType(entityType) {
+				errs = append(errs, fmt.Errorf("%s: undeclared entity type (%s)", v.name, entityType))
+				continue
+			}
+		}
+
+		if entityType == "" || len(actions) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(actions, func(a *schemaAction) bool {
+			return slices.Contains(v.actionTypes(a), entityType)
+		}) {
+			errs = append(errs, fmt.Errorf("%s: entity type (%s) isn't valid for any of the policy's actions", v.name, entityType))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// actionsIn returns the declared actions with the specified UIDs, including their descendants if descendants is true.
+func (s *Schema) actionsIn(uids []EntityUID, descendants bool) []*schemaAction {
+	targets := make(map[string]bool)
+	for _, uid := range uids {
+		targets[uid.String()] = true
+	}
+
+	var actions []*schemaAction
+	for uid, a := range s.actions {
+		if targets[uid] || (descendants && s.isActionDescendant(uid, targets, make(map[string]bool))) {
+			actions = append(actions, a)
+		}
+	}
+
+	return actions
+}
+
+func (s *Schema) isActionDescendant(uid string, ancestors, seen map[string]bool) bool {
+	if seen[uid] {
+		return false
+	}
+	seen[uid] = true
+
+	for _, parent := range s.actions[uid].memberOf {
+		if ancestors[parent] || s.isActionDescendant(parent, ancestors, seen) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parsePolicyScopes parses the scopes of a set of Cedar policies.
+// Syntax errors in policy conditions are not detected.
+func parsePolicyScopes(policies string) ([]policyScope, error) {
+	tokens, err := tokenize(policies)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &scopeParser{tokens: tokens}
+	var scopes []policyScope
+
+	for !p.done() {
+		scope, err := p.policy()
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+
+	return scopes, nil
+}
+
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota
+	tokenString
+	tokenPunct
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+}
+
+// tokenize splits Cedar text into identifiers, string literals and punctuation, discarding whitespace and comments.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	line := 1
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(s[i:], "//"):
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("line %d: unterminated string literal", line)
+			}
+			v, err := strconv.Unquote(s[i : j+1])
+			if err != nil {
+				// Cedar escapes are a subset of Go's; fall back to the raw text.
+				v = s[i+1 : j]
+			}
+			tokens = append(tokens, token{kind: tokenString, value: v, line: line})
+			line += strings.Count(s[i:j+1], "\n")
+			i = j + 1
+		case c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)):
+			j := i
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, value: s[i:j], line: line})
+			i = j
+		default:
+			v := string(c)
+			for _, op := range []string{"::", "==", "!=", "<=", ">=", "&&", "||"} {
+				if strings.HasPrefix(s[i:], op) {
+					v = op
+					break
+				}
+			}
+			tokens = append(tokens, token{kind: tokenPunct, value: v, line: line})
+			i += len(v)
+		}
+	}
+
+	return tokens, nil
+}
+
+type scopeParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *scopeParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *scopeParser) peek() token {
+	if p.done() {
+		return token{}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *scopeParser) next() token {
+	t := p.peek()
+	p.pos++
+
+	return t
+}
+
+func (p *scopeParser) line() int {
+	if p.done() {
+		if len(p.tokens) == 0 {
+			return 1
+		}
+		return p.tokens[len(p.tokens)-1].line
+	}
+
+	return p.peek().line
+}
+
+func (p *scopeParser) expect(kind tokenKind, value string) error {
+	if t := p.next(); t.kind != kind || t.value != value {
+		return fmt.Errorf("line %d: expected %q, got %q", t.line, value, t.value)
+	}
+
+	return nil
+}
+
+func (p *scopeParser) accept(kind tokenKind, value string) bool {
+	if t := p.peek(); !p.done() && t.kind == kind && t.value == value {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *scopeParser) policy() (policyScope, error) {
+	// Annotations.
+	for p.accept(tokenPunct, "@") {
+		p.next()
+		if p.accept(tokenPunct, "(") {
+			p.next()
+			if err := p.expect(tokenPunct, ")"); err != nil {
+				return policyScope{}, err
+			}
+		}
+	}
+
+	scope := policyScope{line: p.line()}
+
+	if t := p.next(); t.kind != tokenIdent || (t.value != "permit" && t.value != "forbid") {
+		return scope, fmt.Errorf("line %d: expected permit or forbid, got %q", t.line, t.value)
+	}
+	if err := p.expect(tokenPunct, "("); err != nil {
+		return scope, err
+	}
+
+	var err error
+	if scope.principal, err = p.constraint("principal"); err != nil {
+		return scope, err
+	}
+	if err := p.expect(tokenPunct, ","); err != nil {
+		return scope, err
+	}
+	if scope.action, err = p.constraint("action"); err != nil {
+		return scope, err
+	}
+	if err := p.expect(tokenPunct, ","); err != nil {
+		return scope, err
+	}
+	if scope.resource, err = p.constraint("resource"); err != nil {
+		return scope, err
+	}
+	if err := p.expect(tokenPunct, ")"); err != nil {
+		return scope, err
+	}
+
+	// Skip any conditions.
+	for depth := 0; ; {
+		if p.done() {
+			return scope, fmt.Errorf("line %d: expected \";\"", p.line())
+		}
+
+		switch t := p.next(); {
+		case t.kind == tokenPunct && (t.value == "(" || t.value == "{" || t.value == "["):
+			depth++
+		case t.kind == tokenPunct && (t.value == ")" || t.value == "}" || t.value == "]"):
+			depth--
+		case t.kind == tokenPunct && t.value == ";" && depth == 0:
+			return scope, nil
+		}
+	}
+}
+
+func (p *scopeParser) constraint(variable string) (scopeConstraint, error) {
+	var c scopeConstraint
+
+	if err := p.expect(tokenIdent, variable); err != nil {
+		return c, err
+	}
+
+	switch {
+	case p.accept(tokenPunct, "=="):
+		c.operator = scopeEq
+		return c, p.entityOrSlot(&c, variable)
+	case p.accept(tokenIdent, "in"):
+		c.operator = scopeIn
+		if variable == "action" && p.accept(tokenPunct, "[") {
+			for !p.accept(tokenPunct, "]") {
+				uid, err := p.entity()
+				if err != nil {
+					return c, err
+				}
+				c.entities = append(c.entities, uid)
+				p.accept(tokenPunct, ",")
+			}
+			return c, nil
+		}
+		return c, p.entityOrSlot(&c, variable)
+	case variable != "action" && p.accept(tokenIdent, "is"):
+		c.operator = scopeIs
+		var err error
+		if c.entityType, err = p.path(); err != nil {
+			return c, err
+		}
+		if p.accept(tokenIdent, "in") {
+			c.operator = scopeIsIn
+			return c, p.entityOrSlot(&c, variable)
+		}
+	}
+
+	return c, nil
+}
+
+func (p *scopeParser) entityOrSlot(c *scopeConstraint, variable string) error {
+	if variable != "action" && p.accept(tokenPunct, "?") {
+		return p.expect(tokenIdent, variable)
+	}
+
+	uid, err := p.entity()
+	if err != nil {
+		return err
+	}
+	c.entities = append(c.entities, uid)
+
+	return nil
+}
+
+// path parses a (possibly namespace-qualified) entity type name.
+func (p *scopeParser) path() (string, error) {
+	var parts []string
+
+	for {
+		t := p.next()
+		if t.kind != tokenIdent {
+			return "", fmt.Errorf("line %d: expected entity type, got %q", t.line, t.value)
+		}
+		parts = append(parts, t.value)
+
+		if p.peek().kind != tokenPunct || p.peek().value != "::" || p.pos+1 >= len(p.tokens) || p.tokens[p.pos+1].kind != tokenIdent {
+			return strings.Join(parts, "::"), nil
+		}
+		p.next()
+	}
+}
+
+// entity parses an entity reference, e.g. PhotoApp::User::"alice".
+func (p *scopeParser) entity() (EntityUID, error) {
+	entityType, err := p.path()
+	if err != nil {
+		return EntityUID{}, err
+	}
+	if err := p.expect(tokenPunct, "::"); err != nil {
+		return EntityUID{}, err
+	}
+
+	t := p.next()
+	if t.kind != tokenString {
+		return EntityUID{}, fmt.Errorf("line %d: expected entity ID, got %q", t.line, t.value)
+	}
+
+	return EntityUID{Type: entityType, ID: t.value}, nil
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cedar
+
+import (
+	"testing"
+)
+
+const testSchema = `{
+  "PhotoApp": {
+    "entityTypes": {
+      "User": {
+        "memberOfTypes": ["UserGroup"]
+      },
+      "UserGroup": {},
+      "Photo": {
+        "memberOfTypes": ["Album"]
+      },
+      "Album": {}
+    },
+    "actions": {
+      "viewPhoto": {
+        "appliesTo": {
+          "principalTypes": ["User"],
+          "resourceTypes": ["Photo"]
+        },
+        "memberOf": [{"id": "read"}]
+      },
+      "listAlbum": {
+        "appliesTo": {
+          "principalTypes": ["User"],
+          "resourceTypes": ["Album"]
+        },
+        "memberOf": [{"id": "read"}]
+      },
+      "read": {}
+    }
+  }
+}`
+
+func TestParseSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema      string
+		expectError bool
+	}{
+		"valid": {
+			schema: testSchema,
+		},
+		"empty namespace": {
+			schema: `{"": {"entityTypes": {"User": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["User"]}}}}}`,
+		},
+		"invalid JSON": {
+			schema:      `{"PhotoApp": `,
+			expectError: true,
+		},
+		"missing actions": {
+			schema:      `{"PhotoApp": {"entityTypes": {}}}`,
+			expectError: true,
+		},
+		"undeclared memberOfTypes": {
+			schema:      `{"PhotoApp": {"entityTypes": {"User": {"memberOfTypes": ["Group"]}}, "actions": {}}}`,
+			expectError: true,
+		},
+		"undeclared principal type": {
+			schema:      `{"PhotoApp": {"entityTypes": {"Photo": {}}, "actions": {"view": {"appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo"]}}}}}`,
+			expectError: true,
+		},
+		"undeclared action group": {
+			schema:      `{"PhotoApp": {"entityTypes": {}, "actions": {"view": {"memberOf": [{"id": "read"}]}}}}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSchema(testCase.schema)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ParseSchema() err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestSchemaValidatePolicyScopes(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ParseSchema(testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		policies    string
+		expectError bool
+	}{
+		"unconstrained": {
+			policies: `permit(principal, action, resource);`,
+		},
+		"equals": {
+			policies: `permit(principal == PhotoApp::User::"alice", action == PhotoApp::Action::"viewPhoto", resource == PhotoApp::Photo::"vacation.jpg");`,
+		},
+		"in": {
+			policies: `permit(principal in PhotoApp::UserGroup::"family", action in [PhotoApp::Action::"viewPhoto", PhotoApp::Action::"listAlbum"], resource in PhotoApp::Album::"vacation");`,
+		},
+		"is in": {
+			policies: `permit(principal is PhotoApp::User in PhotoApp::UserGroup::"family", action in PhotoApp::Action::"read", resource is PhotoApp::Album);`,
+		},
+		"annotations and conditions": {
+			policies: `
+// Alice can view her photos.
+@id("alice-photos")
+permit (
+  principal == PhotoApp::User::"alice",
+  action == PhotoApp::Action::"viewPhoto",
+  resource
+)
+when { resource.owner == principal && context.tags.contains("a;b") };
+
+forbid (principal, action, resource) unless { principal has age };
+`,
+		},
+		"template": {
+			policies: `permit(principal == ?principal, action == PhotoApp::Action::"viewPhoto", resource in ?resource);`,
+		},
+		"undeclared action": {
+			policies:    `permit(principal, action == PhotoApp::Action::"deletePhoto", resource);`,
+			expectError: true,
+		},
+		"undeclared entity type": {
+			policies:    `permit(principal == PhotoApp::Admin::"bob", action, resource);`,
+			expectError: true,
+		},
+		"unqualified entity type": {
+			policies:    `permit(principal, action, resource is Photo);`,
+			expectError: true,
+		},
+		"principal type not applicable": {
+			policies:    `permit(principal == PhotoApp::UserGroup::"family", action == PhotoApp::Action::"viewPhoto", resource);`,
+			expectError: true,
+		},
+		"resource type not applicable to action group": {
+			policies:    `permit(principal, action in PhotoApp::Action::"read", resource is PhotoApp::User);`,
+			expectError: true,
+		},
+		"syntax error": {
+			policies:    `permit(principal, action resource);`,
+			expectError: true,
+		},
+		"missing semicolon": {
+			policies:    `permit(principal, action, resource)`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := schema.ValidatePolicyScopes(testCase.policies)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidatePolicyScopes() err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	schema, err := ParseSchema(testSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alice := EntityUID{Type: "PhotoApp::User", ID: "alice"}
+	photo := EntityUID{Type: "PhotoApp::Photo", ID: "vacation.jpg"}
+	viewPhoto := EntityUID{Type: "PhotoApp::Action", ID: "viewPhoto"}
+
+	if err := schema.ValidateRequest(alice, viewPhoto, photo); err != nil {
+		t.Errorf("ValidateRequest() err = %v", err)
+	}
+
+	if err := schema.ValidateRequest(photo, viewPhoto, alice); err == nil {
+		t.Error("ValidateRequest() expected error for swapped principal and resource")
+	}
+
+	if err := schema.ValidateRequest(alice, EntityUID{Type: "PhotoApp::Action", ID: "deletePhoto"}, photo); err == nil {
+		t.Error("ValidateRequest() expected error for undeclared action")
+	}
+}
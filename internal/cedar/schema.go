@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package cedar validates Cedar policies against a Cedar schema and evaluates authorization requests locally,
+// without calling Amazon Verified Permissions.
+package cedar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	actionEntityType = "Action"
+)
+
+// Schema is a parsed Cedar schema in JSON format.
+// https://docs.cedarpolicy.com/schema/json-schema.html.
+type Schema struct {
+	// Keyed by fully qualified name, e.g. PhotoApp::User.
+	entityTypes map[string]*schemaEntityType
+	// Keyed by fully qualified entity UID, e.g. PhotoApp::Action::"viewPhoto".
+	actions map[string]*schemaAction
+}
+
+type schemaEntityType struct {
+	memberOfTypes []string
+}
+
+type schemaAction struct {
+	// Empty if appliesTo is omitted, in which case the action applies to no principal or resource types.
+	principalTypes []string
+	resourceTypes  []string
+	memberOf       []string
+}
+
+type jsonNamespace struct {
+	EntityTypes *map[string]struct {
+		MemberOfTypes []string `json:"memberOfTypes"`
+	} `json:"entityTypes"`
+	Actions *map[string]struct {
+		AppliesTo *struct {
+			PrincipalTypes []string `json:"principalTypes"`
+			ResourceTypes  []string `json:"resourceTypes"`
+		} `json:"appliesTo"`
+		MemberOf []struct {
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"memberOf"`
+	} `json:"actions"`
+	CommonTypes map[string]json.RawMessage `json:"commonTypes"`
+}
+
+// ParseSchema parses a Cedar schema in JSON format, checking that all referenced entity types and actions are declared.
+func ParseSchema(s string) (*Schema, error) {
+	var namespaces map[string]jsonNamespace
+
+	if err := json.Unmarshal([]byte(s), &namespaces); err != nil {
+		return nil, fmt.Errorf("parsing Cedar schema: %w", err)
+	}
+
+	schema := &Schema{
+		entityTypes: make(map[string]*schemaEntityType),
+		actions:     make(map[string]*schemaAction),
+	}
+
+	// Declarations.
+	for namespace, v := range namespaces {
+		if v.EntityTypes == nil {
+			return nil, fmt.Errorf("Cedar schema namespace (%s): missing entityTypes", namespace)
+		}
+		if v.Actions == nil {
+			return nil, fmt.Errorf("Cedar schema namespace (%s): missing actions", namespace)
+		}
+
+		for name := range *v.EntityTypes {
+			schema.entityTypes[qualify(namespace, name)] = &schemaEntityType{}
+		}
+		for name := range *v.Actions {
+			schema.actions[EntityUID{Type: qualify(namespace, actionEntityType), ID: name}.String()] = &schemaAction{}
+		}
+	}
+
+	// References.
+	var errs []error
+
+	for namespace, v := range namespaces {
+		for name, entityType := range *v.EntityTypes {
+			et := schema.entityTypes[qualify(namespace, name)]
+
+			for _, memberOfType := range entityType.MemberOfTypes {
+				if t, ok := schema.resolveEntityType(namespace, memberOfType); ok {
+					et.memberOfTypes = append(et.memberOfTypes, t)
+				} else {
+					errs = append(errs, fmt.Errorf("entity type (%s): undeclared memberOfTypes entity type (%s)", qualify(namespace, name), memberOfType))
+				}
+			}
+		}
+
+		for name, action := range *v.Actions {
+			uid := EntityUID{Type: qualify(namespace, actionEntityType), ID: name}
+			a := schema.actions[uid.String()]
+
+			if action.AppliesTo != nil {
+				for _, principalType := range action.AppliesTo.PrincipalTypes {
+					if t, ok := schema.resolveEntityType(namespace, principalType); ok {
+						a.principalTypes = append(a.principalTypes, t)
+					} else {
+						errs = append(errs, fmt.Errorf("action (%s): undeclared principal type (%s)", uid, principalType))
+					}
+				}
+				for _, resourceType := range action.AppliesTo.ResourceTypes {
+					if t, ok := schema.resolveEntityType(namespace, resourceType); ok {
+						a.resourceTypes = append(a.resourceTypes, t)
+					} else {
+						errs = append(errs, fmt.Errorf("action (%s): undeclared resource type (%s)", uid, resourceType))
+					}
+				}
+			}
+
+			for _, memberOf := range action.MemberOf {
+				parent := EntityUID{Type: qualify(namespace, actionEntityType), ID: memberOf.ID}
+				if memberOf.Type != "" {
+					parent.Type = memberOf.Type
+					if !strings.Contains(parent.Type, "::") {
+						parent.Type = qualify(namespace, parent.Type)
+					}
+				}
+
+				if _, ok := schema.actions[parent.String()]; ok {
+					a.memberOf = append(a.memberOf, parent.String())
+				} else {
+					errs = append(errs, fmt.Errorf("action (%s): undeclared memberOf action (%s)", uid, parent))
+				}
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// qualify returns the fully qualified name of a declaration in a namespace.
+func qualify(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "::" + name
+}
+
+// resolveEntityType returns the fully qualified name of an entity type referenced from a namespace.
+// Unqualified names are resolved first in the namespace, then in the empty namespace.
+func (s *Schema) resolveEntityType(namespace, name string) (string, bool) {
+	if !strings.Contains(name, "::") {
+		if t := qualify(namespace, name); s.entityTypes[t] != nil {
+			return t, true
+		}
+	}
+
+	if s.entityTypes[name] != nil {
+		return name, true
+	}
+
+	return "", false
+}
+
+// hasEntityType returns whether the fully qualified entity type is declared, either as an entity type or as the type of actions.
+func (s *Schema) hasEntityType(entityType string) bool {
+	if s.entityTypes[entityType] != nil {
+		return true
+	}
+
+	for uid := range s.actions {
+		if strings.HasPrefix(uid, entityType+`::"`) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateRequest checks that the principal and resource types of an authorization request are valid for its action.
+func (s *Schema) ValidateRequest(principal, action, resource EntityUID) error {
+	a, ok := s.actions[action.String()]
+	if !ok {
+		return fmt.Errorf("undeclared action (%s)", action)
+	}
+
+	if !s.hasEntityType(principal.Type) {
+		return fmt.Errorf("undeclared principal entity type (%s)", principal.Type)
+	}
+	if !slices.Contains(a.principalTypes, principal.Type) {
+		return fmt.Errorf("action (%s) doesn't apply to principal entity type (%s)", action, principal.Type)
+	}
+
+	if !s.hasEntityType(resource.Type) {
+		return fmt.Errorf("undeclared resource entity type (%s)", resource.Type)
+	}
+	if !slices.Contains(a.resourceTypes, resource.Type) {
+		return fmt.Errorf("action (%s) doesn't apply to resource entity type (%s)", action, resource.Type)
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,10 +31,13 @@ type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
 	clients                   map[string]any
+	coalesceReads             bool           // From provider configuration.
+	coalescers                map[string]any // Read coalescers with an open batch, keyed by batch name.
 	conns                     map[string]any
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
+	iamPropagation            *iamPropagationTracker
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
@@ -376,6 +380,14 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
+
+	if tracing.Enabled() {
+		// Trace each AWS API call made with this client.
+		awsConfig := config["aws_sdkv2_config"].(*aws.Config).Copy()
+		tracing.AppendMiddlewares(&awsConfig.APIOptions)
+		config["aws_sdkv2_config"] = &awsConfig
+	}
+
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
 		var zero T
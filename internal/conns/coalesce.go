@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// coalesceWindow is how long a batch of reads stays open for further keys before the batch read is made.
+const coalesceWindow = 10 * time.Millisecond
+
+// BatchReadFunc reads the values for a batch of keys in as few API calls as possible.
+// Keys for which there is no value (e.g. the resource does not exist) must be omitted from the returned map.
+type BatchReadFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// CoalesceReads returns the coalesce_reads provider configuration value.
+func (c *AWSClient) CoalesceReads(context.Context) bool {
+	return c.coalesceReads
+}
+
+// CoalescedRead reads the value for the specified key.
+// Concurrent reads with the same batch name made via the same AWSClient are coalesced into a single call to f,
+// made once coalesceWindow has elapsed since the first read in the batch or the batch holds maxBatchSize keys.
+// The returned bool is false if the batch read returned no value for the key.
+// Callers are expected to only use CoalescedRead if read coalescing has been enabled via CoalesceReads.
+func CoalescedRead[K comparable, V any](ctx context.Context, c *AWSClient, name string, maxBatchSize int, key K, f BatchReadFunc[K, V]) (V, bool, error) {
+	var zero V
+
+	rc, err := coalescer(c, name, maxBatchSize, f)
+	if err != nil {
+		return zero, false, err
+	}
+
+	return rc.read(ctx, key)
+}
+
+// coalescer returns the AWSClient's read coalescer for the specified batch name, creating it if necessary.
+// A read coalescer is removed from the AWSClient once it has no open batch, so batch names may be unbounded (e.g. include a resource ID).
+func coalescer[K comparable, V any](c *AWSClient, name string, maxBatchSize int, f BatchReadFunc[K, V]) (*readCoalescer[K, V], error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.coalescers == nil {
+		c.coalescers = make(map[string]any)
+	}
+
+	if v, ok := c.coalescers[name]; ok {
+		rc, ok := v.(*readCoalescer[K, V])
+		if !ok {
+			var zero *readCoalescer[K, V]
+			return nil, fmt.Errorf("read coalescer %s is of type %T, want %T", name, v, zero)
+		}
+
+		return rc, nil
+	}
+
+	rc := &readCoalescer[K, V]{
+		client:       c,
+		f:            f,
+		maxBatchSize: max(maxBatchSize, 1),
+		name:         name,
+		window:       coalesceWindow,
+	}
+	c.coalescers[name] = rc
+
+	return rc, nil
+}
+
+// readCoalescer collects concurrent reads into batches.
+type readCoalescer[K comparable, V any] struct {
+	client       *AWSClient
+	f            BatchReadFunc[K, V]
+	lock         sync.Mutex
+	maxBatchSize int
+	name         string
+	pending      *readBatch[K, V]
+	window       time.Duration
+}
+
+// readBatch is a single batch of reads.
+type readBatch[K comparable, V any] struct {
+	done   chan struct{} // Closed once the batch read has completed.
+	err    error
+	full   chan struct{} // Closed once the batch holds the maximum number of keys.
+	keys   []K
+	values map[K]V
+}
+
+func (rc *readCoalescer[K, V]) read(ctx context.Context, key K) (V, bool, error) {
+	var zero V
+
+	rc.lock.Lock()
+	batch := rc.pending
+	if batch == nil {
+		batch = &readBatch[K, V]{
+			done: make(chan struct{}),
+			full: make(chan struct{}),
+		}
+		rc.pending = batch
+
+		// The batch read must not be canceled if the context of the read that opened the batch is.
+		go rc.run(context.WithoutCancel(ctx), batch)
+	}
+	if !slices.Contains(batch.keys, key) {
+		batch.keys = append(batch.keys, key)
+	}
+	if len(batch.keys) >= rc.maxBatchSize {
+		// No further keys can be added to this batch.
+		rc.pending = nil
+		close(batch.full)
+	}
+	rc.lock.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return zero, false, ctx.Err()
+	case <-batch.done:
+	}
+
+	if batch.err != nil {
+		return zero, false, batch.err
+	}
+
+	v, ok := batch.values[key]
+
+	return v, ok, nil
+}
+
+func (rc *readCoalescer[K, V]) run(ctx context.Context, batch *readBatch[K, V]) {
+	timer := time.NewTimer(rc.window)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-batch.full:
+	}
+
+	c := rc.client
+	c.lock.Lock()
+	rc.lock.Lock()
+	if rc.pending == batch {
+		rc.pending = nil
+	}
+	// Later reads with this batch name get a new read coalescer.
+	if rc.pending == nil && c.coalescers[rc.name] == any(rc) {
+		delete(c.coalescers, rc.name)
+	}
+	rc.lock.Unlock()
+	c.lock.Unlock()
+
+	// The batch's keys can no longer change.
+	tflog.Debug(ctx, "Coalesced reads", map[string]any{
+		"batch_name": rc.name,
+		"batch_size": len(batch.keys),
+	})
+
+	batch.values, batch.err = rc.f(ctx, batch.keys)
+	close(batch.done)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCoalescedRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{}
+
+	var calls atomic.Int32
+	f := func(_ context.Context, keys []string) (map[string]int, error) {
+		calls.Add(1)
+
+		values := make(map[string]int)
+		for _, key := range keys {
+			if key != "missing" {
+				values[key] = len(key)
+			}
+		}
+
+		return values, nil
+	}
+
+	keys := []string{"a", "bb", "ccc", "bb", "missing"}
+	type result struct {
+		value int
+		ok    bool
+		err   error
+	}
+	results := make([]result, len(keys))
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, ok, err := CoalescedRead(ctx, client, "test", 100, key, f)
+			results[i] = result{value: value, ok: ok, err: err}
+		}()
+	}
+	wg.Wait()
+
+	// All reads are made well within the coalescing window.
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("batch reads = %d, want %d", got, want)
+	}
+
+	for i, key := range keys {
+		result := results[i]
+
+		if result.err != nil {
+			t.Errorf("%s: unexpected error: %s", key, result.err)
+			continue
+		}
+
+		if key == "missing" {
+			if result.ok {
+				t.Errorf("%s: expected no value", key)
+			}
+			continue
+		}
+
+		if !result.ok {
+			t.Errorf("%s: expected value", key)
+		}
+		if got, want := result.value, len(key); got != want {
+			t.Errorf("%s: value = %d, want %d", key, got, want)
+		}
+	}
+}
+
+func TestCoalescedReadMaxBatchSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{}
+
+	var lock sync.Mutex
+	var batchSizes []int
+	f := func(_ context.Context, keys []int) (map[int]int, error) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		batchSizes = append(batchSizes, len(keys))
+
+		values := make(map[int]int)
+		for _, key := range keys {
+			values[key] = key
+		}
+
+		return values, nil
+	}
+
+	const n, maxBatchSize = 10, 3
+
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := CoalescedRead(ctx, client, "test", maxBatchSize, i, f); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var total int
+	for _, v := range batchSizes {
+		if v > maxBatchSize {
+			t.Errorf("batch size = %d, want <= %d", v, maxBatchSize)
+		}
+		total += v
+	}
+	if total != n {
+		t.Errorf("keys read = %d, want %d", total, n)
+	}
+}
+
+func TestCoalescedReadError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{}
+	want := errors.New("ThrottlingException")
+
+	_, _, err := CoalescedRead(ctx, client, "test", 100, "a", func(context.Context, []string) (map[string]string, error) {
+		return nil, want
+	})
+
+	if !errors.Is(err, want) {
+		t.Errorf("error = %v, want %v", err, want)
+	}
+
+	// A different key or value type under the same batch name while a batch is open is a programming error.
+	if _, err := coalescer(client, "test", 100, func(context.Context, []string) (map[string]string, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, _, err = CoalescedRead(ctx, client, "test", 100, 1, func(context.Context, []int) (map[int]string, error) {
+		return nil, nil
+	})
+
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestCoalescedReadRemovesCoalescers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &AWSClient{}
+	f := func(_ context.Context, keys []string) (map[string]string, error) {
+		values := make(map[string]string)
+		for _, key := range keys {
+			values[key] = key
+		}
+
+		return values, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := CoalescedRead(ctx, client, fmt.Sprintf("test/%d", i%3), 2, strconv.Itoa(i), f); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	client.lock.Lock()
+	defer client.lock.Unlock()
+
+	if got, want := len(client.coalescers), 0; got != want {
+		t.Errorf("read coalescers = %d, want %d", got, want)
+	}
+}
@@ -31,6 +31,7 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	CoalesceReads                  bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -217,8 +218,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
+	client.coalesceReads = c.CoalesceReads
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.iamPropagation = globalIAMPropagationTracker
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
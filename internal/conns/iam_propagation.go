@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// IAMPropagationWindow is the period after an IAM principal is created or modified
+	// during which other AWS services may not yet see the change.
+	IAMPropagationWindow = 2 * time.Minute
+	// DefaultIAMPropagationTimeout is the minimum period for which errors caused by IAM eventual consistency are retried.
+	// It applies whether or not the referenced IAM principals were changed by this provider, as they may have been changed
+	// by other means, e.g. by another Terraform configuration, a policy attachment or a service-linked role.
+	DefaultIAMPropagationTimeout = 2 * time.Minute
+)
+
+// globalIAMPropagationTracker is shared by all provider instances (aliases) in this plugin process.
+// Entries are keyed by partition and account ID so aliases targeting the same account see each other's changes.
+var globalIAMPropagationTracker = newIAMPropagationTracker(IAMPropagationWindow)
+
+// iamPropagationTracker records when IAM roles, policies and instance profiles were last created or modified.
+type iamPropagationTracker struct {
+	lock    sync.Mutex
+	changes map[string]time.Time
+	now     func() time.Time
+	window  time.Duration
+}
+
+func newIAMPropagationTracker(window time.Duration) *iamPropagationTracker {
+	return &iamPropagationTracker{
+		changes: make(map[string]time.Time),
+		now:     time.Now,
+		window:  window,
+	}
+}
+
+// record notes that the IAM principal with the specified ARN has just been created or modified.
+func (t *iamPropagationTracker) record(principalARN string) bool {
+	key, ok := iamPropagationKey(principalARN)
+	if !ok {
+		return false
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.changes[key] = t.now()
+
+	return true
+}
+
+// remaining returns the longest remaining propagation period of any of the IAM principals with the specified ARNs.
+// Zero is returned if none of the principals has been recently created or modified.
+func (t *iamPropagationTracker) remaining(principalARNs ...string) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	var remaining time.Duration
+
+	for _, principalARN := range principalARNs {
+		key, ok := iamPropagationKey(principalARN)
+		if !ok {
+			continue
+		}
+
+		changed, ok := t.changes[key]
+		if !ok {
+			continue
+		}
+
+		if v := t.window - now.Sub(changed); v > remaining {
+			remaining = v
+		} else if v <= 0 {
+			// Expired.
+			delete(t.changes, key)
+		}
+	}
+
+	return remaining
+}
+
+// iamPropagationKey returns the tracker key for the specified IAM role, policy or instance profile ARN.
+// IAM role, managed policy and instance profile names are unique within an account regardless of path,
+// so the path is dropped and the key is "<partition>:<account ID>:<resource type>/<name>".
+func iamPropagationKey(principalARN string) (string, bool) {
+	v, err := arn.Parse(principalARN)
+	if err != nil || v.Service != "iam" {
+		return "", false
+	}
+
+	resourceType, path, ok := strings.Cut(v.Resource, "/")
+	if !ok {
+		return "", false
+	}
+
+	switch resourceType {
+	case "instance-profile", "policy", "role":
+	default:
+		return "", false
+	}
+
+	name := path[strings.LastIndex(path, "/")+1:]
+	if name == "" {
+		return "", false
+	}
+
+	return v.Partition + ":" + v.AccountID + ":" + resourceType + "/" + name, true
+}
+
+// RecordIAMPrincipalChange records that the IAM roles, policies or instance profiles with the specified ARNs
+// have just been created or modified by this provider.
+// Values that are not IAM role, policy or instance profile ARNs are ignored.
+func (c *AWSClient) RecordIAMPrincipalChange(ctx context.Context, principalARNs ...string) {
+	if c.iamPropagation == nil {
+		return
+	}
+
+	for _, principalARN := range principalARNs {
+		if c.iamPropagation.record(principalARN) {
+			tflog.Debug(ctx, "Recorded IAM principal change", map[string]any{
+				"tf_aws.iam_principal_arn": principalARN,
+			})
+		}
+	}
+}
+
+// RecordIAMRoleChange records that the IAM role with the specified name has just been created or modified by this provider.
+func (c *AWSClient) RecordIAMRoleChange(ctx context.Context, roleName string) {
+	c.RecordIAMPrincipalChange(ctx, c.GlobalARN(ctx, "iam", "role/"+roleName))
+}
+
+// RecordIAMInstanceProfileChange records that the IAM instance profile with the specified name has just been created or modified by this provider.
+func (c *AWSClient) RecordIAMInstanceProfileChange(ctx context.Context, instanceProfileName string) {
+	c.RecordIAMPrincipalChange(ctx, c.GlobalARN(ctx, "iam", "instance-profile/"+instanceProfileName))
+}
+
+// IAMPropagationTimeout returns how long an operation that references any of the IAM principals with the specified ARNs
+// should retry errors caused by IAM eventual consistency, e.g. "role cannot be assumed".
+// The timeout is never less than DefaultIAMPropagationTimeout. It is extended by the remaining propagation period
+// of any of the principals that was created or modified by this provider within the IAM propagation window.
+func (c *AWSClient) IAMPropagationTimeout(ctx context.Context, principalARNs ...string) time.Duration {
+	if c.iamPropagation == nil {
+		return DefaultIAMPropagationTimeout
+	}
+
+	remaining := c.iamPropagation.remaining(principalARNs...)
+
+	if remaining > 0 {
+		tflog.Debug(ctx, "Referenced IAM principal recently changed", map[string]any{
+			"tf_aws.iam_principal_arns":  principalARNs,
+			"tf_aws.iam_propagation_ttl": remaining.String(),
+		})
+	}
+
+	return DefaultIAMPropagationTimeout + remaining
+}
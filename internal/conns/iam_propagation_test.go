@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestIAMPropagationKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ARN         string
+		ExpectedKey string
+		ExpectedOK  bool
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name: "not an ARN",
+			ARN:  "my-role",
+		},
+		{
+			Name: "not IAM",
+			ARN:  "arn:aws:lambda:us-west-2:123456789012:function:my-function", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			Name: "IAM user",
+			ARN:  "arn:aws:iam::123456789012:user/my-user", //lintignore:AWSAT005
+		},
+		{
+			Name:        "role",
+			ARN:         "arn:aws:iam::123456789012:role/my-role", //lintignore:AWSAT005
+			ExpectedKey: "aws:123456789012:role/my-role",
+			ExpectedOK:  true,
+		},
+		{
+			Name:        "role with path",
+			ARN:         "arn:aws:iam::123456789012:role/service-role/team/my-role", //lintignore:AWSAT005
+			ExpectedKey: "aws:123456789012:role/my-role",
+			ExpectedOK:  true,
+		},
+		{
+			Name:        "policy",
+			ARN:         "arn:aws-us-gov:iam::123456789012:policy/my-policy", //lintignore:AWSAT005
+			ExpectedKey: "aws-us-gov:123456789012:policy/my-policy",
+			ExpectedOK:  true,
+		},
+		{
+			Name:        "instance profile",
+			ARN:         "arn:aws:iam::123456789012:instance-profile/path/my-profile", //lintignore:AWSAT005
+			ExpectedKey: "aws:123456789012:instance-profile/my-profile",
+			ExpectedOK:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			gotKey, gotOK := iamPropagationKey(testCase.ARN)
+
+			if gotOK != testCase.ExpectedOK {
+				t.Errorf("ok = %t, want %t", gotOK, testCase.ExpectedOK)
+			}
+
+			if gotKey != testCase.ExpectedKey {
+				t.Errorf("key = %q, want %q", gotKey, testCase.ExpectedKey)
+			}
+		})
+	}
+}
+
+func TestIAMPropagationTracker(t *testing.T) {
+	t.Parallel()
+
+	const (
+		roleARN         = "arn:aws:iam::123456789012:role/my-role"                //lintignore:AWSAT005
+		roleWithPathARN = "arn:aws:iam::123456789012:role/service-role/my-role"   //lintignore:AWSAT005
+		otherRoleARN    = "arn:aws:iam::123456789012:role/other-role"             //lintignore:AWSAT005
+		otherAccountARN = "arn:aws:iam::210987654321:role/my-role"                //lintignore:AWSAT005
+		policyARN       = "arn:aws:iam::123456789012:policy/my-policy"            //lintignore:AWSAT005
+		profileARN      = "arn:aws:iam::123456789012:instance-profile/my-profile" //lintignore:AWSAT005
+	)
+
+	now := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tracker := newIAMPropagationTracker(2 * time.Minute)
+	tracker.now = func() time.Time { return now }
+
+	if got := tracker.remaining(roleARN); got != 0 {
+		t.Errorf("remaining before record = %s, want 0", got)
+	}
+
+	tracker.record(roleARN)
+	now = now.Add(30 * time.Second)
+	tracker.record(policyARN)
+
+	if got, want := tracker.remaining(roleWithPathARN), 90*time.Second; got != want {
+		t.Errorf("remaining(role) = %s, want %s", got, want)
+	}
+	if got, want := tracker.remaining(roleARN, policyARN), 2*time.Minute; got != want {
+		t.Errorf("remaining(role, policy) = %s, want %s", got, want)
+	}
+	if got := tracker.remaining(otherRoleARN, otherAccountARN, profileARN, "my-role"); got != 0 {
+		t.Errorf("remaining(untouched) = %s, want 0", got)
+	}
+
+	now = now.Add(90 * time.Second)
+
+	if got := tracker.remaining(roleARN); got != 0 {
+		t.Errorf("remaining(role) after window = %s, want 0", got)
+	}
+	if got, want := tracker.remaining(policyARN), 30*time.Second; got != want {
+		t.Errorf("remaining(policy) = %s, want %s", got, want)
+	}
+}
+
+func TestAWSClientIAMPropagationTimeout(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+
+	client := &AWSClient{
+		accountID: "123456789012",
+		partition: standardPartition,
+	}
+
+	// No tracker configured.
+	client.RecordIAMRoleChange(ctx, "my-role")
+	if got, want := client.IAMPropagationTimeout(ctx, "arn:aws:iam::123456789012:role/my-role"), DefaultIAMPropagationTimeout; got != want { //lintignore:AWSAT005
+		t.Errorf("IAMPropagationTimeout without tracker = %s, want %s", got, want)
+	}
+
+	client.iamPropagation = newIAMPropagationTracker(IAMPropagationWindow)
+
+	client.RecordIAMRoleChange(ctx, "my-role")
+	client.RecordIAMInstanceProfileChange(ctx, "my-profile")
+
+	if got := client.IAMPropagationTimeout(ctx, "arn:aws:iam::123456789012:role/path/my-role"); got <= DefaultIAMPropagationTimeout { //lintignore:AWSAT005
+		t.Errorf("IAMPropagationTimeout(role) = %s, want > %s", got, DefaultIAMPropagationTimeout)
+	}
+	if got := client.IAMPropagationTimeout(ctx, "arn:aws:iam::123456789012:instance-profile/my-profile"); got <= DefaultIAMPropagationTimeout { //lintignore:AWSAT005
+		t.Errorf("IAMPropagationTimeout(instance profile) = %s, want > %s", got, DefaultIAMPropagationTimeout)
+	}
+	if got, want := client.IAMPropagationTimeout(ctx, "arn:aws:iam::123456789012:role/other-role"), DefaultIAMPropagationTimeout; got != want { //lintignore:AWSAT005
+		t.Errorf("IAMPropagationTimeout(other role) = %s, want %s", got, want)
+	}
+}
@@ -190,7 +190,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -609,7 +609,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +653,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1215,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1237,6 +1237,25 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		//
+		// union interface -> types.List(OfObject) or types.Object.
+		//
+		if ok, d := flattenUnion(ctx, sourcePath, vFrom, targetPath, to, flattener); ok {
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -1468,6 +1487,21 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 			return diags
 		}
 
+		if _, ok := target.(Flattener); !ok {
+			//
+			// union interface -> nested Object.
+			//
+			if ok, d := flattenUnion(ctx, sourcePath, vFrom.Index(i), targetPath, target, flattener); ok {
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				t.Index(i).Set(reflect.ValueOf(target))
+				continue
+			}
+		}
+
 		diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
 		if diags.HasError() {
 			return diags
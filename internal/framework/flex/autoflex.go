@@ -102,6 +102,11 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if members, ok := flexer.getOptions().unionMembers[valTo.Type()]; ok {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, members, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -3,6 +3,10 @@
 
 package flex
 
+import (
+	"reflect"
+)
+
 var (
 	DefaultIgnoredFieldNames = []string{
 		"Tags", // Resource tags are handled separately.
@@ -25,6 +29,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unionMembers stores the member types of AWS SDK union interfaces,
+	// keyed by the interface type
+	unionMembers map[reflect.Type][]reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -75,6 +83,26 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithUnionMembers registers the member types of an AWS SDK for Go v2
+// union interface, e.g.
+//
+//	WithUnionMembers[awstypes.PolicyDefinition](&awstypes.PolicyDefinitionMemberStatic{}, &awstypes.PolicyDefinitionMemberTemplateLinked{})
+//
+// Use this option to expand a nested block with mutually exclusive children
+// to the union. Flattening from a union does not require this option.
+func WithUnionMembers[T any](members ...T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.unionMembers == nil {
+			o.unionMembers = make(map[reflect.Type][]reflect.Type)
+		}
+
+		t := reflect.TypeFor[T]()
+		for _, member := range members {
+			o.unionMembers[t] = append(o.unionMembers[t], reflect.TypeOf(member))
+		}
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	for _, name := range o.ignoredFieldNames {
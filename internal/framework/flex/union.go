@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AWS SDK for Go v2 union (Smithy `union` shape) interfaces are implemented by pointers to member structs
+// named <Union>Member<Name>, each with a single `Value` field.
+// The corresponding Terraform Plugin Framework model is a struct with one field per union member,
+// of which at most one is set. The model field is matched to the member <Name> case-insensitively.
+const (
+	unionMemberInfix      = "Member"
+	unionMemberValueField = "Value"
+)
+
+// unionMemberName returns the name of the specified AWS SDK union interface's member type.
+func unionMemberName(tUnion, tMember reflect.Type) (string, bool) {
+	if tMember.Kind() != reflect.Pointer || tMember.Elem().Kind() != reflect.Struct {
+		return "", false
+	}
+
+	name, ok := strings.CutPrefix(tMember.Elem().Name(), tUnion.Name()+unionMemberInfix)
+	if !ok || name == "" {
+		return "", false
+	}
+
+	if _, ok := tMember.Elem().FieldByName(unionMemberValueField); !ok {
+		return "", false
+	}
+
+	return name, true
+}
+
+// isUnionMemberSet returns whether or not a union member's model field has a configured value.
+// Absent nested blocks are empty, not null.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(valueWithElementsAs); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
+// expandUnion expands the Plugin Framework model `from` to the AWS SDK union interface value `vTo`.
+// The union member is chosen by the single set model field whose name matches a registered member.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, vTo reflect.Value, members []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Expanding to union")
+
+	typeFrom, tUnion := valFrom.Type(), vTo.Type()
+	opts := flexer.getOptions()
+	var setFieldName string
+
+	for i := 0; i < typeFrom.NumField(); i++ {
+		field := typeFrom.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldName := field.Name
+		if opts.isIgnoredField(fieldName) {
+			continue
+		}
+
+		fieldVal, ok := valFrom.Field(i).Interface().(attr.Value)
+		if !ok || !isUnionMemberSet(fieldVal) {
+			continue
+		}
+
+		var tMember reflect.Type
+		var memberName string
+		for _, t := range members {
+			if name, ok := unionMemberName(tUnion, t); ok && strings.EqualFold(name, fieldName) {
+				tMember, memberName = t, name
+				break
+			}
+		}
+		if tMember == nil {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member", map[string]any{
+				logAttrKeySourceFieldname: fieldName,
+			})
+			continue
+		}
+
+		if setFieldName != "" {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set", map[string]any{
+				logAttrKeySourceFieldname: fieldName,
+			})
+			diags.Append(diagExpandingMultipleUnionMembers(typeFrom, tUnion, setFieldName, fieldName))
+			return diags
+		}
+		setFieldName = fieldName
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fieldName,
+			logAttrKeyTargetFieldname: memberName,
+		})
+
+		// Create a new union member and expand its value.
+		member := reflect.New(tMember.Elem())
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(memberName), member.Elem().FieldByName(unionMemberValueField), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
+// flattenUnion flattens the AWS SDK union interface value `vFrom` to the Plugin Framework model `to`.
+// It returns false if `vFrom` does not hold a union member, e.g. it holds an `UnknownUnionMember`.
+func flattenUnion(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any, flexer autoFlexer) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if vFrom.Kind() != reflect.Interface || vFrom.IsNil() {
+		return false, diags
+	}
+
+	vMember := vFrom.Elem()
+	memberName, ok := unionMemberName(vFrom.Type(), vMember.Type())
+	if !ok || vMember.IsNil() {
+		return false, diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Flattening from union")
+
+	valTo := reflect.ValueOf(to)
+
+	// Members that are not set are null.
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return true, diags
+	}
+
+	valTo = valTo.Elem()
+	typeTo := valTo.Type()
+	opts := flexer.getOptions()
+
+	for i := 0; i < typeTo.NumField(); i++ {
+		field := typeTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		fieldName := field.Name
+		if opts.isIgnoredField(fieldName) || !strings.EqualFold(fieldName, memberName) {
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: memberName,
+			logAttrKeyTargetFieldname: fieldName,
+		})
+
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(memberName), vMember.Elem().FieldByName(unionMemberValueField), targetPath.AtName(fieldName), valTo.Field(i), fieldOpts{})...)
+		return true, diags
+	}
+
+	tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+		logAttrKeySourceFieldname: memberName,
+	})
+
+	return true, diags
+}
+
+func diagExpandingMultipleUnionMembers(sourceType, unionType reflect.Type, fieldNames ...string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q sets multiple members (%s) of union %q.", fullTypeName(sourceType), strings.Join(fieldNames, ", "), fullTypeName(unionType)),
+	)
+}
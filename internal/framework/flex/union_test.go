@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// awsUnion mimics an AWS SDK for Go v2 union interface.
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberText struct {
+	Value string
+}
+
+func (*awsUnionMemberText) isAWSUnion() {}
+
+type awsUnionMemberObject struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObject) isAWSUnion() {}
+
+type awsUnionField struct {
+	Field1 awsUnion
+}
+
+type awsUnionSliceField struct {
+	Field1 []awsUnion
+}
+
+type tfUnion struct {
+	Text   types.String                                         `tfsdk:"text"`
+	Object fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object"`
+}
+
+type tfUnionField struct {
+	Field1 fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"field1"`
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	withUnionMembers := WithUnionMembers[awsUnion](&awsUnionMemberText{}, &awsUnionMemberObject{})
+
+	testCases := map[string]struct {
+		source      any
+		target      any
+		want        any
+		expectError bool
+	}{
+		"string member": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Text:   types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+				}),
+			},
+			target: &awsUnionField{},
+			want: &awsUnionField{
+				Field1: &awsUnionMemberText{Value: "a"},
+			},
+		},
+		"nested object member": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Text: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			target: &awsUnionField{},
+			want: &awsUnionField{
+				Field1: &awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+			},
+		},
+		"no member": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Text:   types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+			target: &awsUnionField{},
+			want:   &awsUnionField{},
+		},
+		"multiple members": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Text: types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+			target:      &awsUnionField{},
+			expectError: true,
+		},
+		"slice of unions": {
+			source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnion{
+					{
+						Text:   types.StringValue("a"),
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						Text: types.StringNull(),
+						Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("b"),
+						}),
+					},
+				}),
+			},
+			target: &awsUnionSliceField{},
+			want: &awsUnionSliceField{
+				Field1: []awsUnion{
+					&awsUnionMemberText{Value: "a"},
+					&awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Expand(ctx, testCase.source, testCase.target, withUnionMembers)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, diags)
+			}
+
+			if !diags.HasError() {
+				if diff := cmp.Diff(testCase.target, testCase.want); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		source any
+		target any
+		want   any
+	}{
+		"string member": {
+			source: &awsUnionField{
+				Field1: &awsUnionMemberText{Value: "a"},
+			},
+			target: &tfUnionField{},
+			want: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Text:   types.StringValue("a"),
+					Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				}),
+			},
+		},
+		"nested object member": {
+			source: &awsUnionField{
+				Field1: &awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+			},
+			target: &tfUnionField{},
+			want: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Text: types.StringNull(),
+					Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("b"),
+					}),
+				}),
+			},
+		},
+		"nil union": {
+			source: &awsUnionField{},
+			target: &tfUnionField{},
+			want: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"slice of unions": {
+			source: &awsUnionSliceField{
+				Field1: []awsUnion{
+					&awsUnionMemberText{Value: "a"},
+					&awsUnionMemberObject{Value: awsSingleStringValue{Field1: "b"}},
+				},
+			},
+			target: &tfUnionField{},
+			want: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnion{
+					{
+						Text:   types.StringValue("a"),
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+					},
+					{
+						Text: types.StringNull(),
+						Object: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+							Field1: types.StringValue("b"),
+						}),
+					},
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Flatten(ctx, testCase.source, testCase.target)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+var _ validator.Object = exactlyOneUnionMemberValidator{}
+
+type exactlyOneUnionMemberValidator struct {
+	names []string
+}
+
+func (v exactlyOneUnionMemberValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v exactlyOneUnionMemberValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("exactly one of these must be configured: %s", strings.Join(tfslices.ApplyToAll(v.names, func(v string) string {
+		return `"` + v + `"`
+	}), ", "))
+}
+
+func (v exactlyOneUnionMemberValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+	var set []string
+
+	for _, name := range v.names {
+		value, ok := attributes[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+
+		if value.IsUnknown() {
+			return
+		}
+
+		// Nested blocks that are not configured are empty, not null.
+		if value, ok := value.(interface{ Elements() []attr.Value }); ok && len(value.Elements()) == 0 {
+			continue
+		}
+
+		set = append(set, name)
+	}
+
+	switch len(set) {
+	case 0:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("No union member configured, %s.", v.Description(ctx)),
+		)
+	case 1:
+	default:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Multiple union members (%s) configured, %s.", strings.Join(set, ", "), v.Description(ctx)),
+		)
+	}
+}
+
+// ExactlyOneUnionMember checks that exactly one of the named attributes or
+// nested blocks of an Object that models an AWS API union is configured.
+// Use it as a NestedObject validator alongside AutoFlex's WithUnionMembers option.
+func ExactlyOneUnionMember(names ...string) exactlyOneUnionMemberValidator {
+	return exactlyOneUnionMemberValidator{
+		names: slices.Clone(names),
+	}
+}
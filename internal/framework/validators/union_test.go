@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestExactlyOneUnionMemberValidator(t *testing.T) {
+	t.Parallel()
+
+	blockType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"value": types.StringType,
+	}}
+	attrTypes := map[string]attr.Type{
+		"static":          types.ListType{ElemType: blockType},
+		"template_linked": types.ListType{ElemType: blockType},
+		"description":     types.StringType,
+	}
+	block := types.ObjectValueMust(blockType.AttrTypes, map[string]attr.Value{
+		"value": types.StringValue("v"),
+	})
+	emptyList := types.ListValueMust(blockType, []attr.Value{})
+	oneList := types.ListValueMust(blockType, []attr.Value{block})
+
+	type testCase struct {
+		in          types.Object
+		expectError bool
+	}
+
+	testCases := map[string]testCase{
+		"one member": {
+			in: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"static":          oneList,
+				"template_linked": emptyList,
+				"description":     types.StringValue("d"),
+			}),
+		},
+		"no members": {
+			in: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"static":          emptyList,
+				"template_linked": types.ListNull(blockType),
+				"description":     types.StringValue("d"),
+			}),
+			expectError: true,
+		},
+		"multiple members": {
+			in: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"static":          oneList,
+				"template_linked": oneList,
+				"description":     types.StringNull(),
+			}),
+			expectError: true,
+		},
+		"unknown member": {
+			in: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"static":          oneList,
+				"template_linked": types.ListUnknown(blockType),
+				"description":     types.StringNull(),
+			}),
+		},
+		"skip-validation-on-null": {
+			in: types.ObjectNull(attrTypes),
+		},
+		"skip-validation-on-unknown": {
+			in: types.ObjectUnknown(attrTypes),
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(fmt.Sprintf("ValidateObject - %s", name), func(t *testing.T) {
+			t.Parallel()
+			req := validator.ObjectRequest{
+				ConfigValue: test.in,
+			}
+			res := validator.ObjectResponse{}
+			validators.ExactlyOneUnionMember("static", "template_linked").ValidateObject(context.TODO(), req, &res)
+
+			if !res.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if res.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+	}
+}
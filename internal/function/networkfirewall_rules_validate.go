@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfsuricata "github.com/hashicorp/terraform-provider-aws/internal/suricata"
+)
+
+var networkFirewallRulesValidateResultAttrTypes = map[string]attr.Type{
+	"capacity":   types.Int64Type,
+	"errors":     types.ListType{ElemType: types.StringType},
+	"rule_count": types.Int64Type,
+	"valid":      types.BoolType,
+	"warnings":   types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = networkFirewallRulesValidateFunction{}
+
+func NewNetworkFirewallRulesValidateFunction() function.Function {
+	return &networkFirewallRulesValidateFunction{}
+}
+
+type networkFirewallRulesValidateFunction struct{}
+
+func (f networkFirewallRulesValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "networkfirewall_rules_validate"
+}
+
+func (f networkFirewallRulesValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "networkfirewall_rules_validate Function",
+		MarkdownDescription: "Validates Suricata compatible rules for an AWS Network Firewall stateful rule group locally, " +
+			"and estimates the capacity that they consume",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "rules",
+				MarkdownDescription: "Suricata compatible rules, one per line",
+			},
+			function.MapParameter{
+				Name:                "ip_sets",
+				MarkdownDescription: "IP set variables that the rules can reference, keyed by name",
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+			function.MapParameter{
+				Name:                "port_sets",
+				MarkdownDescription: "Port set variables that the rules can reference, keyed by name",
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: networkFirewallRulesValidateResultAttrTypes,
+		},
+	}
+}
+
+func (f networkFirewallRulesValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		rules            string
+		ipSets, portSets map[string][]string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rules, &ipSets, &portSets))
+	if resp.Error != nil {
+		return
+	}
+
+	errorMessages, warningMessages, ruleCount, capacity := networkFirewallRulesValidate(rules, ipSets, portSets)
+
+	errorList, d := types.ListValueFrom(ctx, types.StringType, errorMessages)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	warningList, d := types.ListValueFrom(ctx, types.StringType, warningMessages)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"capacity":   types.Int64Value(int64(capacity)),
+		"errors":     errorList,
+		"rule_count": types.Int64Value(int64(ruleCount)),
+		"valid":      types.BoolValue(len(errorMessages) == 0),
+		"warnings":   warningList,
+	}
+
+	result, d := types.ObjectValue(networkFirewallRulesValidateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// networkFirewallRulesValidate returns the errors and warnings in rules, ordered by line, the number of valid rules and their estimated capacity.
+// IP set references (@NAME) are not checked, as the function has no reference sets.
+func networkFirewallRulesValidate(rules string, ipSets, portSets map[string][]string) ([]string, []string, int, int) {
+	parsed, parseErr := tfsuricata.Parse(rules)
+	vars := tfsuricata.Variables{
+		IPSets:   slices.Sorted(maps.Keys(ipSets)),
+		PortSets: slices.Sorted(maps.Keys(portSets)),
+	}
+	validateErr := tfsuricata.Validate(parsed, vars)
+
+	var ruleErrs []*tfsuricata.Error
+	for _, err := range []error{parseErr, validateErr} {
+		if err, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, err := range err.Unwrap() {
+				if ruleErr, ok := errs.As[*tfsuricata.Error](err); ok {
+					ruleErrs = append(ruleErrs, ruleErr)
+				}
+			}
+		}
+	}
+	slices.SortStableFunc(ruleErrs, func(a, b *tfsuricata.Error) int {
+		return a.Line - b.Line
+	})
+
+	errorMessages := make([]string, 0, len(ruleErrs))
+	for _, err := range ruleErrs {
+		errorMessages = append(errorMessages, err.Error())
+	}
+
+	warnings := tfsuricata.Warnings(parsed)
+	warningMessages := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		warningMessages = append(warningMessages, warning.Error())
+	}
+
+	return errorMessages, warningMessages, len(parsed), tfsuricata.EstimateCapacity(parsed)
+}
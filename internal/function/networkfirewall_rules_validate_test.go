@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestNetworkFirewallRulesValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	rules := `pass tls $HOME_NET any -> $WEB_SERVERS $WEB_PORTS (tls.sni; content:"example.com"; msg:"allowed"; sid:1;)
+# Drop everything else.
+drop tcp any any -> any any (flow:established,to_server; sid:2;)
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNetworkFirewallRulesValidateFunctionConfig(rules),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", acctest.CtTrue),
+					resource.TestCheckOutput("errors", ""),
+					resource.TestCheckOutput("rule_count", "2"),
+					resource.TestCheckOutput("capacity", "2"),
+					resource.TestCheckOutput("warnings", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestNetworkFirewallRulesValidateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	rules := `pass tls $HOME_NET any -> $DB_SERVERS any (sid:1;)
+drop tcp any any -> any any (mgs:"typo"; sid:2;)
+drop tcp any any -> any any (sid:1;)
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testNetworkFirewallRulesValidateFunctionConfig(rules),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", acctest.CtFalse),
+					resource.TestCheckOutput("errors", `line 1: IP set variable $DB_SERVERS is not defined|line 3: sid 1 is already used by the rule on line 1`),
+					resource.TestCheckOutput("rule_count", "3"),
+					resource.TestCheckOutput("capacity", "3"),
+					resource.TestCheckOutput("warnings", `line 2: unknown keyword "mgs"`),
+				),
+			},
+		},
+	})
+}
+
+func testNetworkFirewallRulesValidateFunctionConfig(rules string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::networkfirewall_rules_validate(
+    %[1]q,
+    { WEB_SERVERS = ["10.0.0.0/24"] },
+    { WEB_PORTS = ["80", "443"] },
+  )
+}
+
+output "valid" {
+  value = local.result.valid
+}
+
+output "errors" {
+  value = join("|", local.result.errors)
+}
+
+output "rule_count" {
+  value = local.result.rule_count
+}
+
+output "capacity" {
+  value = local.result.capacity
+}
+
+output "warnings" {
+  value = join("|", local.result.warnings)
+}
+`, rules)
+}
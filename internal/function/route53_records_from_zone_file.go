@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/zonefile"
+)
+
+var route53RecordsFromZoneFileResultAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"type":    types.StringType,
+	"ttl":     types.Int64Type,
+	"records": types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = route53RecordsFromZoneFileFunction{}
+
+func NewRoute53RecordsFromZoneFileFunction() function.Function {
+	return &route53RecordsFromZoneFileFunction{}
+}
+
+type route53RecordsFromZoneFileFunction struct{}
+
+func (f route53RecordsFromZoneFileFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "route53_records_from_zone_file"
+}
+
+func (f route53RecordsFromZoneFileFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "route53_records_from_zone_file Function",
+		MarkdownDescription: "Parses a BIND (RFC 1035) zone file into a list of resource record sets with the `name`, `type`, `ttl` and `records` " +
+			"arguments of the `aws_route53_record` resource",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "zone_file",
+				MarkdownDescription: "Contents of the zone file",
+			},
+			function.StringParameter{
+				Name:                "origin",
+				MarkdownDescription: "Domain name with which relative names are qualified until a `$ORIGIN` directive. An empty string means all names must be fully qualified",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: route53RecordsFromZoneFileResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f route53RecordsFromZoneFileFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var zoneFile, origin string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &zoneFile, &origin))
+	if resp.Error != nil {
+		return
+	}
+
+	recordSets, err := route53RecordsFromZoneFile(ctx, zoneFile, origin)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: route53RecordsFromZoneFileResultAttrTypes}, recordSets)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type route53ZoneFileRecordSet struct {
+	Name    string   `tfsdk:"name"`
+	Type    string   `tfsdk:"type"`
+	TTL     int64    `tfsdk:"ttl"`
+	Records []string `tfsdk:"records"`
+}
+
+// route53RecordsFromZoneFile groups the records of a zone file into resource record sets, in order of first appearance.
+// ALIAS records, as rendered by the aws_route53_zone_file data source, are skipped as they don't include the alias
+// target's hosted zone.
+func route53RecordsFromZoneFile(ctx context.Context, zoneFile, origin string) ([]route53ZoneFileRecordSet, error) {
+	records, err := zonefile.Parse(zoneFile, origin)
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		name, rrType string
+	}
+	recordSets := make([]route53ZoneFileRecordSet, 0)
+	index := make(map[key]int)
+
+	for _, record := range records {
+		if record.Type == "ALIAS" {
+			tflog.Warn(ctx, "Skipping ALIAS record, use an aws_route53_record resource with an alias block instead", map[string]any{
+				"name":   record.Name,
+				"target": record.Data,
+			})
+			continue
+		}
+
+		name := record.Name
+		if name != "." {
+			name = strings.TrimSuffix(name, ".")
+		}
+
+		value := record.Data
+		switch record.Type {
+		case "SPF", "TXT":
+			// aws_route53_record quotes TXT and SPF values, so multiple character strings are written as `a" "b`.
+			if strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) && len(value) > 1 {
+				value = value[1 : len(value)-1]
+			} else {
+				value = strings.Join(strings.Fields(value), `" "`)
+			}
+		}
+
+		k := key{name: name, rrType: record.Type}
+		if i, ok := index[k]; ok {
+			// RFC 2181 section 5.2: records in a set with differing TTLs are treated as having the lowest TTL.
+			recordSets[i].TTL = min(recordSets[i].TTL, record.TTL)
+			recordSets[i].Records = append(recordSets[i].Records, value)
+			continue
+		}
+
+		index[k] = len(recordSets)
+		recordSets = append(recordSets, route53ZoneFileRecordSet{
+			Name:    name,
+			Type:    record.Type,
+			TTL:     record.TTL,
+			Records: []string{value},
+		})
+	}
+
+	return recordSets, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRoute53RecordsFromZoneFileFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneFile := `$TTL 1h
+@      IN MX   10 mail
+www    300 IN A 192.0.2.1
+       600 IN A 192.0.2.2
+txt        IN TXT "v=spf1 -all" "second"
+alias      IN ALIAS lb.example.net.
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRoute53RecordsFromZoneFileFunctionConfig(zoneFile, "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "3"),
+					resource.TestCheckOutput("mx", "example.com MX 3600 10 mail.example.com."),
+					resource.TestCheckOutput("a", "www.example.com A 300 192.0.2.1,192.0.2.2"),
+					resource.TestCheckOutput("txt", `txt.example.com TXT 3600 v=spf1 -all" "second`),
+				),
+			},
+		},
+	})
+}
+
+func TestRoute53RecordsFromZoneFileFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRoute53RecordsFromZoneFileFunctionConfig("www 300 IN A 192.0.2.1\n", ""),
+				ExpectError: regexache.MustCompile(`relative[\s\n]*name[\s\n]*www[\s\n]*used[\s\n]*without[\s\n]*an[\s\n]*origin`),
+			},
+		},
+	})
+}
+
+func testRoute53RecordsFromZoneFileFunctionConfig(zoneFile, origin string) string {
+	return fmt.Sprintf(`
+locals {
+  records = provider::aws::route53_records_from_zone_file(%[1]q, %[2]q)
+}
+
+output "count" {
+  value = length(local.records)
+}
+
+output "mx" {
+  value = try(format("%%s %%s %%d %%s", local.records[0].name, local.records[0].type, local.records[0].ttl, join(",", local.records[0].records)), null)
+}
+
+output "a" {
+  value = try(format("%%s %%s %%d %%s", local.records[1].name, local.records[1].type, local.records[1].ttl, join(",", local.records[1].records)), null)
+}
+
+output "txt" {
+  value = try(format("%%s %%s %%d %%s", local.records[2].name, local.records[2].type, local.records[2].ttl, join(",", local.records[2].records)), null)
+}
+`, zoneFile, origin)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Time zones are resolved without relying on the host's time zone database.
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/schedule"
+)
+
+const (
+	// scheduleNextRunsMaxCount is the maximum number of runs returned.
+	scheduleNextRunsMaxCount = 1000
+)
+
+var _ function.Function = scheduleNextRunsFunction{}
+
+func NewScheduleNextRunsFunction() function.Function {
+	return &scheduleNextRunsFunction{}
+}
+
+type scheduleNextRunsFunction struct{}
+
+func (f scheduleNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_next_runs"
+}
+
+func (f scheduleNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_next_runs Function",
+		MarkdownDescription: "Returns the next times, in RFC3339 format, at which a cron(), rate() or at() schedule expression runs. " +
+			"Runs are calculated after the start time.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.StringParameter{
+				Name:                "timezone",
+				MarkdownDescription: "IANA time zone in which cron() and at() expressions are evaluated, e.g. `America/New_York`. An empty string means UTC",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of runs to return, between 1 and %d", scheduleNextRunsMaxCount),
+			},
+			// The start time is required, rather than defaulting to the current time, as functions must return the same result
+			// when called with the same arguments during plan and apply.
+			function.StringParameter{
+				Name:                "start_time",
+				MarkdownDescription: "Time, in RFC3339 format, after which runs are calculated",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, timezone, startTime string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &timezone, &count, &startTime))
+	if resp.Error != nil {
+		return
+	}
+
+	runs, err := scheduleNextRuns(expression, timezone, count, startTime)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, runs))
+}
+
+// scheduleNextRuns returns the next runs of an EventBridge Scheduler schedule expression, formatted as RFC3339.
+func scheduleNextRuns(expression, timezone string, count int64, startTime string) ([]string, error) {
+	if count < 1 || count > scheduleNextRunsMaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", scheduleNextRunsMaxCount)
+	}
+
+	// EventBridge Scheduler accepts at(), cron() and rate() expressions, including rate() in minutes.
+	// cron() expressions are parsed the same way in every dialect.
+	e, err := schedule.Parse(schedule.DialectEventBridgeScheduler, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("invalid time zone (%s): %w", timezone, err)
+		}
+	}
+
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start time (%s): %w", startTime, err)
+	}
+
+	runs := make([]string, 0, count)
+	for _, v := range e.NextRuns(start, loc, int(count)) {
+		runs = append(runs, v.Format(time.RFC3339))
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleNextRunsFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("cron(0 2 ? * MON-FRI *)", "Europe/London", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-31T02:00:00Z,2025-02-03T02:00:00Z,2025-02-04T02:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleNextRunsFunctionConfig("rate(12 hours)", "", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2025-01-31T00:00:00Z,2025-01-31T12:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_invalidExpression(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextRunsFunctionConfig("cron(0 2 * * MON *)", "", 1),
+				ExpectError: regexache.MustCompile(`day-of-month[\s\n]*and[\s\n]*day-of-week`),
+			},
+		},
+	})
+}
+
+func TestScheduleNextRunsFunction_invalidTimezone(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleNextRunsFunctionConfig("rate(1 day)", "Mars/Olympus_Mons", 1),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*time[\s\n]*zone`),
+			},
+		},
+	})
+}
+
+func testScheduleNextRunsFunctionConfig(expression, timezone string, count int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_next_runs(%[1]q, %[2]q, %[3]d, "2025-01-30T12:00:00Z"))
+}`, expression, timezone, count)
+}
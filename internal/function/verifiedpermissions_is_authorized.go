@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/cedar"
+)
+
+var verifiedPermissionsIsAuthorizedResultAttrTypes = map[string]attr.Type{
+	"decision":             types.StringType,
+	"determining_policies": types.ListType{ElemType: types.StringType},
+	"errors":               types.ListType{ElemType: types.StringType},
+}
+
+var _ function.Function = verifiedPermissionsIsAuthorizedFunction{}
+
+func NewVerifiedPermissionsIsAuthorizedFunction() function.Function {
+	return &verifiedPermissionsIsAuthorizedFunction{}
+}
+
+type verifiedPermissionsIsAuthorizedFunction struct{}
+
+func (f verifiedPermissionsIsAuthorizedFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verifiedpermissions_is_authorized"
+}
+
+func (f verifiedPermissionsIsAuthorizedFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "verifiedpermissions_is_authorized Function",
+		MarkdownDescription: "Evaluates an authorization request against a set of Cedar policies locally, without calling Amazon Verified Permissions. " +
+			"If a schema is specified, the policies and request are validated against it first",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policies",
+				MarkdownDescription: "Cedar policies",
+			},
+			function.StringParameter{
+				Name:                "schema",
+				MarkdownDescription: "Cedar schema in JSON format. An empty string means no validation",
+			},
+			function.StringParameter{
+				Name:                "entities",
+				MarkdownDescription: "Cedar entities in JSON format",
+			},
+			function.StringParameter{
+				Name:                "request",
+				MarkdownDescription: "Authorization request in JSON format, with `principal`, `action` and `resource` entity UIDs and an optional `context` record",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: verifiedPermissionsIsAuthorizedResultAttrTypes,
+		},
+	}
+}
+
+func (f verifiedPermissionsIsAuthorizedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies, schema, entities, request string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &schema, &entities, &request))
+	if resp.Error != nil {
+		return
+	}
+
+	out, err := tfcedar.IsAuthorized(policies, schema, entities, request)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	determiningPolicies, d := types.ListValueFrom(ctx, types.StringType, out.DeterminingPolicies)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	errorMessages, d := types.ListValueFrom(ctx, types.StringType, out.Errors)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"decision":             types.StringValue(out.Decision),
+		"determining_policies": determiningPolicies,
+		"errors":               errorMessages,
+	}
+
+	result, d := types.ObjectValue(verifiedPermissionsIsAuthorizedResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
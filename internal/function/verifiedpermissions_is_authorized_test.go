@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVerifiedPermissionsIsAuthorizedFunction_allow(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVerifiedPermissionsIsAuthorizedFunctionConfig(`PhotoApp::User::"alice"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "ALLOW"),
+					resource.TestCheckOutput("determining_policies", "policy1"),
+				),
+			},
+		},
+	})
+}
+
+func TestVerifiedPermissionsIsAuthorizedFunction_deny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVerifiedPermissionsIsAuthorizedFunctionConfig(`PhotoApp::User::"bob"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "DENY"),
+					resource.TestCheckOutput("determining_policies", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestVerifiedPermissionsIsAuthorizedFunction_invalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVerifiedPermissionsIsAuthorizedFunctionConfig(`PhotoApp::Admin::"alice"`),
+				ExpectError: regexache.MustCompile(`undeclared[\s\n]*entity[\s\n]*type`),
+			},
+		},
+	})
+}
+
+func testVerifiedPermissionsIsAuthorizedFunctionConfig(principal string) string {
+	return `
+locals {
+  schema = jsonencode({
+    PhotoApp = {
+      entityTypes = {
+        User  = {}
+        Photo = {}
+      }
+      actions = {
+        viewPhoto = {
+          appliesTo = {
+            principalTypes = ["User"]
+            resourceTypes  = ["Photo"]
+          }
+        }
+      }
+    }
+  })
+
+  entities = jsonencode([
+    { uid = { type = "PhotoApp::User", id = "alice" }, parents = [], attrs = {} },
+    { uid = { type = "PhotoApp::User", id = "bob" }, parents = [], attrs = {} },
+    { uid = { type = "PhotoApp::Photo", id = "vacation.jpg" }, parents = [], attrs = {} },
+  ])
+
+  request = jsonencode({
+    principal = { type = "PhotoApp::User", id = "alice" }
+    action    = { type = "PhotoApp::Action", id = "viewPhoto" }
+    resource  = { type = "PhotoApp::Photo", id = "vacation.jpg" }
+  })
+
+  policies = <<-EOT
+    forbid(principal, action, resource) when { principal == PhotoApp::User::"mallory" };
+    permit(principal == ` + principal + `, action == PhotoApp::Action::"viewPhoto", resource);
+  EOT
+
+  result = provider::aws::verifiedpermissions_is_authorized(local.policies, local.schema, local.entities, local.request)
+}
+
+output "decision" {
+  value = local.result.decision
+}
+
+output "determining_policies" {
+  value = join(",", local.result.determining_policies)
+}
+`
+}
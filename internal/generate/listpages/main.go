@@ -19,6 +19,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/listpages/naming"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"golang.org/x/tools/go/packages"
 )
@@ -206,14 +207,8 @@ func (g *Generator) generateFunction(functionName, awsService string, export boo
 		log.Fatalf("function \"%s\" not found", functionName)
 	}
 
-	funcName := function.Name.Name
-
-	if !export {
-		funcName = fmt.Sprintf("%s%s", strings.ToLower(funcName[0:1]), funcName[1:])
-	}
-
 	funcSpec := FuncSpec{
-		Name:            fixSomeInitialisms(funcName),
+		Name:            naming.FuncName(function.Name.Name, export),
 		AWSName:         function.Name.Name,
 		AWSService:      awsService,
 		ParamType:       g.expandTypeField(function.Type.Params, false), // Assumes there is a single input parameter
@@ -267,101 +262,3 @@ func (g *Generator) format() []byte {
 	}
 	return src
 }
-
-func fixSomeInitialisms(s string) string {
-	replace := s
-
-	replace = strings.Replace(replace, "ResourceSes", "ResourceSES", 1)
-	replace = strings.Replace(replace, "ApiGateway", "APIGateway", 1)
-	replace = strings.Replace(replace, "Cloudwatch", "CloudWatch", 1)
-	replace = strings.Replace(replace, "CurReport", "CURReport", 1)
-	replace = strings.Replace(replace, "CloudHsm", "CloudHSM", 1)
-	replace = strings.Replace(replace, "DynamoDb", "DynamoDB", 1)
-	replace = strings.Replace(replace, "Opsworks", "OpsWorks", 1)
-	replace = strings.Replace(replace, "Precheck", "PreCheck", 1)
-	replace = strings.Replace(replace, "Graphql", "GraphQL", 1)
-	replace = strings.Replace(replace, "Haproxy", "HAProxy", 1)
-	replace = strings.Replace(replace, "Acmpca", "ACMPCA", 1)
-	replace = strings.Replace(replace, "AcmPca", "ACMPCA", 1)
-	replace = strings.Replace(replace, "Dnssec", "DNSSEC", 1)
-	replace = strings.Replace(replace, "DocDb", "DocDB", 1)
-	replace = strings.Replace(replace, "Docdb", "DocDB", 1)
-	replace = strings.Replace(replace, "Https", "HTTPS", 1)
-	replace = strings.Replace(replace, "Ipset", "IPSet", 1)
-	replace = strings.Replace(replace, "Iscsi", "iSCSI", 1)
-	replace = strings.Replace(replace, "Mysql", "MySQL", 1)
-	replace = strings.Replace(replace, "Wafv2", "WAFV2", 1)
-	replace = strings.Replace(replace, "Cidr", "CIDR", 1)
-	replace = strings.Replace(replace, "Coip", "CoIP", 1)
-	replace = strings.Replace(replace, "Dhcp", "DHCP", 1)
-	replace = strings.Replace(replace, "Dkim", "DKIM", 1)
-	replace = strings.Replace(replace, "Grpc", "GRPC", 1)
-	replace = strings.Replace(replace, "Http", "HTTP", 1)
-	replace = strings.Replace(replace, "Mwaa", "MWAA", 1)
-	replace = strings.Replace(replace, "Oidc", "OIDC", 1)
-	replace = strings.Replace(replace, "Qldb", "QLDB", 1)
-	replace = strings.Replace(replace, "Smtp", "SMTP", 1)
-	replace = strings.Replace(replace, "Xray", "XRay", 1)
-	replace = strings.Replace(replace, "Acl", "ACL", 1)
-	replace = strings.Replace(replace, "Acm", "ACM", 1)
-	replace = strings.Replace(replace, "Ami", "AMI", 1)
-	replace = strings.Replace(replace, "Api", "API", 1)
-	replace = strings.Replace(replace, "Arn", "ARN", 1)
-	replace = strings.Replace(replace, "Bgp", "BGP", 1)
-	replace = strings.Replace(replace, "Csv", "CSV", 1)
-	replace = strings.Replace(replace, "Dax", "DAX", 1)
-	replace = strings.Replace(replace, "Dlm", "DLM", 1)
-	replace = strings.Replace(replace, "Dms", "DMS", 1)
-	replace = strings.Replace(replace, "Dns", "DNS", 1)
-	replace = strings.Replace(replace, "Ebs", "EBS", 1)
-	replace = strings.Replace(replace, "Ec2", "EC2", 1)
-	replace = strings.Replace(replace, "Ecr", "ECR", 1)
-	replace = strings.Replace(replace, "Ecs", "ECS", 1)
-	replace = strings.Replace(replace, "Efs", "EFS", 1)
-	replace = strings.Replace(replace, "Eip", "EIP", 1)
-	replace = strings.Replace(replace, "Eks", "EKS", 1)
-	replace = strings.Replace(replace, "Elb", "ELB", 1)
-	replace = strings.Replace(replace, "Emr", "EMR", 1)
-	replace = strings.Replace(replace, "Fms", "FMS", 1)
-	replace = strings.Replace(replace, "Fsx", "FSx", 1)
-	replace = strings.Replace(replace, "Hsm", "HSM", 1)
-	replace = strings.Replace(replace, "Iam", "IAM", 1)
-	replace = strings.Replace(replace, "Iot", "IoT", 1)
-	replace = strings.Replace(replace, "Kms", "KMS", 1)
-	replace = strings.Replace(replace, "Msk", "MSK", 1)
-	replace = strings.Replace(replace, "Nat", "NAT", 1)
-	replace = strings.Replace(replace, "Nfs", "NFS", 1)
-	replace = strings.Replace(replace, "Php", "PHP", 1)
-	replace = strings.Replace(replace, "Ram", "RAM", 1)
-	replace = strings.Replace(replace, "Rds", "RDS", 1)
-	replace = strings.Replace(replace, "Rfc", "RFC", 1)
-	replace = strings.Replace(replace, "Sfn", "SFN", 1)
-	replace = strings.Replace(replace, "Smb", "SMB", 1)
-	replace = strings.Replace(replace, "Sms", "SMS", 1)
-	replace = strings.Replace(replace, "Sns", "SNS", 1)
-	replace = strings.Replace(replace, "Sql", "SQL", 1)
-	replace = strings.Replace(replace, "Sqs", "SQS", 1)
-	replace = strings.Replace(replace, "Ssh", "SSH", 1)
-	replace = strings.Replace(replace, "Ssm", "SSM", 1)
-	replace = strings.Replace(replace, "Sso", "SSO", 1)
-	replace = strings.Replace(replace, "Sts", "STS", 1)
-	replace = strings.Replace(replace, "Swf", "SWF", 1)
-	replace = strings.Replace(replace, "Tcp", "TCP", 1)
-	replace = strings.Replace(replace, "Vpc", "VPC", 1)
-	replace = strings.Replace(replace, "Vpn", "VPN", 1)
-	replace = strings.Replace(replace, "Waf", "WAF", 1)
-	replace = strings.Replace(replace, "Xss", "XSS", 1)
-	replace = strings.Replace(replace, "Db", "DB", 1)
-	replace = strings.Replace(replace, "Ip", "IP", 1)
-	replace = strings.Replace(replace, "Mq", "MQ", 1)
-
-	if replace != strings.TrimSuffix(replace, "Ids") {
-		replace = fmt.Sprintf("%s%s", strings.TrimSuffix(replace, "Ids"), "IDs")
-	}
-
-	if replace != strings.TrimSuffix(replace, "Id") {
-		replace = fmt.Sprintf("%s%s", strings.TrimSuffix(replace, "Id"), "ID")
-	}
-
-	return replace
-}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FuncName returns the name, without the "Pages" suffix, of the function generated for the specified list operation.
+func FuncName(listOp string, export bool) string {
+	funcName := listOp
+
+	if !export {
+		funcName = fmt.Sprintf("%s%s", strings.ToLower(funcName[0:1]), funcName[1:])
+	}
+
+	return FixSomeInitialisms(funcName)
+}
+
+// initialisms lists well-known initialisms in AWS API names and their correct spelling.
+// The order is significant: an entry must precede any entry that it contains.
+var initialisms = []struct {
+	from, to string
+}{
+	{"ResourceSes", "ResourceSES"},
+	{"ApiGateway", "APIGateway"},
+	{"Cloudwatch", "CloudWatch"},
+	{"CurReport", "CURReport"},
+	{"CloudHsm", "CloudHSM"},
+	{"DynamoDb", "DynamoDB"},
+	{"Opsworks", "OpsWorks"},
+	{"Precheck", "PreCheck"},
+	{"Graphql", "GraphQL"},
+	{"Haproxy", "HAProxy"},
+	{"Acmpca", "ACMPCA"},
+	{"AcmPca", "ACMPCA"},
+	{"Dnssec", "DNSSEC"},
+	{"DocDb", "DocDB"},
+	{"Docdb", "DocDB"},
+	{"Https", "HTTPS"},
+	{"Ipset", "IPSet"},
+	{"Iscsi", "iSCSI"},
+	{"Mysql", "MySQL"},
+	{"Wafv2", "WAFV2"},
+	{"Json", "JSON"},
+	{"Cidr", "CIDR"},
+	{"Coip", "CoIP"},
+	{"Dhcp", "DHCP"},
+	{"Dkim", "DKIM"},
+	{"Grpc", "GRPC"},
+	{"Http", "HTTP"},
+	{"Mwaa", "MWAA"},
+	{"Oidc", "OIDC"},
+	{"Qldb", "QLDB"},
+	{"Smtp", "SMTP"},
+	{"Xray", "XRay"},
+	{"Acl", "ACL"},
+	{"Acm", "ACM"},
+	{"Ami", "AMI"},
+	{"Api", "API"},
+	{"Arn", "ARN"},
+	{"Bgp", "BGP"},
+	{"Csv", "CSV"},
+	{"Dax", "DAX"},
+	{"Dlm", "DLM"},
+	{"Dms", "DMS"},
+	{"Dns", "DNS"},
+	{"Ebs", "EBS"},
+	{"Ec2", "EC2"},
+	{"Ecr", "ECR"},
+	{"Ecs", "ECS"},
+	{"Efs", "EFS"},
+	{"Eip", "EIP"},
+	{"Eks", "EKS"},
+	{"Elb", "ELB"},
+	{"Emr", "EMR"},
+	{"Fms", "FMS"},
+	{"Fsx", "FSx"},
+	{"Hsm", "HSM"},
+	{"Iam", "IAM"},
+	{"Iot", "IoT"},
+	{"Kms", "KMS"},
+	{"Msk", "MSK"},
+	{"Nat", "NAT"},
+	{"Nfs", "NFS"},
+	{"Php", "PHP"},
+	{"Ram", "RAM"},
+	{"Rds", "RDS"},
+	{"Rfc", "RFC"},
+	{"Sfn", "SFN"},
+	{"Smb", "SMB"},
+	{"Sms", "SMS"},
+	{"Sns", "SNS"},
+	{"Sql", "SQL"},
+	{"Sqs", "SQS"},
+	{"Ssh", "SSH"},
+	{"Ssl", "SSL"},
+	{"Ssm", "SSM"},
+	{"Sso", "SSO"},
+	{"Sts", "STS"},
+	{"Swf", "SWF"},
+	{"Tcp", "TCP"},
+	{"Tls", "TLS"},
+	{"Uri", "URI"},
+	{"Url", "URL"},
+	{"Vpc", "VPC"},
+	{"Vpn", "VPN"},
+	{"Waf", "WAF"},
+	{"Xss", "XSS"},
+	{"Db", "DB"},
+	{"Ip", "IP"},
+	{"Mq", "MQ"},
+}
+
+// FixSomeInitialisms replaces well-known initialisms in an AWS API name.
+func FixSomeInitialisms(s string) string {
+	replace := s
+
+	for _, v := range initialisms {
+		replace = strings.Replace(replace, v.from, v.to, 1)
+	}
+
+	if replace != strings.TrimSuffix(replace, "Ids") {
+		replace = fmt.Sprintf("%s%s", strings.TrimSuffix(replace, "Ids"), "IDs")
+	}
+
+	if replace != strings.TrimSuffix(replace, "Id") {
+		replace = fmt.Sprintf("%s%s", strings.TrimSuffix(replace, "Id"), "ID")
+	}
+
+	return replace
+}
+
+// Initialism returns the correct spelling of word if it is a well-known initialism, or the plural of one.
+// For example, "Arn" is spelled "ARN" and "Arns" is spelled "ARNs".
+// Words that merely contain an initialism, such as "Identifier", are not matched.
+func Initialism(word string) (string, bool) {
+	if word == "Id" {
+		return "ID", true
+	}
+
+	for _, v := range initialisms {
+		if word == v.from {
+			return v.to, true
+		}
+	}
+
+	if v, ok := strings.CutSuffix(word, "s"); ok && v != "" {
+		if v, ok := Initialism(v); ok {
+			return v + "s", true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package naming
+
+import (
+	"testing"
+)
+
+func TestFuncName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		listOp   string
+		export   bool
+		expected string
+	}{
+		"unexported": {
+			listOp:   "ListGraphqlApis",
+			expected: "listGraphQLAPIs",
+		},
+		"exported": {
+			listOp:   "DescribeVpcEndpoints",
+			export:   true,
+			expected: "DescribeVPCEndpoints",
+		},
+		"id suffix": {
+			listOp:   "ListResourcesById",
+			expected: "listResourcesByID",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := FuncName(testCase.listOp, testCase.export), testCase.expected; got != want {
+				t.Errorf("FuncName(%q, %t) = %q, want %q", testCase.listOp, testCase.export, got, want)
+			}
+		})
+	}
+}
+
+func TestInitialism(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		word     string
+		expected string
+		ok       bool
+	}{
+		"initialism": {
+			word:     "Arn",
+			expected: "ARN",
+			ok:       true,
+		},
+		"plural": {
+			word:     "Arns",
+			expected: "ARNs",
+			ok:       true,
+		},
+		"id": {
+			word:     "Id",
+			expected: "ID",
+			ok:       true,
+		},
+		"ids": {
+			word:     "Ids",
+			expected: "IDs",
+			ok:       true,
+		},
+		"ends in s": {
+			word:     "Https",
+			expected: "HTTPS",
+			ok:       true,
+		},
+		"contains initialism": {
+			word: "Identifier",
+		},
+		"ends with initialism": {
+			word: "Valid",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := Initialism(testCase.word)
+			if got, want := ok, testCase.ok; got != want {
+				t.Errorf("Initialism(%q) ok = %t, want %t", testCase.word, got, want)
+			}
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("Initialism(%q) = %q, want %q", testCase.word, got, want)
+			}
+		})
+	}
+}
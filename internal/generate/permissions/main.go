@@ -0,0 +1,1064 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+// The generator statically walks each resource's and data source's CRUD handlers, and the functions and methods they
+// reference, looking for AWS API calls made with clients obtained from the conns.AWSClient.<Service>Client methods
+// in internal/conns/awsclient_gen.go, or from a service package's own functions returning AWS SDK for Go v1 clients.
+// Each AWS API operation found is mapped to the IAM action that authorizes it.
+// The tagging operations of resources with transparent tagging are taken from their service package's tags generator directives.
+// iam:PassRole is added to the create and update actions of resources with schema attributes that take IAM roles.
+// No other dependent actions, i.e. additional actions that AWS authorizes for an operation, are modeled.
+
+const (
+	filename = `permissions_gen.json`
+
+	modulePath       = "github.com/hashicorp/terraform-provider-aws"
+	sdkPackagePath   = "github.com/aws/aws-sdk-go-v2/service/"
+	sdkV1PackagePath = "github.com/aws/aws-sdk-go/service/"
+
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+
+	actionPassRole = "iam:PassRole"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/permissions/%s", filename)
+
+	clients, err := readAWSClientMethods(filepath.Join("..", "conns", "awsclient_gen.go"))
+	if err != nil {
+		g.Fatalf("reading AWS client methods: %s", err)
+	}
+
+	attrConsts, err := readAttrConsts(filepath.Join("..", "..", "names", "attr_consts_gen.go"))
+	if err != nil {
+		g.Fatalf("reading attribute name constants: %s", err)
+	}
+
+	idx := &index{
+		attrConsts:      attrConsts,
+		clients:         clients,
+		clientFunctions: make(map[string]string),
+		functions:       make(map[string]*function),
+	}
+
+	dirs, err := os.ReadDir(filepath.Join("..", "service"))
+	if err != nil {
+		g.Fatalf("reading service packages: %s", err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		if err := idx.processDir(filepath.Join("..", "service", dir.Name()), modulePath+"/internal/service/"+dir.Name()); err != nil {
+			g.Fatalf("processing service package (%s): %s", dir.Name(), err)
+		}
+	}
+
+	m := manifest{
+		DataSources: make(map[string]map[string][]string),
+		Resources:   make(map[string]map[string][]string),
+	}
+
+	for _, v := range idx.entryPoints {
+		operations := make(map[string][]string)
+
+		for operation, keys := range v.operations {
+			// Operations that require no IAM actions are omitted.
+			if actions := idx.actions(keys, v.tagsResourceType); len(actions) > 0 {
+				operations[operation] = actions
+			}
+		}
+
+		// Passing a role to an AWS service, e.g. on CreateFunction, is also authorized by iam:PassRole on the role.
+		if !v.dataSource && idx.passesRole(v) {
+			for _, operation := range []string{operationCreate, operationUpdate} {
+				if actions, ok := operations[operation]; ok && !slices.Contains(actions, actionPassRole) {
+					actions = append(actions, actionPassRole)
+					slices.Sort(actions)
+					operations[operation] = actions
+				}
+			}
+		}
+
+		if v.dataSource {
+			m.DataSources[v.typeName] = operations
+		} else {
+			m.Resources[v.typeName] = operations
+		}
+	}
+
+	body, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		g.Fatalf("encoding manifest: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type manifest struct {
+	DataSources map[string]map[string][]string `json:"data_sources"`
+	Resources   map[string]map[string][]string `json:"resources"`
+}
+
+// readAWSClientMethods returns the AWS SDK for Go v2 package path of the client returned by each conns.AWSClient method.
+func readAWSClientMethods(filename string) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := fileImports(file)
+	clients := make(map[string]string)
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || funcDecl.Type.Results == nil || len(funcDecl.Type.Results.List) != 1 {
+			continue
+		}
+
+		if path, ok := sdkClientType(funcDecl.Type.Results.List[0].Type, imports); ok {
+			clients[funcDecl.Name.Name] = path
+		}
+	}
+
+	return clients, nil
+}
+
+// readAttrConsts returns the values of the names.Attr... schema attribute name constants, keyed by constant name.
+func readAttrConsts(filename string) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	attrConsts := make(map[string]string)
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if i >= len(valueSpec.Values) {
+					continue
+				}
+				if lit, ok := valueSpec.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if value, err := strconv.Unquote(lit.Value); err == nil {
+						attrConsts[name.Name] = value
+					}
+				}
+			}
+		}
+	}
+
+	return attrConsts, nil
+}
+
+// fileImports returns the import paths of a source file, keyed by package name.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+
+	return imports
+}
+
+// sdkClientType returns the AWS SDK package path if the type expression is *<package>.Client (AWS SDK for Go v2),
+// or the AWS SDK for Go v1 client type, e.g. *simpledb.SimpleDB.
+func sdkClientType(expr ast.Expr, imports map[string]string) (string, bool) {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return "", false
+	}
+
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+
+	path, ok := imports[ident.Name]
+	if !ok {
+		return "", false
+	}
+
+	switch {
+	case strings.HasPrefix(path, sdkPackagePath) && sel.Sel.Name == "Client":
+		return path, true
+	case strings.HasPrefix(path, sdkV1PackagePath) && strings.EqualFold(sel.Sel.Name, path[len(sdkV1PackagePath):]):
+		return path, true
+	}
+
+	return "", false
+}
+
+// operation is an AWS API operation.
+type operation struct {
+	sdkPackagePath string
+	name           string
+}
+
+// newOperation returns the AWS API operation called by a client method.
+// AWS SDK for Go v1 method names have suffixes, e.g. DescribeDomainsPagesWithContext, that aren't part of the operation name.
+func newOperation(sdkPackagePath, method string) operation {
+	if strings.HasPrefix(sdkPackagePath, sdkV1PackagePath) {
+		method = strings.TrimSuffix(method, "WithContext")
+		method = strings.TrimSuffix(method, "Pages")
+		method = strings.TrimSuffix(method, "Request")
+	}
+
+	return operation{sdkPackagePath: sdkPackagePath, name: method}
+}
+
+// function is a function, method or function literal.
+type function struct {
+	operations []operation
+	// callees are the keys of the functions and methods referenced.
+	callees []string
+	// roleAttribute is set if the function defines a schema attribute that takes an IAM role.
+	roleAttribute bool
+}
+
+// entryPoint is a resource or data source, with the keys of the functions implementing each of its operations.
+type entryPoint struct {
+	typeName   string
+	dataSource bool
+	operations map[string][]string
+	// tagsResourceType is the resource type passed to the service package's ListTags and UpdateTags methods.
+	tagsResourceType string
+	// schemaKeys are the keys of the functions and methods that may define the schema.
+	schemaKeys []string
+}
+
+type index struct {
+	// attrConsts are the values of the names.Attr... constants, keyed by constant name.
+	attrConsts map[string]string
+	// clients are the AWS SDK for Go v2 package paths of the clients returned by each conns.AWSClient method.
+	clients map[string]string
+	// clientFunctions are the AWS SDK package paths of the clients returned by service package functions,
+	// keyed by <package path>.<name>.
+	clientFunctions map[string]string
+	// functions are keyed by <package path>.<name> or <package path>.<receiver type>.<name>.
+	functions   map[string]*function
+	entryPoints []*entryPoint
+}
+
+var (
+	annotation    = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+	paginator     = regexache.MustCompile(`^New([0-9A-Za-z]+)Paginator$`)
+	roleAttribute = regexache.MustCompile(`^(.+_)?(role|role_arn|role_arns|roles|iam_instance_profile)$`)
+	tagsDirective = regexache.MustCompile(`^//go:generate go run \.\./\.\./generate/tags/main\.go (.*)$`)
+)
+
+// processDir indexes the functions, resources and data sources in a service package.
+func (idx *index) processDir(dir, packagePath string) error {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		// Clients may be obtained from functions in any of the package's files.
+		for _, file := range pkg.Files {
+			idx.processClientFunctions(file, packagePath)
+		}
+
+		if err := idx.processTagsDirectives(dir, pkg, packagePath); err != nil {
+			return err
+		}
+
+		// Process files in a stable order.
+		for _, name := range slices.Sorted(maps.Keys(pkg.Files)) {
+			idx.processFile(pkg.Files[name], packagePath)
+		}
+	}
+
+	return nil
+}
+
+// processClientFunctions records the package-level functions that return an AWS SDK client.
+func (idx *index) processClientFunctions(file *ast.File, packagePath string) {
+	imports := fileImports(file)
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv != nil || funcDecl.Type.Results == nil || len(funcDecl.Type.Results.List) != 1 {
+			continue
+		}
+
+		if path, ok := sdkClientType(funcDecl.Type.Results.List[0].Type, imports); ok {
+			idx.clientFunctions[packagePath+"."+funcDecl.Name.Name] = path
+		}
+	}
+}
+
+// processTagsDirectives indexes the tagging operations generated by the package's tags generator directives
+// as the functions with keys <package path>.#tags[<operation>], where operation is the resource operation that calls them.
+// Transparent tagging lists tags after Create, Read and Update, and tags and untags resources on Create and Update.
+func (idx *index) processTagsDirectives(dir string, pkg *ast.Package, packagePath string) error {
+	body, err := os.ReadFile(filepath.Join(dir, "generate.go"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	// The AWS SDK package of the generated tagging functions.
+	var sdkPath string
+	for name, file := range pkg.Files {
+		if filepath.Base(name) == "tags_gen.go" {
+			for _, path := range fileImports(file) {
+				if strings.HasPrefix(path, sdkPackagePath) && !strings.Contains(path[len(sdkPackagePath):], "/") {
+					sdkPath = path
+				}
+			}
+		}
+	}
+	if sdkPath == "" {
+		return nil
+	}
+
+	functions := map[string]*function{
+		operationCreate: {},
+		operationRead:   {},
+		operationUpdate: {},
+	}
+
+	for _, line := range strings.Split(string(body), "\n") {
+		m := tagsDirective.FindStringSubmatch(strings.TrimSpace(line))
+		if len(m) == 0 {
+			continue
+		}
+
+		flags := make(map[string]string)
+		for _, arg := range strings.Fields(m[1]) {
+			if arg == "--" {
+				break
+			}
+			name, value, _ := strings.Cut(strings.TrimPrefix(arg, "-"), "=")
+			flags[name] = value
+		}
+
+		if _, ok := flags["ListTags"]; ok {
+			op := operation{sdkPackagePath: sdkPath, name: cmp.Or(flags["ListTagsOp"], "ListTagsForResource")}
+			for _, v := range []string{operationCreate, operationRead, operationUpdate} {
+				functions[v].operations = append(functions[v].operations, op)
+			}
+		}
+		if _, ok := flags["UpdateTags"]; ok {
+			for _, op := range []operation{
+				{sdkPackagePath: sdkPath, name: cmp.Or(flags["TagOp"], "TagResource")},
+				{sdkPackagePath: sdkPath, name: cmp.Or(flags["UntagOp"], "UntagResource")},
+			} {
+				for _, v := range []string{operationCreate, operationUpdate} {
+					functions[v].operations = append(functions[v].operations, op)
+				}
+			}
+		}
+	}
+
+	for operation, f := range functions {
+		idx.functions[packagePath+".#tags["+operation+"]"] = f
+	}
+
+	return nil
+}
+
+func (idx *index) processFile(file *ast.File, packagePath string) {
+	imports := fileImports(file)
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Body == nil {
+			continue
+		}
+
+		a := &analyzer{
+			idx:         idx,
+			imports:     imports,
+			packagePath: packagePath,
+		}
+
+		key := packagePath + "." + funcDecl.Name.Name
+		if funcDecl.Recv != nil && len(funcDecl.Recv.List) == 1 {
+			a.receiverType = receiverTypeName(funcDecl.Recv.List[0].Type)
+			if names := funcDecl.Recv.List[0].Names; len(names) == 1 {
+				a.receiverName = names[0].Name
+			}
+			key = packagePath + "." + a.receiverType + "." + funcDecl.Name.Name
+		}
+
+		f := a.analyze(funcDecl.Type, funcDecl.Body)
+		f.roleAttribute = a.definesRoleAttribute(funcDecl.Body)
+		idx.functions[key] = f
+
+		a.analyzeCases(key, funcDecl.Type, funcDecl.Body)
+
+		if funcDecl.Recv == nil && funcDecl.Doc != nil {
+			idx.processAnnotations(funcDecl, a)
+		}
+	}
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(v.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(v.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(v.X)
+	case *ast.Ident:
+		return v.Name
+	}
+
+	return ""
+}
+
+// processAnnotations records the resource or data source implemented by an annotated factory function.
+func (idx *index) processAnnotations(funcDecl *ast.FuncDecl, a *analyzer) {
+	var typeName, kind, tagsResourceType string
+	var tags bool
+
+	for _, line := range funcDecl.Doc.List {
+		m := annotation.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		args := common.ParseArgs(m[3])
+
+		switch m[1] {
+		case "FrameworkDataSource", "FrameworkResource", "SDKDataSource", "SDKResource":
+			if len(args.Positional) > 0 {
+				typeName, kind = args.Positional[0], m[1]
+			}
+		case "Tags":
+			_, tags = args.Keyword["identifierAttribute"]
+			tagsResourceType = args.Keyword["resourceType"]
+		}
+	}
+
+	if typeName == "" {
+		return
+	}
+
+	e := &entryPoint{
+		typeName:         typeName,
+		dataSource:       strings.HasSuffix(kind, "DataSource"),
+		operations:       make(map[string][]string),
+		tagsResourceType: tagsResourceType,
+	}
+
+	switch kind {
+	case "SDKDataSource", "SDKResource":
+		idx.sdkHandlers(funcDecl, a, e)
+
+		// The schema is defined by the factory function or by the functions it calls, e.g. resourceClusterSchema.
+		factoryKey := a.packagePath + "." + funcDecl.Name.Name
+		e.schemaKeys = append(e.schemaKeys, factoryKey)
+		for _, v := range idx.functions[factoryKey].callees {
+			if strings.Contains(v[strings.LastIndex(v, ".")+1:], "Schema") {
+				e.schemaKeys = append(e.schemaKeys, v)
+			}
+		}
+	case "FrameworkDataSource", "FrameworkResource":
+		idx.frameworkHandlers(funcDecl, a, e)
+	}
+
+	// Transparent tagging lists tags after Create, Read and Update and updates them after Create and Update.
+	// The generated tagging operations are added, as well as those of any hand-written ListTags and UpdateTags methods.
+	if tags && !e.dataSource {
+		for _, v := range []string{operationCreate, operationRead, operationUpdate} {
+			e.operations[v] = append(e.operations[v], a.packagePath+".servicePackage.ListTags", a.packagePath+".#tags["+v+"]")
+		}
+		e.operations[operationCreate] = append(e.operations[operationCreate], a.packagePath+".servicePackage.UpdateTags")
+		e.operations[operationUpdate] = append(e.operations[operationUpdate], a.packagePath+".servicePackage.UpdateTags")
+	}
+
+	idx.entryPoints = append(idx.entryPoints, e)
+}
+
+// sdkHandlers records the CRUD handlers of a Plugin SDK resource or data source, which are the values of
+// the schema.Resource fields returned by the factory function.
+func (idx *index) sdkHandlers(funcDecl *ast.FuncDecl, a *analyzer, e *entryPoint) {
+	operations := map[string]string{
+		"Create":               operationCreate,
+		"CreateContext":        operationCreate,
+		"CreateWithoutTimeout": operationCreate,
+		"Read":                 operationRead,
+		"ReadContext":          operationRead,
+		"ReadWithoutTimeout":   operationRead,
+		"StateContext":         operationRead, // Importer.
+		"Update":               operationUpdate,
+		"UpdateContext":        operationUpdate,
+		"UpdateWithoutTimeout": operationUpdate,
+		"Delete":               operationDelete,
+		"DeleteContext":        operationDelete,
+		"DeleteWithoutTimeout": operationDelete,
+	}
+
+	// Variables holding values of the package's types, whose methods may be handlers, e.g. h := &attributeHandler{}.
+	variables := make(map[string]string)
+
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		if assign, ok := node.(*ast.AssignStmt); ok && len(assign.Lhs) == len(assign.Rhs) {
+			for i, rhs := range assign.Rhs {
+				if unary, ok := rhs.(*ast.UnaryExpr); ok {
+					rhs = unary.X
+				}
+				lit, ok := rhs.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				if ident, ok := lit.Type.(*ast.Ident); ok {
+					if lhs, ok := assign.Lhs[i].(*ast.Ident); ok {
+						variables[lhs.Name] = ident.Name
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		kv, ok := node.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		operation, ok := operations[key.Name]
+		if !ok || (e.dataSource && operation != operationRead) {
+			return true
+		}
+
+		switch v := kv.Value.(type) {
+		case *ast.Ident:
+			e.operations[operation] = append(e.operations[operation], a.packagePath+"."+v.Name)
+		case *ast.SelectorExpr:
+			if ident, ok := v.X.(*ast.Ident); ok && variables[ident.Name] != "" {
+				e.operations[operation] = append(e.operations[operation], a.packagePath+"."+variables[ident.Name]+"."+v.Sel.Name)
+			}
+		case *ast.FuncLit:
+			literalKey := fmt.Sprintf("%s.%s#%s", a.packagePath, funcDecl.Name.Name, key.Name)
+			idx.functions[literalKey] = a.analyze(v.Type, v.Body)
+			e.operations[operation] = append(e.operations[operation], literalKey)
+		}
+
+		return true
+	})
+}
+
+// frameworkHandlers records the CRUD methods of a Plugin Framework resource or data source, whose type is the
+// type of the value returned by the factory function.
+func (idx *index) frameworkHandlers(funcDecl *ast.FuncDecl, a *analyzer, e *entryPoint) {
+	var typeName string
+
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		if typeName != "" {
+			return false
+		}
+
+		if lit, ok := node.(*ast.CompositeLit); ok {
+			if ident, ok := lit.Type.(*ast.Ident); ok {
+				typeName = ident.Name
+			}
+		}
+
+		return true
+	})
+
+	if typeName == "" {
+		return
+	}
+
+	methods := map[string]string{
+		"Create":      operationCreate,
+		"Read":        operationRead,
+		"ImportState": operationRead,
+		"Update":      operationUpdate,
+		"Delete":      operationDelete,
+	}
+
+	for method, operation := range methods {
+		if e.dataSource && method != "Read" {
+			continue
+		}
+
+		e.operations[operation] = append(e.operations[operation], a.packagePath+"."+typeName+"."+method)
+	}
+
+	e.schemaKeys = append(e.schemaKeys, a.packagePath+"."+typeName+".Schema")
+}
+
+// passesRole returns whether a resource has a schema attribute that takes an IAM role, which it passes to an AWS service.
+// IAM resources, e.g. aws_iam_role_policy_attachment, don't pass the roles that they reference.
+func (idx *index) passesRole(e *entryPoint) bool {
+	for _, key := range e.schemaKeys {
+		if strings.HasPrefix(key, modulePath+"/internal/service/iam.") {
+			return false
+		}
+		if f, ok := idx.functions[key]; ok && f.roleAttribute {
+			return true
+		}
+	}
+
+	return false
+}
+
+// actions returns the IAM actions required by the functions with the specified keys and the functions they reference.
+// resourceType, if set, selects the case of any switch on a resource type parameter.
+func (idx *index) actions(keys []string, resourceType string) []string {
+	visited := make(map[string]bool)
+	actions := make(map[string]bool)
+
+	var visit func(string)
+	visit = func(key string) {
+		// Only the case for the resource's type of a switch on resource type.
+		if k := key + "[" + resourceType + "]"; resourceType != "" && idx.functions[k] != nil {
+			key = k
+		}
+
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+
+		f, ok := idx.functions[key]
+		if !ok {
+			return
+		}
+
+		for _, v := range f.operations {
+			for _, action := range iamActions(v) {
+				actions[action] = true
+			}
+		}
+		for _, v := range f.callees {
+			visit(v)
+		}
+	}
+
+	for _, key := range keys {
+		visit(key)
+	}
+
+	// Non-nil, so that operations that require no IAM actions are written as [].
+	result := make([]string, 0, len(actions))
+	result = slices.AppendSeq(result, maps.Keys(actions))
+	slices.Sort(result)
+
+	return result
+}
+
+// analyzer finds the AWS API operations called, and the functions and methods referenced, by a function.
+type analyzer struct {
+	idx          *index
+	imports      map[string]string
+	packagePath  string
+	receiverName string
+	receiverType string
+}
+
+func (a *analyzer) analyze(funcType *ast.FuncType, body *ast.BlockStmt) *function {
+	return a.scan(body, a.parameterClients(funcType))
+}
+
+// analyzeCases indexes each case of a function's switch statements on its resourceType parameter, such as those in
+// a service package's ListTags and UpdateTags methods, as a separate function with key <key>[<resource type>].
+func (a *analyzer) analyzeCases(key string, funcType *ast.FuncType, body *ast.BlockStmt) {
+	if !hasParameter(funcType, "resourceType") {
+		return
+	}
+
+	// Variables holding clients that are assigned before the switch statement.
+	clients := a.parameterClients(funcType)
+	a.scan(body, clients)
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		stmt, ok := node.(*ast.SwitchStmt)
+		if !ok {
+			return true
+		}
+		if ident, ok := stmt.Tag.(*ast.Ident); !ok || ident.Name != "resourceType" {
+			return true
+		}
+
+		for _, v := range stmt.Body.List {
+			clause := v.(*ast.CaseClause)
+			for _, expr := range clause.List {
+				if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if value, err := strconv.Unquote(lit.Value); err == nil {
+						a.idx.functions[key+"["+value+"]"] = a.scan(&ast.BlockStmt{List: clause.Body}, maps.Clone(clients))
+					}
+				}
+			}
+		}
+
+		return false
+	})
+}
+
+func hasParameter(funcType *ast.FuncType, name string) bool {
+	if funcType.Params != nil {
+		for _, field := range funcType.Params.List {
+			for _, v := range field.Names {
+				if v.Name == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// parameterClients returns the function's parameters that are AWS SDK for Go v2 clients.
+func (a *analyzer) parameterClients(funcType *ast.FuncType) map[string]string {
+	clients := make(map[string]string)
+
+	if funcType.Params != nil {
+		for _, field := range funcType.Params.List {
+			if path, ok := sdkClientType(field.Type, a.imports); ok {
+				for _, name := range field.Names {
+					clients[name.Name] = path
+				}
+			}
+		}
+	}
+
+	return clients
+}
+
+// scan returns the AWS API operations called, and the functions and methods referenced, in the body.
+// clients are the variables holding AWS SDK for Go v2 clients, keyed by name. Assignments to clients are added.
+func (a *analyzer) scan(body *ast.BlockStmt, clients map[string]string) *function {
+	f := &function{}
+	callees := make(map[string]bool)
+	// Selected field and method names, which aren't references to package-level functions.
+	selected := make(map[*ast.Ident]bool)
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch v := node.(type) {
+		case *ast.CompositeLit:
+			// Don't look inside nested resource schemas: their handlers belong to other resources.
+			if sel, ok := v.Type.(*ast.SelectorExpr); ok && sel.Sel.Name == "Resource" {
+				return false
+			}
+
+		case *ast.AssignStmt:
+			if len(v.Lhs) == len(v.Rhs) {
+				for i, rhs := range v.Rhs {
+					if ident, ok := v.Lhs[i].(*ast.Ident); ok {
+						if path, ok := a.client(rhs); ok {
+							clients[ident.Name] = path
+						}
+					}
+				}
+			}
+
+		case *ast.ValueSpec:
+			if len(v.Names) == len(v.Values) {
+				for i, value := range v.Values {
+					if path, ok := a.client(value); ok {
+						clients[v.Names[i].Name] = path
+					}
+				}
+			}
+
+		case *ast.SelectorExpr:
+			selected[v.Sel] = true
+			ident, isIdent := v.X.(*ast.Ident)
+
+			switch {
+			case isIdent && clients[ident.Name] != "":
+				// conn.<Operation>(...)
+				if v.Sel.Name != "Options" {
+					f.operations = append(f.operations, newOperation(clients[ident.Name], v.Sel.Name))
+				}
+				return false
+			case isIdent && ident.Name == a.receiverName && a.receiverName != "":
+				// r.<method>(...)
+				callees[a.packagePath+"."+a.receiverType+"."+v.Sel.Name] = true
+				return false
+			case isIdent && strings.HasPrefix(a.imports[ident.Name], sdkPackagePath):
+				// <service>.New<Operation>Paginator(conn, ...)
+				if m := paginator.FindStringSubmatch(v.Sel.Name); len(m) > 0 {
+					f.operations = append(f.operations, operation{sdkPackagePath: a.imports[ident.Name], name: m[1]})
+				}
+				return false
+			case isIdent && strings.HasPrefix(a.imports[ident.Name], modulePath+"/"):
+				// tf<service>.<Function>(...)
+				callees[a.imports[ident.Name]+"."+v.Sel.Name] = true
+				return false
+			}
+
+			// meta.(*conns.AWSClient).<Service>Client(ctx).<Operation>(...)
+			if path, ok := a.client(v.X); ok && v.Sel.Name != "Options" {
+				f.operations = append(f.operations, newOperation(path, v.Sel.Name))
+			}
+
+		case *ast.Ident:
+			// References to package-level functions, whether called or passed as values.
+			if !selected[v] {
+				callees[a.packagePath+"."+v.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	f.callees = slices.Sorted(maps.Keys(callees))
+
+	return f
+}
+
+// definesRoleAttribute returns whether the body defines a schema attribute, keyed by a string literal
+// or a names.Attr... constant, whose name shows that it takes an IAM role, e.g. execution_role_arn.
+func (a *analyzer) definesRoleAttribute(body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		if found {
+			return false
+		}
+
+		kv, ok := node.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		var name string
+		switch v := kv.Key.(type) {
+		case *ast.BasicLit:
+			if v.Kind == token.STRING {
+				name, _ = strconv.Unquote(v.Value)
+			}
+		case *ast.SelectorExpr:
+			if ident, ok := v.X.(*ast.Ident); ok && a.imports[ident.Name] == modulePath+"/names" {
+				name = a.idx.attrConsts[v.Sel.Name]
+			}
+		}
+
+		found = roleAttribute.MatchString(name)
+
+		return true
+	})
+
+	return found
+}
+
+// client returns the AWS SDK package path if the expression is a call to a conns.AWSClient client method
+// or to a function of the package that returns a client.
+func (a *analyzer) client(expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return "", false
+	}
+
+	switch v := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		path, ok := a.idx.clients[v.Sel.Name]
+		return path, ok
+	case *ast.Ident:
+		path, ok := a.idx.clientFunctions[a.packagePath+"."+v.Name]
+		return path, ok
+	}
+
+	return "", false
+}
+
+// iamActions returns the IAM actions that authorize an AWS API operation.
+// By default an operation is authorized by the action of the same name in the service's IAM namespace.
+func iamActions(op operation) []string {
+	sdkPackage := strings.TrimPrefix(strings.TrimPrefix(op.sdkPackagePath, sdkPackagePath), sdkV1PackagePath)
+
+	prefix, ok := iamServicePrefixes[sdkPackage]
+	if !ok {
+		prefix = sdkPackage
+	}
+
+	if v, ok := iamActionOverrides[prefix][op.name]; ok {
+		actions := make([]string, len(v))
+		for i, action := range v {
+			actions[i] = prefix + ":" + action
+		}
+		return actions
+	}
+
+	return []string{prefix + ":" + op.name}
+}
+
+// iamServicePrefixes are the IAM service prefixes, keyed by AWS SDK for Go v2 package name, that differ from the package name.
+var iamServicePrefixes = map[string]string{
+	"apigatewayv2":                 "apigateway",
+	"applicationautoscaling":       "application-autoscaling",
+	"appintegrations":              "app-integrations",
+	"autoscalingplans":             "autoscaling-plans",
+	"bedrockagent":                 "bedrock",
+	"chimesdkmediapipelines":       "chime",
+	"chimesdkvoice":                "chime",
+	"cloudcontrol":                 "cloudformation",
+	"cloudhsmv2":                   "cloudhsm",
+	"cloudwatchlogs":               "logs",
+	"codestarconnections":          "codestar-connections",
+	"codestarnotifications":        "codestar-notifications",
+	"cognitoidentity":              "cognito-identity",
+	"cognitoidentityprovider":      "cognito-idp",
+	"configservice":                "config",
+	"connectcases":                 "cases",
+	"costexplorer":                 "ce",
+	"databasemigrationservice":     "dms",
+	"directoryservice":             "ds",
+	"docdbelastic":                 "docdb-elastic",
+	"dynamodbstreams":              "dynamodb",
+	"ecrpublic":                    "ecr-public",
+	"efs":                          "elasticfilesystem",
+	"elasticloadbalancingv2":       "elasticloadbalancing",
+	"elasticsearchservice":         "es",
+	"emr":                          "elasticmapreduce",
+	"emrcontainers":                "emr-containers",
+	"emrserverless":                "emr-serverless",
+	"eventbridge":                  "events",
+	"keyspaces":                    "cassandra",
+	"kinesisanalyticsv2":           "kinesisanalytics",
+	"lexmodelbuildingservice":      "lex",
+	"lexmodelsv2":                  "lex",
+	"marketplacecatalog":           "aws-marketplace",
+	"mwaa":                         "airflow",
+	"networkfirewall":              "network-firewall",
+	"opensearch":                   "es",
+	"opensearchserverless":         "aoss",
+	"paymentcryptography":          "payment-cryptography",
+	"pinpointsmsvoicev2":           "sms-voice",
+	"rdsdata":                      "rds-data",
+	"redshiftdata":                 "redshift-data",
+	"redshiftserverless":           "redshift-serverless",
+	"resourcegroupstaggingapi":     "tag",
+	"route53recoverycontrolconfig": "route53-recovery-control-config",
+	"route53recoveryreadiness":     "route53-recovery-readiness",
+	"s3control":                    "s3",
+	"servicecatalogappregistry":    "servicecatalog",
+	"sesv2":                        "ses",
+	"sfn":                          "states",
+	"simpledb":                     "sdb",
+	"ssmcontacts":                  "ssm-contacts",
+	"ssmincidents":                 "ssm-incidents",
+	"ssmquicksetup":                "ssm-quicksetup",
+	"ssmsap":                       "ssm-sap",
+	"ssoadmin":                     "sso",
+	"timestreamwrite":              "timestream",
+	"vpclattice":                   "vpc-lattice",
+	"wafregional":                  "waf-regional",
+	"workspacesweb":                "workspaces-web",
+}
+
+// iamActionOverrides are the IAM actions, keyed by IAM service prefix and API operation name, that authorize
+// operations whose names differ from their actions' names.
+var iamActionOverrides = map[string]map[string][]string{
+	"s3": {
+		"CopyObject":                               {"GetObject", "PutObject"},
+		"DeleteBucketCors":                         {"PutBucketCORS"},
+		"DeleteBucketEncryption":                   {"PutEncryptionConfiguration"},
+		"DeleteBucketLifecycle":                    {"PutLifecycleConfiguration"},
+		"DeleteBucketOwnershipControls":            {"PutBucketOwnershipControls"},
+		"DeleteBucketReplication":                  {"PutReplicationConfiguration"},
+		"DeleteObjects":                            {"DeleteObject"},
+		"DeletePublicAccessBlock":                  {"PutBucketPublicAccessBlock"},
+		"GetBucketAccelerateConfiguration":         {"GetAccelerateConfiguration"},
+		"GetBucketAnalyticsConfiguration":          {"GetAnalyticsConfiguration"},
+		"GetBucketCors":                            {"GetBucketCORS"},
+		"GetBucketEncryption":                      {"GetEncryptionConfiguration"},
+		"GetBucketIntelligentTieringConfiguration": {"GetIntelligentTieringConfiguration"},
+		"GetBucketInventoryConfiguration":          {"GetInventoryConfiguration"},
+		"GetBucketLifecycleConfiguration":          {"GetLifecycleConfiguration"},
+		"GetBucketMetricsConfiguration":            {"GetMetricsConfiguration"},
+		"GetBucketNotificationConfiguration":       {"GetBucketNotification"},
+		"GetBucketReplication":                     {"GetReplicationConfiguration"},
+		"GetObjectLockConfiguration":               {"GetBucketObjectLockConfiguration"},
+		"GetPublicAccessBlock":                     {"GetBucketPublicAccessBlock"},
+		"HeadBucket":                               {"ListBucket"},
+		"HeadObject":                               {"GetObject"},
+		"ListObjectVersions":                       {"ListBucketVersions"},
+		"ListObjects":                              {"ListBucket"},
+		"ListObjectsV2":                            {"ListBucket"},
+		"PutBucketAccelerateConfiguration":         {"PutAccelerateConfiguration"},
+		"PutBucketAnalyticsConfiguration":          {"PutAnalyticsConfiguration"},
+		"PutBucketCors":                            {"PutBucketCORS"},
+		"PutBucketEncryption":                      {"PutEncryptionConfiguration"},
+		"PutBucketIntelligentTieringConfiguration": {"PutIntelligentTieringConfiguration"},
+		"PutBucketInventoryConfiguration":          {"PutInventoryConfiguration"},
+		"PutBucketLifecycleConfiguration":          {"PutLifecycleConfiguration"},
+		"PutBucketMetricsConfiguration":            {"PutMetricsConfiguration"},
+		"PutBucketNotificationConfiguration":       {"PutBucketNotification"},
+		"PutBucketReplication":                     {"PutReplicationConfiguration"},
+		"PutObjectLockConfiguration":               {"PutBucketObjectLockConfiguration"},
+		"PutPublicAccessBlock":                     {"PutBucketPublicAccessBlock"},
+	},
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/providermanifest/manifest"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	tffwprovider "github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The command writes a JSON manifest of every resource, data source, ephemeral resource and function
+// implemented by the provider.
+// Run it from the root of the repository, as it scans the service packages' source for annotations and sweepers:
+//
+//	go run -tags generate ./internal/generate/providermanifest [-o <file>]
+
+var (
+	outputFile = flag.String("o", "", "Output file (default: stdout)")
+	serviceDir = flag.String("service-dir", filepath.Join("internal", "service"), "Directory containing the service packages")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tprovidermanifest [-o <file>] [-service-dir <directory>]\n\n")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	src, err := manifest.ReadSource(*serviceDir)
+	if err != nil {
+		g.Fatalf("reading service packages: %s", err)
+	}
+
+	primary, err := provider.New(ctx)
+	if err != nil {
+		g.Fatalf("creating provider: %s", err)
+	}
+
+	m := &manifest.Manifest{
+		DataSources:        make([]*manifest.Entry, 0),
+		EphemeralResources: make([]*manifest.Entry, 0),
+		Functions:          make([]*manifest.FunctionEntry, 0),
+		Resources:          make([]*manifest.Entry, 0),
+	}
+
+	for _, sp := range primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		service := sp.ServicePackageName()
+		serviceName, _ := names.HumanFriendly(service)
+
+		newEntry := func(typeName, name, implementation string, v *types.ServicePackageResourceTags) *manifest.Entry {
+			e := &manifest.Entry{
+				TypeName:       typeName,
+				Name:           name,
+				Service:        service,
+				ServiceName:    serviceName,
+				Implementation: implementation,
+			}
+			if v != nil {
+				e.Tags = &manifest.Tags{
+					IdentifierAttribute: v.IdentifierAttribute,
+					ResourceType:        v.ResourceType,
+				}
+			}
+			return e
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			e := newEntry(v.TypeName, v.Name, manifest.ImplementationSDK, v.Tags)
+			r := v.Factory()
+			e.IdentifierAttribute = src.IdentifierAttribute(v.TypeName)
+			_, e.Taggable = r.SchemaMap()[names.AttrTags]
+			e.Importable = r.Importer != nil
+			e.Sweeper = src.Sweepers[v.TypeName]
+			e.Deprecated, e.DeprecationMessage = r.DeprecationMessage != "", r.DeprecationMessage
+			m.Resources = append(m.Resources, e)
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			e := newEntry(v.TypeName, v.Name, manifest.ImplementationFramework, v.Tags)
+			r, err := v.Factory(ctx)
+			if err != nil {
+				g.Fatalf("creating resource (%s): %s", v.TypeName, err)
+			}
+			var response resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &response)
+			e.IdentifierAttribute = src.IdentifierAttribute(v.TypeName)
+			_, e.Taggable = response.Schema.Attributes[names.AttrTags]
+			_, e.Importable = r.(resource.ResourceWithImportState)
+			e.Sweeper = src.Sweepers[v.TypeName]
+			e.Deprecated, e.DeprecationMessage = response.Schema.DeprecationMessage != "", response.Schema.DeprecationMessage
+			m.Resources = append(m.Resources, e)
+		}
+
+		for _, v := range sp.SDKDataSources(ctx) {
+			e := newEntry(v.TypeName, v.Name, manifest.ImplementationSDK, v.Tags)
+			r := v.Factory()
+			_, e.Taggable = r.SchemaMap()[names.AttrTags]
+			e.Deprecated, e.DeprecationMessage = r.DeprecationMessage != "", r.DeprecationMessage
+			m.DataSources = append(m.DataSources, e)
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			e := newEntry(v.TypeName, v.Name, manifest.ImplementationFramework, v.Tags)
+			d, err := v.Factory(ctx)
+			if err != nil {
+				g.Fatalf("creating data source (%s): %s", v.TypeName, err)
+			}
+			var response datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &response)
+			_, e.Taggable = response.Schema.Attributes[names.AttrTags]
+			e.Deprecated, e.DeprecationMessage = response.Schema.DeprecationMessage != "", response.Schema.DeprecationMessage
+			m.DataSources = append(m.DataSources, e)
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
+			for _, v := range sp.EphemeralResources(ctx) {
+				e := newEntry(v.TypeName, v.Name, manifest.ImplementationFramework, nil)
+				r, err := v.Factory(ctx)
+				if err != nil {
+					g.Fatalf("creating ephemeral resource (%s): %s", v.TypeName, err)
+				}
+				var response ephemeral.SchemaResponse
+				r.Schema(ctx, ephemeral.SchemaRequest{}, &response)
+				e.Deprecated, e.DeprecationMessage = response.Schema.DeprecationMessage != "", response.Schema.DeprecationMessage
+				m.EphemeralResources = append(m.EphemeralResources, e)
+			}
+		}
+	}
+
+	if p, ok := tffwprovider.New(primary).(fwprovider.ProviderWithFunctions); ok {
+		for _, newFunction := range p.Functions(ctx) {
+			f := newFunction()
+			var metadata function.MetadataResponse
+			f.Metadata(ctx, function.MetadataRequest{}, &metadata)
+			var definition function.DefinitionResponse
+			f.Definition(ctx, function.DefinitionRequest{}, &definition)
+			m.Functions = append(m.Functions, &manifest.FunctionEntry{
+				Name:               metadata.Name,
+				Summary:            definition.Definition.Summary,
+				Deprecated:         definition.Definition.DeprecationMessage != "",
+				DeprecationMessage: definition.Definition.DeprecationMessage,
+			})
+		}
+	}
+
+	body, err := manifest.Encode(m)
+	if err != nil {
+		g.Fatalf("encoding manifest: %s", err)
+	}
+
+	if *outputFile == "" {
+		if _, err := os.Stdout.Write(body); err != nil {
+			g.Fatalf("writing manifest: %s", err)
+		}
+		return
+	}
+
+	d := g.NewUnformattedFileDestination(*outputFile)
+
+	if err := d.BufferBytes(body); err != nil {
+		g.Fatalf("buffering manifest: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("writing manifest (%s): %s", *outputFile, err)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package manifest builds the provider manifest generated by the providermanifest generator.
+package manifest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ImplementationFramework = "framework"
+	ImplementationSDK       = "sdk"
+)
+
+// Manifest describes the provider's data sources, ephemeral resources, functions and resources.
+type Manifest struct {
+	DataSources        []*Entry         `json:"data_sources"`
+	EphemeralResources []*Entry         `json:"ephemeral_resources"`
+	Functions          []*FunctionEntry `json:"functions"`
+	Resources          []*Entry         `json:"resources"`
+}
+
+// Entry describes a data source, ephemeral resource or resource.
+type Entry struct {
+	TypeName            string `json:"type_name"`
+	Name                string `json:"name"`
+	Service             string `json:"service"`
+	ServiceName         string `json:"service_name,omitempty"`
+	Implementation      string `json:"implementation"`
+	IdentifierAttribute string `json:"identifier_attribute,omitempty"`
+	Taggable            bool   `json:"taggable"`
+	Tags                *Tags  `json:"tags,omitempty"`
+	Importable          bool   `json:"importable"`
+	Sweeper             bool   `json:"sweeper"`
+	Deprecated          bool   `json:"deprecated"`
+	DeprecationMessage  string `json:"deprecation_message,omitempty"`
+}
+
+// Tags describes a resource's transparent tagging.
+type Tags struct {
+	IdentifierAttribute string `json:"identifier_attribute"`
+	ResourceType        string `json:"resource_type,omitempty"`
+}
+
+// FunctionEntry describes a provider-defined function.
+type FunctionEntry struct {
+	Name               string `json:"name"`
+	Summary            string `json:"summary,omitempty"`
+	Deprecated         bool   `json:"deprecated"`
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+}
+
+// Encode sorts the manifest's entries and encodes it as indented JSON.
+func Encode(m *Manifest) ([]byte, error) {
+	compareEntries := func(a, b *Entry) int {
+		return cmp.Compare(a.TypeName, b.TypeName)
+	}
+	slices.SortFunc(m.DataSources, compareEntries)
+	slices.SortFunc(m.EphemeralResources, compareEntries)
+	slices.SortFunc(m.Functions, func(a, b *FunctionEntry) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(m.Resources, compareEntries)
+
+	body, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(body, '\n'), nil
+}
+
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+)
+
+// Source is the information read from the service packages' source.
+type Source struct {
+	// IdentifierAttributes maps resource types to the attribute identifying them on import,
+	// from their @Testing(importStateIdAttribute=...) annotation.
+	IdentifierAttributes map[string]string `json:"identifier_attributes"`
+	// Sweepers is the set of resource types that have sweepers registered in sweep.go files.
+	Sweepers map[string]bool `json:"sweepers"`
+}
+
+// IdentifierAttribute returns the attribute identifying a resource type, "id" unless annotated otherwise.
+func (s *Source) IdentifierAttribute(typeName string) string {
+	if v, ok := s.IdentifierAttributes[typeName]; ok {
+		return v
+	}
+
+	return names.AttrID
+}
+
+// ReadSource reads the resource annotations and sweepers from the service packages in dir.
+func ReadSource(dir string) (*Source, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*", "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	src := &Source{
+		IdentifierAttributes: make(map[string]string),
+		Sweepers:             make(map[string]bool),
+	}
+	fset := token.NewFileSet()
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		for _, group := range file.Comments {
+			readAnnotations(src, group)
+		}
+
+		if filepath.Base(filename) == "sweep.go" {
+			readSweepers(src, file)
+		}
+	}
+
+	return src, nil
+}
+
+// readAnnotations records the identifier attribute of a resource annotated in a comment.
+func readAnnotations(src *Source, group *ast.CommentGroup) {
+	var typeName, identifierAttribute string
+
+	for _, comment := range group.List {
+		m := annotation.FindStringSubmatch(comment.Text)
+		if m == nil {
+			continue
+		}
+
+		args := common.ParseArgs(m[3])
+		switch m[1] {
+		case "FrameworkResource", "SDKResource":
+			if len(args.Positional) > 0 {
+				typeName = args.Positional[0]
+			}
+		case "Testing":
+			if v, ok := args.Keyword["importStateIdAttribute"]; ok {
+				identifierAttribute = v
+			}
+		}
+	}
+
+	if typeName != "" && identifierAttribute != "" {
+		src.IdentifierAttributes[typeName] = identifierAttribute
+	}
+}
+
+// readSweepers records the resource types that have sweepers registered in a sweep.go file.
+func readSweepers(src *Source, file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+
+		// awsv2.Register("aws_x", ...) or resource.AddTestSweepers("aws_x", ...).
+		if selector, ok := call.Fun.(*ast.SelectorExpr); ok && (selector.Sel.Name == "Register" || selector.Sel.Name == "AddTestSweepers") {
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if typeName, err := strconv.Unquote(lit.Value); err == nil {
+					src.Sweepers[typeName] = true
+				}
+			}
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var update = flag.Bool("update", false, "Update the golden files")
+
+// testGolden compares got with the contents of a golden file in testdata, rewriting it if -update is set.
+func testGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+
+	filename := filepath.Join("testdata", name)
+
+	if *update {
+		if err := os.WriteFile(filename, got, 0644); err != nil {
+			t.Fatalf("writing %s: %s", filename, err)
+		}
+	}
+
+	want, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("reading %s: %s", filename, err)
+	}
+
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestReadSource(t *testing.T) {
+	t.Parallel()
+
+	src, err := ReadSource(filepath.Join("testdata", "service"))
+	if err != nil {
+		t.Fatalf("ReadSource() err: %s", err)
+	}
+
+	got, err := json.MarshalIndent(src, "", "  ")
+	if err != nil {
+		t.Fatalf("encoding source: %s", err)
+	}
+
+	testGolden(t, "source.golden.json", append(got, '\n'))
+}
+
+func TestIdentifierAttribute(t *testing.T) {
+	t.Parallel()
+
+	src, err := ReadSource(filepath.Join("testdata", "service"))
+	if err != nil {
+		t.Fatalf("ReadSource() err: %s", err)
+	}
+
+	testCases := map[string]string{
+		"aws_example_thing":  "arn",
+		"aws_example_widget": "id",
+		"aws_other_gadget":   "name",
+	}
+
+	for typeName, want := range testCases {
+		if got := src.IdentifierAttribute(typeName); got != want {
+			t.Errorf("IdentifierAttribute(%q) = %q, want %q", typeName, got, want)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	m := &Manifest{
+		DataSources: []*Entry{
+			{
+				TypeName:       "aws_example_thing",
+				Name:           "Thing",
+				Service:        "example",
+				ServiceName:    "Example",
+				Implementation: ImplementationSDK,
+				Taggable:       true,
+			},
+		},
+		EphemeralResources: make([]*Entry, 0),
+		Functions: []*FunctionEntry{
+			{
+				Name:    "example_parse",
+				Summary: "example_parse Function",
+			},
+			{
+				Name:               "example_build",
+				Summary:            "example_build Function",
+				Deprecated:         true,
+				DeprecationMessage: "Use example_parse instead.",
+			},
+		},
+		Resources: []*Entry{
+			{
+				TypeName:            "aws_example_widget",
+				Name:                "Widget",
+				Service:             "example",
+				ServiceName:         "Example",
+				Implementation:      ImplementationFramework,
+				IdentifierAttribute: "id",
+				Importable:          true,
+			},
+			{
+				TypeName:            "aws_example_thing",
+				Name:                "Thing",
+				Service:             "example",
+				ServiceName:         "Example",
+				Implementation:      ImplementationSDK,
+				IdentifierAttribute: "arn",
+				Taggable:            true,
+				Tags: &Tags{
+					IdentifierAttribute: "arn",
+				},
+				Importable: true,
+				Sweeper:    true,
+			},
+		},
+	}
+
+	got, err := Encode(m)
+	if err != nil {
+		t.Fatalf("Encode() err: %s", err)
+	}
+
+	testGolden(t, "manifest.golden.json", got)
+}
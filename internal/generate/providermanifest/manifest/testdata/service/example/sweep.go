@@ -0,0 +1,5 @@
+package example
+
+func RegisterSweepers() {
+	awsv2.Register("aws_example_thing", sweepThings)
+}
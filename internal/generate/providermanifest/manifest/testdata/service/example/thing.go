@@ -0,0 +1,10 @@
+package example
+
+// @SDKResource("aws_example_thing", name="Thing")
+// @Tags(identifierAttribute="arn")
+// @Testing(importStateIdAttribute="arn")
+func resourceThing() {}
+
+// @FrameworkResource("aws_example_widget", name="Widget")
+// @Testing(tagsTest=false)
+func newWidgetResource() {}
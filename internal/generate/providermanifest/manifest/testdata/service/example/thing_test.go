@@ -0,0 +1,5 @@
+package example
+
+// @SDKResource("aws_example_test_only", name="Test Only")
+// @Testing(importStateIdAttribute="name")
+func resourceTestOnly() {}
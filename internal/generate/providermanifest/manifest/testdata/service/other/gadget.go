@@ -0,0 +1,5 @@
+package other
+
+// @FrameworkResource("aws_other_gadget", name="Gadget")
+// @Testing(importStateIdAttribute=name)
+type gadgetResource struct{}
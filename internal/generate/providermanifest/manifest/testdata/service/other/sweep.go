@@ -0,0 +1,7 @@
+package other
+
+func init() {
+	resource.AddTestSweepers("aws_other_gadget", &resource.Sweeper{
+		Name: "aws_other_gadget",
+	})
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate go run ../generate/permissions/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package permissions
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package permissions provides the IAM actions that the provider's resources and data sources require.
+//
+// The manifest is generated by statically analyzing each resource's and data source's CRUD handlers for
+// the AWS API operations they call. It is a best effort: operations called indirectly (e.g. via function values)
+// are not found, and conditional calls are always included. Operations that require no IAM actions are omitted.
+package permissions
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+const (
+	OperationCreate = "create"
+	OperationRead   = "read"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// Operations returns all resource operations.
+func Operations() []string {
+	return []string{
+		OperationCreate,
+		OperationRead,
+		OperationUpdate,
+		OperationDelete,
+	}
+}
+
+//go:embed permissions_gen.json
+var manifestJSON []byte
+
+type manifest struct {
+	DataSources map[string]map[string][]string `json:"data_sources"`
+	Resources   map[string]map[string][]string `json:"resources"`
+}
+
+var loadManifest = sync.OnceValues(func() (*manifest, error) {
+	var m manifest
+
+	if err := json.Unmarshal(manifestJSON, &m); err != nil {
+		return nil, fmt.Errorf("reading IAM permissions manifest: %w", err)
+	}
+
+	return &m, nil
+})
+
+// ResourceActions returns the sorted IAM actions required by the specified operations on a resource type.
+// If no operations are specified, the actions required by all operations are returned.
+func ResourceActions(typeName string, operations ...string) ([]string, error) {
+	m, err := loadManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := m.Resources[typeName]
+	if !ok {
+		return nil, fmt.Errorf("resource type (%s) not found", typeName)
+	}
+
+	if len(operations) == 0 {
+		operations = Operations()
+	}
+
+	actions := make(map[string]struct{})
+	for _, operation := range operations {
+		if !slices.Contains(Operations(), operation) {
+			return nil, fmt.Errorf("unsupported operation (%s)", operation)
+		}
+
+		for _, action := range v[operation] {
+			actions[action] = struct{}{}
+		}
+	}
+
+	return slices.Sorted(maps.Keys(actions)), nil
+}
+
+// DataSourceActions returns the sorted IAM actions required to read a data source type.
+func DataSourceActions(typeName string) ([]string, error) {
+	m, err := loadManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := m.DataSources[typeName]
+	if !ok {
+		return nil, fmt.Errorf("data source type (%s) not found", typeName)
+	}
+
+	return slices.Clone(v[OperationRead]), nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package permissions_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/permissions"
+)
+
+func TestResourceActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName    string
+		operations  []string
+		contains    []string
+		notContains []string
+		expectError bool
+	}{
+		"all operations": {
+			typeName: "aws_sqs_queue",
+			contains: []string{"sqs:CreateQueue", "sqs:DeleteQueue", "sqs:GetQueueAttributes", "sqs:ListQueueTags", "sqs:TagQueue"},
+		},
+		"create with tags": {
+			typeName:   "aws_sqs_queue",
+			operations: []string{permissions.OperationCreate},
+			contains:   []string{"sqs:CreateQueue", "sqs:ListQueueTags", "sqs:TagQueue"},
+		},
+		"AWS SDK for Go v1": {
+			typeName: "aws_simpledb_domain",
+			contains: []string{"sdb:CreateDomain", "sdb:DeleteDomain", "sdb:DomainMetadata"},
+		},
+		"pass role": {
+			typeName:   "aws_lambda_function",
+			operations: []string{permissions.OperationCreate, permissions.OperationUpdate},
+			contains:   []string{"iam:PassRole", "lambda:CreateFunction", "lambda:UpdateFunctionConfiguration"},
+		},
+		"pass role not read": {
+			typeName:    "aws_lambda_function",
+			operations:  []string{permissions.OperationRead},
+			notContains: []string{"iam:PassRole"},
+		},
+		"pass role not IAM": {
+			typeName:    "aws_iam_role_policy_attachment",
+			notContains: []string{"iam:PassRole"},
+		},
+		"read": {
+			typeName:    "aws_sqs_queue",
+			operations:  []string{permissions.OperationRead},
+			contains:    []string{"sqs:GetQueueAttributes"},
+			notContains: []string{"sqs:CreateQueue", "sqs:DeleteQueue"},
+		},
+		"tags for resource type": {
+			typeName:    "aws_iam_role",
+			operations:  []string{permissions.OperationCreate},
+			contains:    []string{"iam:CreateRole", "iam:TagRole"},
+			notContains: []string{"iam:TagUser"},
+		},
+		"unknown resource type": {
+			typeName:    "aws_not_a_resource",
+			expectError: true,
+		},
+		"unsupported operation": {
+			typeName:    "aws_sqs_queue",
+			operations:  []string{"import"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := permissions.ResourceActions(testCase.typeName, testCase.operations...)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ResourceActions(%q) err %t, want %t", testCase.typeName, got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if !slices.IsSorted(got) {
+				t.Errorf("ResourceActions(%q) = %v, not sorted", testCase.typeName, got)
+			}
+			for _, v := range testCase.contains {
+				if !slices.Contains(got, v) {
+					t.Errorf("ResourceActions(%q) = %v, missing %q", testCase.typeName, got, v)
+				}
+			}
+			for _, v := range testCase.notContains {
+				if slices.Contains(got, v) {
+					t.Errorf("ResourceActions(%q) = %v, unexpected %q", testCase.typeName, got, v)
+				}
+			}
+		})
+	}
+}
+
+func TestDataSourceActions(t *testing.T) {
+	t.Parallel()
+
+	got, err := permissions.DataSourceActions("aws_sqs_queue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(got, "sqs:GetQueueAttributes") {
+		t.Errorf("DataSourceActions(%q) = %v, missing %q", "aws_sqs_queue", got, "sqs:GetQueueAttributes")
+	}
+
+	if _, err := permissions.DataSourceActions("aws_not_a_data_source"); err == nil {
+		t.Errorf("DataSourceActions(%q) expected error", "aws_not_a_data_source")
+	}
+}
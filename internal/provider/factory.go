@@ -16,15 +16,21 @@ import (
 // ProtoV5ProviderServerFactory returns a muxed terraform-plugin-go protocol v5 provider factory function.
 // This factory function is suitable for use with the terraform-plugin-go Serve function.
 // The primary (Plugin SDK) provider server is also returned (useful for testing).
+// The primary provider's data sources and resources are built on first use.
 func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, *schema.Provider, error) {
-	primary, err := New(ctx)
+	primary, resources, err := newProvider(ctx)
 
 	if err != nil {
 		return nil, nil, err
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return &sdkProviderServer{
+				ProviderServer: primary.GRPCProvider(),
+				resources:      resources,
+			}
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
@@ -36,3 +42,93 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 
 	return muxServer.ProviderServer, primary, nil
 }
+
+// sdkProviderServer is the primary (Plugin SDK) provider server.
+// It builds each data source and resource before the first request that uses it.
+// Errors building a data source or resource are returned as diagnostics.
+type sdkProviderServer struct {
+	tfprotov5.ProviderServer
+	resources *sdkInstanceResources
+}
+
+func (s *sdkProviderServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	if err := s.resources.buildAll(); err != nil {
+		return &tfprotov5.GetProviderSchemaResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.GetProviderSchema(ctx, request)
+}
+
+func (s *sdkProviderServer) ValidateResourceTypeConfig(ctx context.Context, request *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	if err := s.resources.buildResource(request.TypeName); err != nil {
+		return &tfprotov5.ValidateResourceTypeConfigResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.ValidateResourceTypeConfig(ctx, request)
+}
+
+func (s *sdkProviderServer) UpgradeResourceState(ctx context.Context, request *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	if err := s.resources.buildResource(request.TypeName); err != nil {
+		return &tfprotov5.UpgradeResourceStateResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.UpgradeResourceState(ctx, request)
+}
+
+func (s *sdkProviderServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if err := s.resources.buildResource(request.TypeName); err != nil {
+		return &tfprotov5.ReadResourceResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.ReadResource(ctx, request)
+}
+
+func (s *sdkProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if err := s.resources.buildResource(request.TypeName); err != nil {
+		return &tfprotov5.PlanResourceChangeResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.PlanResourceChange(ctx, request)
+}
+
+func (s *sdkProviderServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if err := s.resources.buildResource(request.TypeName); err != nil {
+		return &tfprotov5.ApplyResourceChangeResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.ApplyResourceChange(ctx, request)
+}
+
+func (s *sdkProviderServer) ImportResourceState(ctx context.Context, request *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	if err := s.resources.buildResource(request.TypeName); err != nil {
+		return &tfprotov5.ImportResourceStateResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.ImportResourceState(ctx, request)
+}
+
+func (s *sdkProviderServer) ValidateDataSourceConfig(ctx context.Context, request *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	if err := s.resources.buildDataSource(request.TypeName); err != nil {
+		return &tfprotov5.ValidateDataSourceConfigResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.ValidateDataSourceConfig(ctx, request)
+}
+
+func (s *sdkProviderServer) ReadDataSource(ctx context.Context, request *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	if err := s.resources.buildDataSource(request.TypeName); err != nil {
+		return &tfprotov5.ReadDataSourceResponse{Diagnostics: errorDiagnostics(err)}, nil
+	}
+
+	return s.ProviderServer.ReadDataSource(ctx, request)
+}
+
+func errorDiagnostics(err error) []*tfprotov5.Diagnostic {
+	return []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Initializing provider",
+			Detail:   err.Error(),
+		},
+	}
+}
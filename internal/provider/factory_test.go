@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -22,3 +23,35 @@ func BenchmarkProtoV5ProviderServerFactory(b *testing.B) {
 		b.Logf("%d resources, %d data sources", len(p.ResourcesMap), len(p.DataSourcesMap))
 	}
 }
+
+func TestProtoV5ProviderServerFactoryGetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serverFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r, ok := p.ResourcesMap["aws_s3_bucket"]; !ok || len(r.SchemaMap()) != 0 {
+		t.Error("aws_s3_bucket built before first use")
+	}
+
+	// Resource and data source schemas are built, and checked, on first use.
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	if len(p.ResourcesMap["aws_s3_bucket"].SchemaMap()) == 0 {
+		t.Error("no aws_s3_bucket schema")
+	}
+}
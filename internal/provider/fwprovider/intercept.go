@@ -15,13 +15,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type interceptorFunc[Request, Response any] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -127,11 +130,17 @@ func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFu
 		forward := interceptors
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Preceding interceptors' Finally interceptors are run last to first, e.g. to end any tracing span.
 			if diags.HasError() {
+				when = Finally
+				for _, v := range slices.Reverse(forward[:i]) {
+					ctx, diags = v(ctx, request, response, meta, when, diags)
+				}
+
 				return diags
 			}
 		}
@@ -166,11 +175,17 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 		forward := interceptors
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Preceding interceptors' Finally interceptors are run last to first, e.g. to end any tracing span.
 			if diags.HasError() {
+				when = Finally
+				for _, v := range slices.Reverse(forward[:i]) {
+					ctx, diags = v(ctx, request, response, meta, when, diags)
+				}
+
 				return diags
 			}
 		}
@@ -197,6 +212,50 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 	}
 }
 
+// tracingInterceptor traces each CRUD operation as an OpenTelemetry span.
+// AWS API calls made during the operation are recorded as child spans.
+type tracingInterceptor struct {
+	isDataSource       bool
+	servicePackageName string
+	typeName           string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, operation string, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, _ = tracing.StartResourceSpan(ctx, r.servicePackageName, r.typeName, operation, r.isDataSource)
+	case Finally:
+		tracing.EndSpan(trace.SpanFromContext(ctx), fwdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
+
+func (r tracingInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Create", when, diags)
+}
+
+func (r tracingInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Read", when, diags)
+}
+
+func (r tracingInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Update", when, diags)
+}
+
+func (r tracingInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Delete", when, diags)
+}
+
+// tracingDataSourceInterceptor traces data source Read operations.
+type tracingDataSourceInterceptor struct {
+	tracingInterceptor
+}
+
+func (r tracingDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, "Read", when, diags)
+}
+
 // tagsDataSourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -24,6 +24,7 @@ import (
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -60,6 +61,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"coalesce_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether concurrent reads of supported resources are coalesced into batched AWS API calls. Reduces the number of API calls, and so throttling, when refreshing large numbers of resources.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -312,33 +317,39 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 //
 // The data source type name is determined by the DataSource implementing
 // the Metadata method. All data sources must have unique names.
+// Data source implementations are created, and their schemas built, only when first used.
 func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	var errs []error
 	var dataSources []func() datasource.DataSource
 
 	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
 		servicePackageName := sp.ServicePackageName()
 
 		for _, v := range sp.FrameworkDataSources(ctx) {
-			inner, err := v.Factory(ctx)
+			typeName := v.TypeName
+			inner := lazy(func(ctx context.Context) (datasource.DataSourceWithConfigure, error) {
+				inner, err := v.Factory(ctx)
 
-			if err != nil {
-				tflog.Warn(ctx, "creating data source", map[string]interface{}{
-					"service_package_name": n,
-					"error":                err.Error(),
-				})
+				if err != nil {
+					tflog.Warn(ctx, "creating data source", map[string]interface{}{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
 
-				continue
-			}
+					return nil, fmt.Errorf("creating data source (%s): %w", typeName, err)
+				}
 
-			metadataResponse := datasource.MetadataResponse{}
-			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
-			typeName := metadataResponse.TypeName
+				metadataResponse := datasource.MetadataResponse{}
+				inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
 
-			// Temporary check that type name from annotation equals Metadata response.
-			if typeName != v.TypeName {
-				errs = append(errs, fmt.Errorf("data source %s %s annotation: %s Metadata: %s", servicePackageName, v.Name, typeName, v.TypeName))
-			}
+				// Temporary check that type name from annotation equals Metadata response.
+				if metadataResponse.TypeName != typeName {
+					tflog.Warn(ctx, "registering data sources", map[string]interface{}{
+						"error": fmt.Sprintf("data source %s %s annotation: %s Metadata: %s", servicePackageName, v.Name, typeName, metadataResponse.TypeName),
+					})
+				}
+
+				return inner, nil
+			})
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
@@ -353,37 +364,42 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			if tracing.Enabled() {
+				interceptors = append(interceptors, tracingDataSourceInterceptor{
+					tracingInterceptor: tracingInterceptor{
+						isDataSource:       true,
+						servicePackageName: servicePackageName,
+						typeName:           typeName,
+					},
+				})
+			}
+
+			var schemaValidator func(datasource.SchemaResponse) error
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				schemaResponse := datasource.SchemaResponse{}
-				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
-				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
-					if !v.IsComputed() {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
-						continue
+				// Ensure that the schema look OK once it has been built.
+				schemaValidator = func(schemaResponse datasource.SchemaResponse) error {
+					if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
+						if !v.IsComputed() {
+							return fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName)
+						}
+					} else {
+						return fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName)
 					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName))
-					continue
+
+					return nil
 				}
 
 				interceptors = append(interceptors, tagsDataSourceInterceptor{tags: v.Tags})
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, typeName, inner, interceptors, schemaValidator)
 			})
 		}
 	}
 
-	if err := errors.Join(errs...); err != nil {
-		tflog.Warn(ctx, "registering data sources", map[string]interface{}{
-			"error": err.Error(),
-		})
-	}
-
 	return dataSources
 }
 
@@ -392,29 +408,34 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 //
 // The resource type name is determined by the Resource implementing
 // the Metadata method. All resources must have unique names.
+// Resource implementations are created, and their schemas built, only when first used.
 func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
-	var errs []error
 	var resources []func() resource.Resource
 
 	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
 		servicePackageName := sp.ServicePackageName()
 
 		for _, v := range sp.FrameworkResources(ctx) {
-			inner, err := v.Factory(ctx)
+			typeName := v.TypeName
+			inner := lazy(func(ctx context.Context) (resource.ResourceWithConfigure, error) {
+				inner, err := v.Factory(ctx)
 
-			if err != nil {
-				errs = append(errs, fmt.Errorf("creating resource: %w", err))
-				continue
-			}
+				if err != nil {
+					return nil, fmt.Errorf("creating resource (%s): %w", typeName, err)
+				}
 
-			metadataResponse := resource.MetadataResponse{}
-			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
-			typeName := metadataResponse.TypeName
+				metadataResponse := resource.MetadataResponse{}
+				inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
 
-			// Temporary check that type name from annotation equals Metadata response.
-			if typeName != v.TypeName {
-				errs = append(errs, fmt.Errorf("resource %s %s annotation: %s Metadata: %s", servicePackageName, v.Name, typeName, v.TypeName))
-			}
+				// Temporary check that type name from annotation equals Metadata response.
+				if metadataResponse.TypeName != typeName {
+					tflog.Warn(ctx, "registering resources", map[string]interface{}{
+						"error": fmt.Sprintf("resource %s %s annotation: %s Metadata: %s", servicePackageName, v.Name, typeName, metadataResponse.TypeName),
+					})
+				}
+
+				return inner, nil
+			})
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
@@ -429,46 +450,46 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			if tracing.Enabled() {
+				interceptors = append(interceptors, tracingInterceptor{
+					servicePackageName: servicePackageName,
+					typeName:           typeName,
+				})
+			}
+
+			var schemaValidator func(resource.SchemaResponse) error
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				schemaResponse := resource.SchemaResponse{}
-				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
-
-				if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
-					if v.IsComputed() {
-						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
-						continue
+				// Ensure that the schema look OK once it has been built.
+				schemaValidator = func(schemaResponse resource.SchemaResponse) error {
+					if v, ok := schemaResponse.Schema.Attributes[names.AttrTags]; ok {
+						if v.IsComputed() {
+							return fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName)
+						}
+					} else {
+						return fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName)
 					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName))
-					continue
-				}
-				if v, ok := schemaResponse.Schema.Attributes[names.AttrTagsAll]; ok {
-					if !v.IsComputed() {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTagsAll, typeName))
-						continue
+					if v, ok := schemaResponse.Schema.Attributes[names.AttrTagsAll]; ok {
+						if !v.IsComputed() {
+							return fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTagsAll, typeName)
+						}
+					} else {
+						return fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName)
 					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
-					continue
+
+					return nil
 				}
 
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors, schemaValidator)
 			})
 		}
 	}
 
-	if err := errors.Join(errs...); err != nil {
-		tflog.Warn(ctx, "registering resources", map[string]interface{}{
-			"error": err.Error(),
-		})
-	}
-
 	return resources
 }
 
@@ -542,6 +563,10 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewNetworkFirewallRulesValidateFunction,
+		tffunction.NewRoute53RecordsFromZoneFileFunction,
+		tffunction.NewScheduleNextRunsFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVerifiedPermissionsIsAuthorizedFunction,
 	}
 }
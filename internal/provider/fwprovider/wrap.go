@@ -5,6 +5,7 @@ package fwprovider
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,36 +17,92 @@ import (
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
+// lazy returns a function that calls the specified factory on first use and caches the result.
+// Data source and resource implementations are only created when Terraform first uses them.
+func lazy[T any](factory func(context.Context) (T, error)) func(context.Context) (T, error) {
+	var (
+		once sync.Once
+		v    T
+		err  error
+	)
+
+	return func(ctx context.Context) (T, error) {
+		once.Do(func() {
+			v, err = factory(ctx)
+		})
+
+		return v, err
+	}
+}
+
+// Schemas are cached per process, keyed by type name.
+var (
+	dataSourceSchemaCache sync.Map
+	resourceSchemaCache   sync.Map
+)
+
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            datasource.DataSourceWithConfigure
+	inner            func(context.Context) (datasource.DataSourceWithConfigure, error)
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// schemaValidator, if set, is run on the data source's schema when it is first built.
+	schemaValidator func(datasource.SchemaResponse) error
+	typeName        string
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, typeName string, inner func(context.Context) (datasource.DataSourceWithConfigure, error), interceptors dataSourceInterceptors, schemaValidator func(datasource.SchemaResponse) error) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		schemaValidator:  schemaValidator,
+		typeName:         typeName,
 	}
 }
 
+// Metadata returns the type name from the service package annotation so that the inner data source isn't created.
 func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Metadata(ctx, request, response)
+	response.TypeName = w.typeName
 }
 
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	if v, ok := dataSourceSchemaCache.Load(w.typeName); ok {
+		*response = v.(datasource.SchemaResponse)
+		return
+	}
+
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating data source", err.Error())
+		return
+	}
+
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Schema(ctx, request, response)
+	inner.Schema(ctx, request, response)
+
+	if w.schemaValidator != nil && !response.Diagnostics.HasError() {
+		if err := w.schemaValidator(*response); err != nil {
+			response.Diagnostics.AddError("Invalid data source schema", err.Error())
+		}
+	}
+
+	if !response.Diagnostics.HasError() {
+		dataSourceSchemaCache.Store(w.typeName, *response)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating data source", err.Error())
+		return
+	}
+
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -54,15 +111,26 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating data source", err.Error())
+		return
+	}
+
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Configure(ctx, request, response)
+	inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
-	if v, ok := w.inner.(datasource.DataSourceWithConfigValidators); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if v, ok := inner.(datasource.DataSourceWithConfigValidators); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		return v.ConfigValidators(ctx)
 	}
@@ -144,32 +212,64 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
-	inner            resource.ResourceWithConfigure
+	inner            func(context.Context) (resource.ResourceWithConfigure, error)
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// schemaValidator, if set, is run on the resource's schema when it is first built.
+	schemaValidator func(resource.SchemaResponse) error
+	typeName        string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, typeName string, inner func(context.Context) (resource.ResourceWithConfigure, error), interceptors resourceInterceptors, schemaValidator func(resource.SchemaResponse) error) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		schemaValidator:  schemaValidator,
+		typeName:         typeName,
 	}
 }
 
+// Metadata returns the type name from the service package annotation so that the inner resource isn't created.
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
-	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Metadata(ctx, request, response)
+	response.TypeName = w.typeName
 }
 
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	if v, ok := resourceSchemaCache.Load(w.typeName); ok {
+		*response = v.(resource.SchemaResponse)
+		return
+	}
+
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Schema(ctx, request, response)
+	inner.Schema(ctx, request, response)
+
+	if w.schemaValidator != nil && !response.Diagnostics.HasError() {
+		if err := w.schemaValidator(*response); err != nil {
+			response.Diagnostics.AddError("Invalid resource schema", err.Error())
+		}
+	}
+
+	if !response.Diagnostics.HasError() {
+		resourceSchemaCache.Store(w.typeName, *response)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -178,8 +278,14 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -188,8 +294,14 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -198,8 +310,14 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -208,15 +326,27 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
 	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
 		w.meta = v
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	w.inner.Configure(ctx, request, response)
+	inner.Configure(ctx, request, response)
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
+	if v, ok := inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ImportState(ctx, request, response)
 
@@ -230,14 +360,25 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
+	if v, ok := inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 	}
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
-	if v, ok := w.inner.(resource.ResourceWithConfigValidators); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if v, ok := inner.(resource.ResourceWithConfigValidators); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		return v.ConfigValidators(ctx)
 	}
@@ -246,14 +387,25 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 }
 
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
-	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		response.Diagnostics.AddError("Creating resource", err.Error())
+		return
+	}
+
+	if v, ok := inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
-	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if v, ok := inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
 		return v.UpgradeState(ctx)
@@ -263,7 +415,12 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 }
 
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
-	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
+	inner, err := w.inner(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if v, ok := inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
 		return v.MoveState(ctx)
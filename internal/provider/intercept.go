@@ -17,9 +17,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // schemaResourceData is an interface that implements functions from schema.ResourceData
@@ -36,7 +38,8 @@ type schemaResourceData interface {
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
 // If a Before interceptor returns Diagnostics indicating an error occurred then
-// no further interceptors in the chain are run and neither is the schema's method.
+// no further interceptors in the chain are run and neither is the schema's method,
+// but the Finally interceptors of any preceding interceptors are run so that they can release resources.
 // In other cases all interceptors in the chain are run.
 type interceptor interface {
 	run(context.Context, schemaResourceData, any, when, why, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -79,6 +82,22 @@ const (
 	AllOps = Create | Read | Update | Delete // Interceptor is invoked for all calls
 )
 
+// String returns the name of a single CRUD operation.
+func (w why) String() string {
+	switch w {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
 type interceptorItems []interceptorItem
 
 // why returns a slice of interceptors that run for the specified CRUD operation.
@@ -97,12 +116,20 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 		forward := interceptors.why(why)
 
 		when := Before
-		for _, v := range forward {
+		for i, v := range forward {
 			if v.when&when != 0 {
 				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 
 				// Short circuit if any Before interceptor errors.
+				// Preceding interceptors' Finally interceptors are run last to first, e.g. to end any tracing span.
 				if diags.HasError() {
+					when = Finally
+					for _, v := range slices.Reverse(forward[:i]) {
+						if v.when&when != 0 {
+							ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
+						}
+					}
+
 					return diags
 				}
 			}
@@ -195,6 +222,25 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// tracingInterceptor traces each CRUD operation as an OpenTelemetry span.
+// AWS API calls made during the operation are recorded as child spans.
+type tracingInterceptor struct {
+	isDataSource       bool
+	servicePackageName string
+	typeName           string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx, _ = tracing.StartResourceSpan(ctx, r.servicePackageName, r.typeName, why.String(), r.isDataSource)
+	case Finally:
+		tracing.EndSpan(trace.SpanFromContext(ctx), sdkdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
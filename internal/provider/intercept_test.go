@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -93,3 +94,54 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestInterceptedHandler_beforeError(t *testing.T) {
+	t.Parallel()
+
+	var interceptors interceptorItems
+	var calls []string
+
+	interceptors = append(interceptors, interceptorItem{
+		when: Before | Finally,
+		why:  Create,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			switch when {
+			case Before:
+				calls = append(calls, "first Before")
+			case Finally:
+				calls = append(calls, "first Finally")
+			}
+			return ctx, diags
+		}),
+	})
+	interceptors = append(interceptors, interceptorItem{
+		when: Before | Finally,
+		why:  Create,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			switch when {
+			case Before:
+				calls = append(calls, "second Before")
+				return ctx, sdkdiag.AppendErrorf(diags, "before error")
+			case Finally:
+				calls = append(calls, "second Finally")
+			}
+			return ctx, diags
+		}),
+	})
+
+	var create schema.CreateContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		calls = append(calls, "create")
+		return nil
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, create, Create)(context.Background(), nil, 42)
+	if got, want := len(diags), 1; got != want {
+		t.Errorf("length of diags = %v, want %v", got, want)
+	}
+	if got, want := strings.Join(calls, ", "), "first Before, second Before, first Finally"; got != want {
+		t.Errorf("calls = %q, want %q", got, want)
+	}
+}
@@ -8,8 +8,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -27,13 +30,33 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tracing"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
 // The provider instance is fully configured once the `ConfigureContextFunc` has been called.
+// All data sources and resources are built, see ProtoV5ProviderServerFactory for a provider instance
+// whose data sources and resources are built on first use.
 func New(ctx context.Context) (*schema.Provider, error) {
+	provider, resources, err := newProvider(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resources.buildAll(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// newProvider returns a new, initialized Terraform Plugin SDK v2-style provider instance.
+// The provider instance's data sources and resources are empty placeholders until built by the returned sdkInstanceResources.
+func newProvider(ctx context.Context) (*schema.Provider, *sdkInstanceResources, error) {
 	log.Printf("Initializing Terraform AWS Provider...")
 
 	provider := &schema.Provider{
@@ -54,6 +77,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"coalesce_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether concurrent reads of supported resources are coalesced into batched AWS API calls. " +
+					"Reduces the number of API calls, and so throttling, when refreshing large numbers of resources.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -256,19 +285,69 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Resolve an endpoint with FIPS capability",
 			},
 		},
-
-		// Data sources and resources implemented using Terraform Plugin SDK
-		// should use the @SDKDataSource and @SDKResource function-level annotations
-		// rather than adding directly to these maps.
-		DataSourcesMap: make(map[string]*schema.Resource),
-		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return configure(ctx, provider, d)
 	}
 
+	resources, err := sdkResources(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Data sources and resources implemented using Terraform Plugin SDK
+	// should use the @SDKDataSource and @SDKResource function-level annotations
+	// rather than adding directly to these maps.
+	provider.DataSourcesMap = make(map[string]*schema.Resource, len(resources.dataSources))
+	provider.ResourcesMap = make(map[string]*schema.Resource, len(resources.resources))
+	instanceResources := &sdkInstanceResources{
+		dataSources: addPlaceholders(provider.DataSourcesMap, resources.dataSources),
+		resources:   addPlaceholders(provider.ResourcesMap, resources.resources),
+	}
+
+	// Set the provider Meta (instance data) here.
+	// It will be overwritten by the result of the call to ConfigureContextFunc,
+	// but can be used pre-configuration by other (non-primary) provider servers.
+	var meta *conns.AWSClient
+	if v, ok := provider.Meta().(*conns.AWSClient); ok {
+		meta = v
+	} else {
+		meta = new(conns.AWSClient)
+	}
+	meta.SetServicePackages(ctx, resources.servicePackages)
+	provider.SetMeta(meta)
+
+	return provider, instanceResources, nil
+}
+
+// sdkProviderResources holds the Plugin SDK data sources and resources implemented by all service packages.
+// Each data source and resource is built, once per process, by calling its builder.
+type sdkProviderResources struct {
+	dataSources     map[string]func() (*schema.Resource, error)
+	resources       map[string]func() (*schema.Resource, error)
+	servicePackages map[string]conns.ServicePackage
+}
+
+var (
+	sdkResourcesCache *sdkProviderResources
+	sdkResourcesLock  sync.Mutex
+)
+
+// sdkResources returns the Plugin SDK data sources and resources implemented by all service packages.
+// They are registered once per process. No data source or resource is built until first used.
+func sdkResources(ctx context.Context) (*sdkProviderResources, error) {
+	sdkResourcesLock.Lock()
+	defer sdkResourcesLock.Unlock()
+
+	if sdkResourcesCache != nil {
+		return sdkResourcesCache, nil
+	}
+
 	var errs []error
+	dataSources := make(map[string]func() (*schema.Resource, error))
+	resources := make(map[string]func() (*schema.Resource, error))
 	servicePackageMap := make(map[string]conns.ServicePackage)
 
 	for _, sp := range servicePackages(ctx) {
@@ -278,194 +357,310 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		for _, v := range sp.SDKDataSources(ctx) {
 			typeName := v.TypeName
 
-			if _, ok := provider.DataSourcesMap[typeName]; ok {
+			if _, ok := dataSources[typeName]; ok {
 				errs = append(errs, fmt.Errorf("duplicate data source: %s", typeName))
 				continue
 			}
 
-			r := v.Factory()
+			dataSources[typeName] = sync.OnceValues(func() (*schema.Resource, error) {
+				return newSDKDataSource(servicePackageName, v)
+			})
+		}
 
-			// Ensure that the correct CRUD handler variants are used.
-			if r.Read != nil || r.ReadContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s", typeName))
+		for _, v := range sp.SDKResources(ctx) {
+			typeName := v.TypeName
+
+			if _, ok := resources[typeName]; ok {
+				errs = append(errs, fmt.Errorf("duplicate resource: %s", typeName))
 				continue
 			}
 
-			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
-				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
-					ctx = v.RegisterLogger(ctx)
-				}
+			resources[typeName] = sync.OnceValues(func() (*schema.Resource, error) {
+				return newSDKResource(servicePackageName, v)
+			})
+		}
+	}
 
-				return ctx
-			}
-			interceptors := interceptorItems{}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
 
-			if v.Tags != nil {
-				schema := r.SchemaMap()
+	sdkResourcesCache = &sdkProviderResources{
+		dataSources:     dataSources,
+		resources:       resources,
+		servicePackages: servicePackageMap,
+	}
 
-				// The data source has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				if v, ok := schema[names.AttrTags]; ok {
-					if !v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName))
-					continue
-				}
+	return sdkResourcesCache, nil
+}
 
-				interceptors = append(interceptors, interceptorItem{
-					when: Before | After,
-					why:  Read,
-					interceptor: tagsDataSourceInterceptor{
-						tags: v.Tags,
-					},
-				})
-			}
+// newSDKDataSource builds the specified Plugin SDK data source, wrapping its handlers with the provider's interceptors.
+func newSDKDataSource(servicePackageName string, v *types.ServicePackageSDKDataSource) (*schema.Resource, error) {
+	typeName := v.TypeName
 
-			ds := &wrappedDataSource{
-				bootstrapContext: bootstrapContext,
-				interceptors:     interceptors,
-			}
+	r := v.Factory()
+	if v := r.SchemaFunc; v != nil {
+		// Build the schema once, on first use.
+		r.SchemaFunc = sync.OnceValue(v)
+	}
 
-			if v := r.ReadWithoutTimeout; v != nil {
-				r.ReadWithoutTimeout = ds.Read(v)
-			}
+	// Ensure that the correct CRUD handler variants are used.
+	if r.Read != nil || r.ReadContext != nil {
+		return nil, fmt.Errorf("incorrect Read handler variant: %s", typeName)
+	}
 
-			provider.DataSourcesMap[typeName] = r
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+		if v, ok := meta.(*conns.AWSClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
+			ctx = v.RegisterLogger(ctx)
 		}
 
-		for _, v := range sp.SDKResources(ctx) {
-			typeName := v.TypeName
+		return ctx
+	}
+	interceptors := interceptorItems{}
+
+	if tracing.Enabled() {
+		interceptors = append(interceptors, interceptorItem{
+			when: Before | Finally,
+			why:  Read,
+			interceptor: tracingInterceptor{
+				isDataSource:       true,
+				servicePackageName: servicePackageName,
+				typeName:           typeName,
+			},
+		})
+	}
 
-			if _, ok := provider.ResourcesMap[typeName]; ok {
-				errs = append(errs, fmt.Errorf("duplicate resource: %s", typeName))
-				continue
-			}
+	if v.Tags != nil {
+		// The data source has opted in to transparent tagging.
+		// Ensure that the schema look OK.
+		if err := validateSDKDataSourceTagsSchema(typeName, r.SchemaMap()); err != nil {
+			return nil, err
+		}
 
-			r := v.Factory()
+		interceptors = append(interceptors, interceptorItem{
+			when: Before | After,
+			why:  Read,
+			interceptor: tagsDataSourceInterceptor{
+				tags: v.Tags,
+			},
+		})
+	}
 
-			// Ensure that the correct CRUD handler variants are used.
-			if r.Create != nil || r.CreateContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Create handler variant: %s", typeName))
-				continue
-			}
-			if r.Read != nil || r.ReadContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s", typeName))
-				continue
-			}
-			if r.Update != nil || r.UpdateContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Update handler variant: %s", typeName))
-				continue
-			}
-			if r.Delete != nil || r.DeleteContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Delete handler variant: %s", typeName))
-				continue
-			}
+	ds := &wrappedDataSource{
+		bootstrapContext: bootstrapContext,
+		interceptors:     interceptors,
+	}
 
-			// bootstrapContext is run on all wrapped methods before any interceptors.
-			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
-				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
-					ctx = v.RegisterLogger(ctx)
-				}
+	if v := r.ReadWithoutTimeout; v != nil {
+		r.ReadWithoutTimeout = ds.Read(v)
+	}
 
-				return ctx
-			}
-			interceptors := interceptorItems{}
+	return r, nil
+}
 
-			if v.Tags != nil {
-				schema := r.SchemaMap()
+// newSDKResource builds the specified Plugin SDK resource, wrapping its handlers with the provider's interceptors.
+func newSDKResource(servicePackageName string, v *types.ServicePackageSDKResource) (*schema.Resource, error) {
+	typeName := v.TypeName
 
-				// The resource has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				if v, ok := schema[names.AttrTags]; ok {
-					if v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName))
-					continue
-				}
-				if v, ok := schema[names.AttrTagsAll]; ok {
-					if !v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
-					continue
-				}
+	r := v.Factory()
+	if v := r.SchemaFunc; v != nil {
+		// Build the schema once, on first use.
+		r.SchemaFunc = sync.OnceValue(v)
+	}
 
-				interceptors = append(interceptors, interceptorItem{
-					when: Before | After | Finally,
-					why:  Create | Read | Update,
-					interceptor: tagsResourceInterceptor{
-						tags:       v.Tags,
-						updateFunc: tagsUpdateFunc,
-						readFunc:   tagsReadFunc,
-					},
-				})
-			}
+	// Ensure that the correct CRUD handler variants are used.
+	if r.Create != nil || r.CreateContext != nil {
+		return nil, fmt.Errorf("incorrect Create handler variant: %s", typeName)
+	}
+	if r.Read != nil || r.ReadContext != nil {
+		return nil, fmt.Errorf("incorrect Read handler variant: %s", typeName)
+	}
+	if r.Update != nil || r.UpdateContext != nil {
+		return nil, fmt.Errorf("incorrect Update handler variant: %s", typeName)
+	}
+	if r.Delete != nil || r.DeleteContext != nil {
+		return nil, fmt.Errorf("incorrect Delete handler variant: %s", typeName)
+	}
 
-			rs := &wrappedResource{
-				bootstrapContext: bootstrapContext,
-				interceptors:     interceptors,
-			}
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+		if v, ok := meta.(*conns.AWSClient); ok {
+			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
+			ctx = v.RegisterLogger(ctx)
+		}
 
-			if v := r.CreateWithoutTimeout; v != nil {
-				r.CreateWithoutTimeout = rs.Create(v)
-			}
-			if v := r.ReadWithoutTimeout; v != nil {
-				r.ReadWithoutTimeout = rs.Read(v)
-			}
-			if v := r.UpdateWithoutTimeout; v != nil {
-				r.UpdateWithoutTimeout = rs.Update(v)
-			}
-			if v := r.DeleteWithoutTimeout; v != nil {
-				r.DeleteWithoutTimeout = rs.Delete(v)
-			}
-			if v := r.Importer; v != nil {
-				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = rs.State(v)
-				}
-			}
-			if v := r.CustomizeDiff; v != nil {
-				r.CustomizeDiff = rs.CustomizeDiff(v)
+		return ctx
+	}
+	interceptors := interceptorItems{}
+
+	if tracing.Enabled() {
+		interceptors = append(interceptors, interceptorItem{
+			when: Before | Finally,
+			why:  AllOps,
+			interceptor: tracingInterceptor{
+				servicePackageName: servicePackageName,
+				typeName:           typeName,
+			},
+		})
+	}
+
+	if v.Tags != nil {
+		// The resource has opted in to transparent tagging.
+		// Ensure that the schema look OK.
+		if err := validateSDKResourceTagsSchema(typeName, r.SchemaMap()); err != nil {
+			return nil, err
+		}
+
+		interceptors = append(interceptors, interceptorItem{
+			when: Before | After | Finally,
+			why:  Create | Read | Update,
+			interceptor: tagsResourceInterceptor{
+				tags:       v.Tags,
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			},
+		})
+	}
+
+	rs := &wrappedResource{
+		bootstrapContext: bootstrapContext,
+		interceptors:     interceptors,
+	}
+
+	if v := r.CreateWithoutTimeout; v != nil {
+		r.CreateWithoutTimeout = rs.Create(v)
+	}
+	if v := r.ReadWithoutTimeout; v != nil {
+		r.ReadWithoutTimeout = rs.Read(v)
+	}
+	if v := r.UpdateWithoutTimeout; v != nil {
+		r.UpdateWithoutTimeout = rs.Update(v)
+	}
+	if v := r.DeleteWithoutTimeout; v != nil {
+		r.DeleteWithoutTimeout = rs.Delete(v)
+	}
+	if v := r.Importer; v != nil {
+		if v := v.StateContext; v != nil {
+			r.Importer.StateContext = rs.State(v)
+		}
+	}
+	if v := r.CustomizeDiff; v != nil {
+		r.CustomizeDiff = rs.CustomizeDiff(v)
+	}
+	for _, stateUpgrader := range r.StateUpgraders {
+		if v := stateUpgrader.Upgrade; v != nil {
+			stateUpgrader.Upgrade = rs.StateUpgrade(v)
+		}
+	}
+
+	return r, nil
+}
+
+// sdkInstanceResources builds a single provider instance's Plugin SDK data sources and resources.
+// Until built, each data source or resource is an empty placeholder in the provider's DataSourcesMap or ResourcesMap.
+type sdkInstanceResources struct {
+	dataSources map[string]func() error
+	resources   map[string]func() error
+}
+
+// buildDataSource builds the specified data source, if it is implemented by this provider.
+func (r *sdkInstanceResources) buildDataSource(typeName string) error {
+	if build, ok := r.dataSources[typeName]; ok {
+		return build()
+	}
+
+	return nil
+}
+
+// buildResource builds the specified resource, if it is implemented by this provider.
+func (r *sdkInstanceResources) buildResource(typeName string) error {
+	if build, ok := r.resources[typeName]; ok {
+		return build()
+	}
+
+	return nil
+}
+
+// buildAll builds all data sources and resources.
+func (r *sdkInstanceResources) buildAll() error {
+	var errs []error
+
+	for _, typeName := range slices.Sorted(maps.Keys(r.dataSources)) {
+		errs = append(errs, r.buildDataSource(typeName))
+	}
+	for _, typeName := range slices.Sorted(maps.Keys(r.resources)) {
+		errs = append(errs, r.buildResource(typeName))
+	}
+
+	return errors.Join(errs...)
+}
+
+// addPlaceholders adds an empty placeholder to m for each of the specified data sources or resources.
+// The returned functions replace a placeholder with a copy of the built data source or resource,
+// so that provider instances do not share *schema.Resource values.
+// Schemas are shared. They are not modified once built.
+func addPlaceholders(m map[string]*schema.Resource, builders map[string]func() (*schema.Resource, error)) map[string]func() error {
+	builds := make(map[string]func() error, len(builders))
+
+	for typeName, build := range builders {
+		placeholder := &schema.Resource{}
+		m[typeName] = placeholder
+		builds[typeName] = sync.OnceValue(func() error {
+			v, err := build()
+
+			if err != nil {
+				return err
 			}
-			for _, stateUpgrader := range r.StateUpgraders {
-				if v := stateUpgrader.Upgrade; v != nil {
-					stateUpgrader.Upgrade = rs.StateUpgrade(v)
-				}
+
+			*placeholder = *v
+			if v := v.Importer; v != nil {
+				importer := *v
+				placeholder.Importer = &importer
 			}
+			placeholder.StateUpgraders = slices.Clone(v.StateUpgraders)
 
-			provider.ResourcesMap[typeName] = r
-		}
+			return nil
+		})
 	}
 
-	if err := errors.Join(errs...); err != nil {
-		return nil, err
+	return builds
+}
+
+// validateSDKDataSourceTagsSchema checks the schema of a data source that has opted in to transparent tagging.
+func validateSDKDataSourceTagsSchema(typeName string, schema map[string]*schema.Schema) error {
+	if v, ok := schema[names.AttrTags]; ok {
+		if !v.Computed {
+			return fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName)
+		}
+	} else {
+		return fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName)
 	}
 
-	// Set the provider Meta (instance data) here.
-	// It will be overwritten by the result of the call to ConfigureContextFunc,
-	// but can be used pre-configuration by other (non-primary) provider servers.
-	var meta *conns.AWSClient
-	if v, ok := provider.Meta().(*conns.AWSClient); ok {
-		meta = v
+	return nil
+}
+
+// validateSDKResourceTagsSchema checks the schema of a resource that has opted in to transparent tagging.
+func validateSDKResourceTagsSchema(typeName string, schema map[string]*schema.Schema) error {
+	if v, ok := schema[names.AttrTags]; ok {
+		if v.Computed {
+			return fmt.Errorf("`%s` attribute cannot be Computed: %s", names.AttrTags, typeName)
+		}
 	} else {
-		meta = new(conns.AWSClient)
+		return fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTags, typeName)
+	}
+	if v, ok := schema[names.AttrTagsAll]; ok {
+		if !v.Computed {
+			return fmt.Errorf("`%s` attribute must be Computed: %s", names.AttrTags, typeName)
+		}
+	} else {
+		return fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName)
 	}
-	meta.SetServicePackages(ctx, servicePackageMap)
-	provider.SetMeta(meta)
 
-	return provider, nil
+	return nil
 }
 
 // configure ensures that the provider is fully configured.
@@ -481,6 +676,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		CoalesceReads:                  d.Get("coalesce_reads").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
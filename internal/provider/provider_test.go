@@ -11,9 +11,13 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -28,6 +32,20 @@ func BenchmarkSDKProviderInitialization(b *testing.B) {
 	}
 }
 
+// go test -bench=BenchmarkSDKProviderFirstResourceSchema -benchmem -run=Bench -v ./internal/provider
+func BenchmarkSDKProviderFirstResourceSchema(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		p, err := New(context.Background())
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if r, ok := p.ResourcesMap["aws_s3_bucket"]; !ok || len(r.SchemaMap()) == 0 {
+			b.Fatal("no aws_s3_bucket schema")
+		}
+	}
+}
+
 func TestProvider(t *testing.T) {
 	t.Parallel()
 
@@ -44,6 +62,103 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderInstancesDoNotShareResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	p1, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, r1 := range p1.ResourcesMap {
+		if r1 == p2.ResourcesMap[typeName] {
+			t.Errorf("resource %s is shared by provider instances", typeName)
+		}
+	}
+	for typeName, r1 := range p1.DataSourcesMap {
+		if r1 == p2.DataSourcesMap[typeName] {
+			t.Errorf("data source %s is shared by provider instances", typeName)
+		}
+	}
+}
+
+func TestProviderTagsSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Data sources and resources, and so their schemas, are not built during provider initialization.
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.SDKDataSources(ctx) {
+			if v.Tags == nil {
+				continue
+			}
+
+			if err := validateSDKDataSourceTagsSchema(v.TypeName, v.Factory().SchemaMap()); err != nil {
+				t.Error(err)
+			}
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			if v.Tags == nil {
+				continue
+			}
+
+			if err := validateSDKResourceTagsSchema(v.TypeName, v.Factory().SchemaMap()); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+}
+
+func TestProviderFrameworkTagsSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	primary, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Schemas are built, and the schemas of data sources and resources that have opted in to transparent tagging checked, on first use.
+	p := fwprovider.New(primary)
+
+	for _, f := range p.DataSources(ctx) {
+		v := f()
+
+		metadataResponse := datasource.MetadataResponse{}
+		v.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+
+		schemaResponse := datasource.SchemaResponse{}
+		v.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+		if err := fwdiag.DiagnosticsError(schemaResponse.Diagnostics); err != nil {
+			t.Errorf("data source %s: %s", metadataResponse.TypeName, err)
+		}
+	}
+
+	for _, f := range p.Resources(ctx) {
+		v := f()
+
+		metadataResponse := resource.MetadataResponse{}
+		v.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aws"}, &metadataResponse)
+
+		schemaResponse := resource.SchemaResponse{}
+		v.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+		if err := fwdiag.DiagnosticsError(schemaResponse.Diagnostics); err != nil {
+			t.Errorf("resource %s: %s", metadataResponse.TypeName, err)
+		}
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -5,6 +5,7 @@ package provider
 import (
 	"context"
 	"slices"
+	"sync"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
@@ -260,260 +261,269 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 )
 
+var (
+	servicePackagesOnce  sync.Once
+	servicePackagesCache []conns.ServicePackage
+)
+
+// servicePackages returns all service packages.
+// The service packages are created once per process.
 func servicePackages(ctx context.Context) []conns.ServicePackage {
-	v := []conns.ServicePackage{
-		accessanalyzer.ServicePackage(ctx),
-		account.ServicePackage(ctx),
-		acm.ServicePackage(ctx),
-		acmpca.ServicePackage(ctx),
-		amp.ServicePackage(ctx),
-		amplify.ServicePackage(ctx),
-		apigateway.ServicePackage(ctx),
-		apigatewayv2.ServicePackage(ctx),
-		appautoscaling.ServicePackage(ctx),
-		appconfig.ServicePackage(ctx),
-		appfabric.ServicePackage(ctx),
-		appflow.ServicePackage(ctx),
-		appintegrations.ServicePackage(ctx),
-		applicationinsights.ServicePackage(ctx),
-		applicationsignals.ServicePackage(ctx),
-		appmesh.ServicePackage(ctx),
-		apprunner.ServicePackage(ctx),
-		appstream.ServicePackage(ctx),
-		appsync.ServicePackage(ctx),
-		athena.ServicePackage(ctx),
-		auditmanager.ServicePackage(ctx),
-		autoscaling.ServicePackage(ctx),
-		autoscalingplans.ServicePackage(ctx),
-		backup.ServicePackage(ctx),
-		batch.ServicePackage(ctx),
-		bcmdataexports.ServicePackage(ctx),
-		bedrock.ServicePackage(ctx),
-		bedrockagent.ServicePackage(ctx),
-		billing.ServicePackage(ctx),
-		budgets.ServicePackage(ctx),
-		ce.ServicePackage(ctx),
-		chatbot.ServicePackage(ctx),
-		chime.ServicePackage(ctx),
-		chimesdkmediapipelines.ServicePackage(ctx),
-		chimesdkvoice.ServicePackage(ctx),
-		cleanrooms.ServicePackage(ctx),
-		cloud9.ServicePackage(ctx),
-		cloudcontrol.ServicePackage(ctx),
-		cloudformation.ServicePackage(ctx),
-		cloudfront.ServicePackage(ctx),
-		cloudfrontkeyvaluestore.ServicePackage(ctx),
-		cloudhsmv2.ServicePackage(ctx),
-		cloudsearch.ServicePackage(ctx),
-		cloudtrail.ServicePackage(ctx),
-		cloudwatch.ServicePackage(ctx),
-		codeartifact.ServicePackage(ctx),
-		codebuild.ServicePackage(ctx),
-		codecatalyst.ServicePackage(ctx),
-		codecommit.ServicePackage(ctx),
-		codeconnections.ServicePackage(ctx),
-		codeguruprofiler.ServicePackage(ctx),
-		codegurureviewer.ServicePackage(ctx),
-		codepipeline.ServicePackage(ctx),
-		codestarconnections.ServicePackage(ctx),
-		codestarnotifications.ServicePackage(ctx),
-		cognitoidentity.ServicePackage(ctx),
-		cognitoidp.ServicePackage(ctx),
-		comprehend.ServicePackage(ctx),
-		computeoptimizer.ServicePackage(ctx),
-		configservice.ServicePackage(ctx),
-		connect.ServicePackage(ctx),
-		connectcases.ServicePackage(ctx),
-		controltower.ServicePackage(ctx),
-		costoptimizationhub.ServicePackage(ctx),
-		cur.ServicePackage(ctx),
-		customerprofiles.ServicePackage(ctx),
-		databrew.ServicePackage(ctx),
-		dataexchange.ServicePackage(ctx),
-		datapipeline.ServicePackage(ctx),
-		datasync.ServicePackage(ctx),
-		datazone.ServicePackage(ctx),
-		dax.ServicePackage(ctx),
-		deploy.ServicePackage(ctx),
-		detective.ServicePackage(ctx),
-		devicefarm.ServicePackage(ctx),
-		devopsguru.ServicePackage(ctx),
-		directconnect.ServicePackage(ctx),
-		dlm.ServicePackage(ctx),
-		dms.ServicePackage(ctx),
-		docdb.ServicePackage(ctx),
-		docdbelastic.ServicePackage(ctx),
-		drs.ServicePackage(ctx),
-		ds.ServicePackage(ctx),
-		dynamodb.ServicePackage(ctx),
-		ec2.ServicePackage(ctx),
-		ecr.ServicePackage(ctx),
-		ecrpublic.ServicePackage(ctx),
-		ecs.ServicePackage(ctx),
-		efs.ServicePackage(ctx),
-		eks.ServicePackage(ctx),
-		elasticache.ServicePackage(ctx),
-		elasticbeanstalk.ServicePackage(ctx),
-		elasticsearch.ServicePackage(ctx),
-		elastictranscoder.ServicePackage(ctx),
-		elb.ServicePackage(ctx),
-		elbv2.ServicePackage(ctx),
-		emr.ServicePackage(ctx),
-		emrcontainers.ServicePackage(ctx),
-		emrserverless.ServicePackage(ctx),
-		events.ServicePackage(ctx),
-		evidently.ServicePackage(ctx),
-		finspace.ServicePackage(ctx),
-		firehose.ServicePackage(ctx),
-		fis.ServicePackage(ctx),
-		fms.ServicePackage(ctx),
-		fsx.ServicePackage(ctx),
-		gamelift.ServicePackage(ctx),
-		glacier.ServicePackage(ctx),
-		globalaccelerator.ServicePackage(ctx),
-		glue.ServicePackage(ctx),
-		grafana.ServicePackage(ctx),
-		greengrass.ServicePackage(ctx),
-		groundstation.ServicePackage(ctx),
-		guardduty.ServicePackage(ctx),
-		healthlake.ServicePackage(ctx),
-		iam.ServicePackage(ctx),
-		identitystore.ServicePackage(ctx),
-		imagebuilder.ServicePackage(ctx),
-		inspector.ServicePackage(ctx),
-		inspector2.ServicePackage(ctx),
-		internetmonitor.ServicePackage(ctx),
-		invoicing.ServicePackage(ctx),
-		iot.ServicePackage(ctx),
-		iotanalytics.ServicePackage(ctx),
-		iotevents.ServicePackage(ctx),
-		ivs.ServicePackage(ctx),
-		ivschat.ServicePackage(ctx),
-		kafka.ServicePackage(ctx),
-		kafkaconnect.ServicePackage(ctx),
-		kendra.ServicePackage(ctx),
-		keyspaces.ServicePackage(ctx),
-		kinesis.ServicePackage(ctx),
-		kinesisanalytics.ServicePackage(ctx),
-		kinesisanalyticsv2.ServicePackage(ctx),
-		kinesisvideo.ServicePackage(ctx),
-		kms.ServicePackage(ctx),
-		lakeformation.ServicePackage(ctx),
-		lambda.ServicePackage(ctx),
-		launchwizard.ServicePackage(ctx),
-		lexmodels.ServicePackage(ctx),
-		lexv2models.ServicePackage(ctx),
-		licensemanager.ServicePackage(ctx),
-		lightsail.ServicePackage(ctx),
-		location.ServicePackage(ctx),
-		logs.ServicePackage(ctx),
-		lookoutmetrics.ServicePackage(ctx),
-		m2.ServicePackage(ctx),
-		macie2.ServicePackage(ctx),
-		mediaconnect.ServicePackage(ctx),
-		mediaconvert.ServicePackage(ctx),
-		medialive.ServicePackage(ctx),
-		mediapackage.ServicePackage(ctx),
-		mediapackagev2.ServicePackage(ctx),
-		mediastore.ServicePackage(ctx),
-		memorydb.ServicePackage(ctx),
-		meta.ServicePackage(ctx),
-		mgn.ServicePackage(ctx),
-		mq.ServicePackage(ctx),
-		mwaa.ServicePackage(ctx),
-		neptune.ServicePackage(ctx),
-		neptunegraph.ServicePackage(ctx),
-		networkfirewall.ServicePackage(ctx),
-		networkmanager.ServicePackage(ctx),
-		networkmonitor.ServicePackage(ctx),
-		oam.ServicePackage(ctx),
-		opensearch.ServicePackage(ctx),
-		opensearchserverless.ServicePackage(ctx),
-		opsworks.ServicePackage(ctx),
-		organizations.ServicePackage(ctx),
-		osis.ServicePackage(ctx),
-		outposts.ServicePackage(ctx),
-		paymentcryptography.ServicePackage(ctx),
-		pcaconnectorad.ServicePackage(ctx),
-		pcs.ServicePackage(ctx),
-		pinpoint.ServicePackage(ctx),
-		pinpointsmsvoicev2.ServicePackage(ctx),
-		pipes.ServicePackage(ctx),
-		polly.ServicePackage(ctx),
-		pricing.ServicePackage(ctx),
-		qbusiness.ServicePackage(ctx),
-		qldb.ServicePackage(ctx),
-		quicksight.ServicePackage(ctx),
-		ram.ServicePackage(ctx),
-		rbin.ServicePackage(ctx),
-		rds.ServicePackage(ctx),
-		redshift.ServicePackage(ctx),
-		redshiftdata.ServicePackage(ctx),
-		redshiftserverless.ServicePackage(ctx),
-		rekognition.ServicePackage(ctx),
-		resiliencehub.ServicePackage(ctx),
-		resourceexplorer2.ServicePackage(ctx),
-		resourcegroups.ServicePackage(ctx),
-		resourcegroupstaggingapi.ServicePackage(ctx),
-		rolesanywhere.ServicePackage(ctx),
-		route53.ServicePackage(ctx),
-		route53domains.ServicePackage(ctx),
-		route53profiles.ServicePackage(ctx),
-		route53recoverycontrolconfig.ServicePackage(ctx),
-		route53recoveryreadiness.ServicePackage(ctx),
-		route53resolver.ServicePackage(ctx),
-		rum.ServicePackage(ctx),
-		s3.ServicePackage(ctx),
-		s3control.ServicePackage(ctx),
-		s3outposts.ServicePackage(ctx),
-		s3tables.ServicePackage(ctx),
-		sagemaker.ServicePackage(ctx),
-		scheduler.ServicePackage(ctx),
-		schemas.ServicePackage(ctx),
-		secretsmanager.ServicePackage(ctx),
-		securityhub.ServicePackage(ctx),
-		securitylake.ServicePackage(ctx),
-		serverlessrepo.ServicePackage(ctx),
-		servicecatalog.ServicePackage(ctx),
-		servicecatalogappregistry.ServicePackage(ctx),
-		servicediscovery.ServicePackage(ctx),
-		servicequotas.ServicePackage(ctx),
-		ses.ServicePackage(ctx),
-		sesv2.ServicePackage(ctx),
-		sfn.ServicePackage(ctx),
-		shield.ServicePackage(ctx),
-		signer.ServicePackage(ctx),
-		simpledb.ServicePackage(ctx),
-		sns.ServicePackage(ctx),
-		sqs.ServicePackage(ctx),
-		ssm.ServicePackage(ctx),
-		ssmcontacts.ServicePackage(ctx),
-		ssmincidents.ServicePackage(ctx),
-		ssmquicksetup.ServicePackage(ctx),
-		ssmsap.ServicePackage(ctx),
-		sso.ServicePackage(ctx),
-		ssoadmin.ServicePackage(ctx),
-		storagegateway.ServicePackage(ctx),
-		sts.ServicePackage(ctx),
-		swf.ServicePackage(ctx),
-		synthetics.ServicePackage(ctx),
-		taxsettings.ServicePackage(ctx),
-		timestreaminfluxdb.ServicePackage(ctx),
-		timestreamquery.ServicePackage(ctx),
-		timestreamwrite.ServicePackage(ctx),
-		transcribe.ServicePackage(ctx),
-		transfer.ServicePackage(ctx),
-		verifiedpermissions.ServicePackage(ctx),
-		vpclattice.ServicePackage(ctx),
-		waf.ServicePackage(ctx),
-		wafregional.ServicePackage(ctx),
-		wafv2.ServicePackage(ctx),
-		wellarchitected.ServicePackage(ctx),
-		worklink.ServicePackage(ctx),
-		workspaces.ServicePackage(ctx),
-		workspacesweb.ServicePackage(ctx),
-		xray.ServicePackage(ctx),
-	}
+	servicePackagesOnce.Do(func() {
+		servicePackagesCache = []conns.ServicePackage{
+			accessanalyzer.ServicePackage(ctx),
+			account.ServicePackage(ctx),
+			acm.ServicePackage(ctx),
+			acmpca.ServicePackage(ctx),
+			amp.ServicePackage(ctx),
+			amplify.ServicePackage(ctx),
+			apigateway.ServicePackage(ctx),
+			apigatewayv2.ServicePackage(ctx),
+			appautoscaling.ServicePackage(ctx),
+			appconfig.ServicePackage(ctx),
+			appfabric.ServicePackage(ctx),
+			appflow.ServicePackage(ctx),
+			appintegrations.ServicePackage(ctx),
+			applicationinsights.ServicePackage(ctx),
+			applicationsignals.ServicePackage(ctx),
+			appmesh.ServicePackage(ctx),
+			apprunner.ServicePackage(ctx),
+			appstream.ServicePackage(ctx),
+			appsync.ServicePackage(ctx),
+			athena.ServicePackage(ctx),
+			auditmanager.ServicePackage(ctx),
+			autoscaling.ServicePackage(ctx),
+			autoscalingplans.ServicePackage(ctx),
+			backup.ServicePackage(ctx),
+			batch.ServicePackage(ctx),
+			bcmdataexports.ServicePackage(ctx),
+			bedrock.ServicePackage(ctx),
+			bedrockagent.ServicePackage(ctx),
+			billing.ServicePackage(ctx),
+			budgets.ServicePackage(ctx),
+			ce.ServicePackage(ctx),
+			chatbot.ServicePackage(ctx),
+			chime.ServicePackage(ctx),
+			chimesdkmediapipelines.ServicePackage(ctx),
+			chimesdkvoice.ServicePackage(ctx),
+			cleanrooms.ServicePackage(ctx),
+			cloud9.ServicePackage(ctx),
+			cloudcontrol.ServicePackage(ctx),
+			cloudformation.ServicePackage(ctx),
+			cloudfront.ServicePackage(ctx),
+			cloudfrontkeyvaluestore.ServicePackage(ctx),
+			cloudhsmv2.ServicePackage(ctx),
+			cloudsearch.ServicePackage(ctx),
+			cloudtrail.ServicePackage(ctx),
+			cloudwatch.ServicePackage(ctx),
+			codeartifact.ServicePackage(ctx),
+			codebuild.ServicePackage(ctx),
+			codecatalyst.ServicePackage(ctx),
+			codecommit.ServicePackage(ctx),
+			codeconnections.ServicePackage(ctx),
+			codeguruprofiler.ServicePackage(ctx),
+			codegurureviewer.ServicePackage(ctx),
+			codepipeline.ServicePackage(ctx),
+			codestarconnections.ServicePackage(ctx),
+			codestarnotifications.ServicePackage(ctx),
+			cognitoidentity.ServicePackage(ctx),
+			cognitoidp.ServicePackage(ctx),
+			comprehend.ServicePackage(ctx),
+			computeoptimizer.ServicePackage(ctx),
+			configservice.ServicePackage(ctx),
+			connect.ServicePackage(ctx),
+			connectcases.ServicePackage(ctx),
+			controltower.ServicePackage(ctx),
+			costoptimizationhub.ServicePackage(ctx),
+			cur.ServicePackage(ctx),
+			customerprofiles.ServicePackage(ctx),
+			databrew.ServicePackage(ctx),
+			dataexchange.ServicePackage(ctx),
+			datapipeline.ServicePackage(ctx),
+			datasync.ServicePackage(ctx),
+			datazone.ServicePackage(ctx),
+			dax.ServicePackage(ctx),
+			deploy.ServicePackage(ctx),
+			detective.ServicePackage(ctx),
+			devicefarm.ServicePackage(ctx),
+			devopsguru.ServicePackage(ctx),
+			directconnect.ServicePackage(ctx),
+			dlm.ServicePackage(ctx),
+			dms.ServicePackage(ctx),
+			docdb.ServicePackage(ctx),
+			docdbelastic.ServicePackage(ctx),
+			drs.ServicePackage(ctx),
+			ds.ServicePackage(ctx),
+			dynamodb.ServicePackage(ctx),
+			ec2.ServicePackage(ctx),
+			ecr.ServicePackage(ctx),
+			ecrpublic.ServicePackage(ctx),
+			ecs.ServicePackage(ctx),
+			efs.ServicePackage(ctx),
+			eks.ServicePackage(ctx),
+			elasticache.ServicePackage(ctx),
+			elasticbeanstalk.ServicePackage(ctx),
+			elasticsearch.ServicePackage(ctx),
+			elastictranscoder.ServicePackage(ctx),
+			elb.ServicePackage(ctx),
+			elbv2.ServicePackage(ctx),
+			emr.ServicePackage(ctx),
+			emrcontainers.ServicePackage(ctx),
+			emrserverless.ServicePackage(ctx),
+			events.ServicePackage(ctx),
+			evidently.ServicePackage(ctx),
+			finspace.ServicePackage(ctx),
+			firehose.ServicePackage(ctx),
+			fis.ServicePackage(ctx),
+			fms.ServicePackage(ctx),
+			fsx.ServicePackage(ctx),
+			gamelift.ServicePackage(ctx),
+			glacier.ServicePackage(ctx),
+			globalaccelerator.ServicePackage(ctx),
+			glue.ServicePackage(ctx),
+			grafana.ServicePackage(ctx),
+			greengrass.ServicePackage(ctx),
+			groundstation.ServicePackage(ctx),
+			guardduty.ServicePackage(ctx),
+			healthlake.ServicePackage(ctx),
+			iam.ServicePackage(ctx),
+			identitystore.ServicePackage(ctx),
+			imagebuilder.ServicePackage(ctx),
+			inspector.ServicePackage(ctx),
+			inspector2.ServicePackage(ctx),
+			internetmonitor.ServicePackage(ctx),
+			invoicing.ServicePackage(ctx),
+			iot.ServicePackage(ctx),
+			iotanalytics.ServicePackage(ctx),
+			iotevents.ServicePackage(ctx),
+			ivs.ServicePackage(ctx),
+			ivschat.ServicePackage(ctx),
+			kafka.ServicePackage(ctx),
+			kafkaconnect.ServicePackage(ctx),
+			kendra.ServicePackage(ctx),
+			keyspaces.ServicePackage(ctx),
+			kinesis.ServicePackage(ctx),
+			kinesisanalytics.ServicePackage(ctx),
+			kinesisanalyticsv2.ServicePackage(ctx),
+			kinesisvideo.ServicePackage(ctx),
+			kms.ServicePackage(ctx),
+			lakeformation.ServicePackage(ctx),
+			lambda.ServicePackage(ctx),
+			launchwizard.ServicePackage(ctx),
+			lexmodels.ServicePackage(ctx),
+			lexv2models.ServicePackage(ctx),
+			licensemanager.ServicePackage(ctx),
+			lightsail.ServicePackage(ctx),
+			location.ServicePackage(ctx),
+			logs.ServicePackage(ctx),
+			lookoutmetrics.ServicePackage(ctx),
+			m2.ServicePackage(ctx),
+			macie2.ServicePackage(ctx),
+			mediaconnect.ServicePackage(ctx),
+			mediaconvert.ServicePackage(ctx),
+			medialive.ServicePackage(ctx),
+			mediapackage.ServicePackage(ctx),
+			mediapackagev2.ServicePackage(ctx),
+			mediastore.ServicePackage(ctx),
+			memorydb.ServicePackage(ctx),
+			meta.ServicePackage(ctx),
+			mgn.ServicePackage(ctx),
+			mq.ServicePackage(ctx),
+			mwaa.ServicePackage(ctx),
+			neptune.ServicePackage(ctx),
+			neptunegraph.ServicePackage(ctx),
+			networkfirewall.ServicePackage(ctx),
+			networkmanager.ServicePackage(ctx),
+			networkmonitor.ServicePackage(ctx),
+			oam.ServicePackage(ctx),
+			opensearch.ServicePackage(ctx),
+			opensearchserverless.ServicePackage(ctx),
+			opsworks.ServicePackage(ctx),
+			organizations.ServicePackage(ctx),
+			osis.ServicePackage(ctx),
+			outposts.ServicePackage(ctx),
+			paymentcryptography.ServicePackage(ctx),
+			pcaconnectorad.ServicePackage(ctx),
+			pcs.ServicePackage(ctx),
+			pinpoint.ServicePackage(ctx),
+			pinpointsmsvoicev2.ServicePackage(ctx),
+			pipes.ServicePackage(ctx),
+			polly.ServicePackage(ctx),
+			pricing.ServicePackage(ctx),
+			qbusiness.ServicePackage(ctx),
+			qldb.ServicePackage(ctx),
+			quicksight.ServicePackage(ctx),
+			ram.ServicePackage(ctx),
+			rbin.ServicePackage(ctx),
+			rds.ServicePackage(ctx),
+			redshift.ServicePackage(ctx),
+			redshiftdata.ServicePackage(ctx),
+			redshiftserverless.ServicePackage(ctx),
+			rekognition.ServicePackage(ctx),
+			resiliencehub.ServicePackage(ctx),
+			resourceexplorer2.ServicePackage(ctx),
+			resourcegroups.ServicePackage(ctx),
+			resourcegroupstaggingapi.ServicePackage(ctx),
+			rolesanywhere.ServicePackage(ctx),
+			route53.ServicePackage(ctx),
+			route53domains.ServicePackage(ctx),
+			route53profiles.ServicePackage(ctx),
+			route53recoverycontrolconfig.ServicePackage(ctx),
+			route53recoveryreadiness.ServicePackage(ctx),
+			route53resolver.ServicePackage(ctx),
+			rum.ServicePackage(ctx),
+			s3.ServicePackage(ctx),
+			s3control.ServicePackage(ctx),
+			s3outposts.ServicePackage(ctx),
+			s3tables.ServicePackage(ctx),
+			sagemaker.ServicePackage(ctx),
+			scheduler.ServicePackage(ctx),
+			schemas.ServicePackage(ctx),
+			secretsmanager.ServicePackage(ctx),
+			securityhub.ServicePackage(ctx),
+			securitylake.ServicePackage(ctx),
+			serverlessrepo.ServicePackage(ctx),
+			servicecatalog.ServicePackage(ctx),
+			servicecatalogappregistry.ServicePackage(ctx),
+			servicediscovery.ServicePackage(ctx),
+			servicequotas.ServicePackage(ctx),
+			ses.ServicePackage(ctx),
+			sesv2.ServicePackage(ctx),
+			sfn.ServicePackage(ctx),
+			shield.ServicePackage(ctx),
+			signer.ServicePackage(ctx),
+			simpledb.ServicePackage(ctx),
+			sns.ServicePackage(ctx),
+			sqs.ServicePackage(ctx),
+			ssm.ServicePackage(ctx),
+			ssmcontacts.ServicePackage(ctx),
+			ssmincidents.ServicePackage(ctx),
+			ssmquicksetup.ServicePackage(ctx),
+			ssmsap.ServicePackage(ctx),
+			sso.ServicePackage(ctx),
+			ssoadmin.ServicePackage(ctx),
+			storagegateway.ServicePackage(ctx),
+			sts.ServicePackage(ctx),
+			swf.ServicePackage(ctx),
+			synthetics.ServicePackage(ctx),
+			taxsettings.ServicePackage(ctx),
+			timestreaminfluxdb.ServicePackage(ctx),
+			timestreamquery.ServicePackage(ctx),
+			timestreamwrite.ServicePackage(ctx),
+			transcribe.ServicePackage(ctx),
+			transfer.ServicePackage(ctx),
+			verifiedpermissions.ServicePackage(ctx),
+			vpclattice.ServicePackage(ctx),
+			waf.ServicePackage(ctx),
+			wafregional.ServicePackage(ctx),
+			wafv2.ServicePackage(ctx),
+			wellarchitected.ServicePackage(ctx),
+			worklink.ServicePackage(ctx),
+			workspaces.ServicePackage(ctx),
+			workspacesweb.ServicePackage(ctx),
+			xray.ServicePackage(ctx),
+		}
+	})
 
-	return slices.Clone(v)
+	return slices.Clone(servicePackagesCache)
 }
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cron is a parsed AWS cron expression:
+//
+//	Minutes Hours Day-of-month Month Day-of-week Year
+type cron struct {
+	minutes field
+	hours   field
+	months  field
+	years   field
+
+	// Exactly one of day-of-month and day-of-week is '?'.
+	daysOfMonth      *field
+	lastDayOfMonth   bool  // L
+	lastWeekdayMonth bool  // LW
+	nearestWeekdays  []int // nW
+
+	daysOfWeek     *field
+	lastDaysOfWeek []int    // nL
+	nthDaysOfWeek  [][2]int // n#k
+}
+
+const (
+	minYear = 1970
+	maxYear = 2199
+)
+
+var (
+	monthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	dayNames   = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// field is the set of values that match a cron field.
+type field struct {
+	min, max int
+	values   []bool // Indexed by value - min.
+}
+
+func newField(minValue, maxValue int) field {
+	return field{
+		min:    minValue,
+		max:    maxValue,
+		values: make([]bool, maxValue-minValue+1),
+	}
+}
+
+func (f *field) matches(v int) bool {
+	return v >= f.min && v <= f.max && f.values[v-f.min]
+}
+
+func (f *field) set(from, to, step int) {
+	for v := from; v <= to; v += step {
+		f.values[v-f.min] = true
+	}
+}
+
+func parseCron(s string) (*cron, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("expected cron(Minutes Hours Day-of-month Month Day-of-week Year), got %d fields", len(fields))
+	}
+
+	c := &cron{}
+	var err error
+
+	if c.minutes, err = parseField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("minutes: %w", err)
+	}
+	if c.hours, err = parseField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("hours: %w", err)
+	}
+	if c.months, err = parseField(fields[3], 1, 12, monthNames); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	if c.years, err = parseField(fields[5], minYear, maxYear, nil); err != nil {
+		return nil, fmt.Errorf("year: %w", err)
+	}
+
+	switch dom, dow := fields[2], fields[4]; {
+	case dom == "?" && dow == "?":
+		return nil, fmt.Errorf("only one of day-of-month and day-of-week can be '?'")
+	case dom == "?":
+		if err := c.parseDaysOfWeek(dow); err != nil {
+			return nil, fmt.Errorf("day-of-week: %w", err)
+		}
+	case dow == "?":
+		if err := c.parseDaysOfMonth(dom); err != nil {
+			return nil, fmt.Errorf("day-of-month: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("one of day-of-month and day-of-week must be '?'")
+	}
+
+	return c, nil
+}
+
+func (c *cron) parseDaysOfMonth(s string) error {
+	var items []string
+
+	for _, item := range strings.Split(s, ",") {
+		switch {
+		case item == "L":
+			c.lastDayOfMonth = true
+		case item == "LW":
+			c.lastWeekdayMonth = true
+		case strings.HasSuffix(item, "W"):
+			v, err := parseValue(strings.TrimSuffix(item, "W"), 1, 31, nil)
+			if err != nil {
+				return err
+			}
+			c.nearestWeekdays = append(c.nearestWeekdays, v)
+		default:
+			items = append(items, item)
+		}
+	}
+
+	f := newField(1, 31)
+	if len(items) > 0 {
+		var err error
+		if f, err = parseField(strings.Join(items, ","), 1, 31, nil); err != nil {
+			return err
+		}
+	}
+	c.daysOfMonth = &f
+
+	return nil
+}
+
+func (c *cron) parseDaysOfWeek(s string) error {
+	var items []string
+
+	for _, item := range strings.Split(s, ",") {
+		switch {
+		case item == "L":
+			// The last day of the week, Saturday.
+			items = append(items, "7")
+		case strings.HasSuffix(item, "L"):
+			v, err := parseValue(strings.TrimSuffix(item, "L"), 1, 7, dayNames)
+			if err != nil {
+				return err
+			}
+			c.lastDaysOfWeek = append(c.lastDaysOfWeek, v)
+		case strings.Contains(item, "#"):
+			day, nth, _ := strings.Cut(item, "#")
+			v, err := parseValue(day, 1, 7, dayNames)
+			if err != nil {
+				return err
+			}
+			n, err := parseValue(nth, 1, 5, nil)
+			if err != nil {
+				return err
+			}
+			c.nthDaysOfWeek = append(c.nthDaysOfWeek, [2]int{v, n})
+		default:
+			items = append(items, item)
+		}
+	}
+
+	f := newField(1, 7)
+	if len(items) > 0 {
+		var err error
+		if f, err = parseField(strings.Join(items, ","), 1, 7, dayNames); err != nil {
+			return err
+		}
+	}
+	c.daysOfWeek = &f
+
+	return nil
+}
+
+// parseField parses a comma-separated list of '*', values, ranges and increments.
+func parseField(s string, minValue, maxValue int, names []string) (field, error) {
+	f := newField(minValue, maxValue)
+
+	for _, item := range strings.Split(s, ",") {
+		rng, inc, hasIncrement := strings.Cut(item, "/")
+
+		step := 1
+		if hasIncrement {
+			v, err := strconv.Atoi(inc)
+			if err != nil || v < 1 {
+				return f, fmt.Errorf("invalid increment (%s)", inc)
+			}
+			step = v
+		}
+
+		var from, to int
+		switch {
+		case rng == "*":
+			from, to = minValue, maxValue
+		case strings.Contains(rng, "-"):
+			lo, hi, _ := strings.Cut(rng, "-")
+			var err error
+			if from, err = parseValue(lo, minValue, maxValue, names); err != nil {
+				return f, err
+			}
+			if to, err = parseValue(hi, minValue, maxValue, names); err != nil {
+				return f, err
+			}
+			if from > to {
+				return f, fmt.Errorf("invalid range (%s)", rng)
+			}
+		default:
+			var err error
+			if from, err = parseValue(rng, minValue, maxValue, names); err != nil {
+				return f, err
+			}
+			to = from
+			if hasIncrement {
+				to = maxValue
+			}
+		}
+
+		f.set(from, to, step)
+	}
+
+	return f, nil
+}
+
+// parseValue parses a single value, which may be a name when names is non-nil.
+func parseValue(s string, minValue, maxValue int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			return minValue + i, nil
+		}
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value (%s)", s)
+	}
+
+	if v < minValue || v > maxValue {
+		return 0, fmt.Errorf("value (%d) must be between %d and %d", v, minValue, maxValue)
+	}
+
+	return v, nil
+}
+
+// matchesDay returns whether the cron expression matches the specified day.
+func (c *cron) matchesDay(year int, month time.Month, day int, loc *time.Location) bool {
+	daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+
+	if f := c.daysOfMonth; f != nil {
+		if f.matches(day) || (c.lastDayOfMonth && day == daysInMonth) {
+			return true
+		}
+		if c.lastWeekdayMonth && day == nearestWeekday(year, month, daysInMonth, loc) {
+			return true
+		}
+		for _, v := range c.nearestWeekdays {
+			if v <= daysInMonth && day == nearestWeekday(year, month, v, loc) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	weekday := int(time.Date(year, month, day, 0, 0, 0, 0, loc).Weekday()) + 1
+
+	if c.daysOfWeek.matches(weekday) {
+		return true
+	}
+	for _, v := range c.lastDaysOfWeek {
+		if weekday == v && day+7 > daysInMonth {
+			return true
+		}
+	}
+	for _, v := range c.nthDaysOfWeek {
+		if weekday == v[0] && (day-1)/7+1 == v[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest the specified day, in the same month.
+func nearestWeekday(year int, month time.Month, day int, loc *time.Location) int {
+	daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+
+	switch time.Date(year, month, day, 0, 0, 0, 0, loc).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysInMonth {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+func (c *cron) nextRuns(start time.Time, loc *time.Location, count int) []time.Time {
+	var runs []time.Time
+
+	year, month, day := start.Date()
+	for d := time.Date(year, month, day, 0, 0, 0, 0, loc); d.Year() <= maxYear; d = d.AddDate(0, 0, 1) {
+		year, month, day := d.Date()
+
+		if !c.years.matches(year) {
+			// Skip to the last day of the year.
+			d = time.Date(year, time.December, 31, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !c.months.matches(int(month)) {
+			// Skip to the last day of the month.
+			d = time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !c.matchesDay(year, month, day, loc) {
+			continue
+		}
+
+		for hour := 0; hour <= 23; hour++ {
+			if !c.hours.matches(hour) {
+				continue
+			}
+
+			for minute := 0; minute <= 59; minute++ {
+				if !c.minutes.matches(minute) {
+					continue
+				}
+
+				t := time.Date(year, month, day, hour, minute, 0, 0, loc)
+
+				// Skip wall clock times that don't exist due to a daylight saving time transition.
+				if t.Hour() != hour || t.Minute() != minute {
+					continue
+				}
+
+				if !t.After(start) {
+					continue
+				}
+
+				runs = append(runs, t)
+				if len(runs) == count {
+					return runs
+				}
+			}
+		}
+	}
+
+	return runs
+}
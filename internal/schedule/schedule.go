@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schedule parses and evaluates the cron(), rate() and at() schedule expressions
+// accepted by Amazon EventBridge rules, EventBridge Scheduler and AWS Systems Manager maintenance windows.
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect is the schedule expression syntax accepted by a service.
+type Dialect int
+
+const (
+	// DialectEventBridgeRule is the syntax accepted by EventBridge rules: cron() and rate().
+	// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html.
+	DialectEventBridgeRule Dialect = iota
+	// DialectEventBridgeScheduler is the syntax accepted by EventBridge Scheduler: at(), cron() and rate().
+	// https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html.
+	DialectEventBridgeScheduler
+	// DialectSSMMaintenanceWindow is the syntax accepted by Systems Manager maintenance windows: at(), cron() and rate()
+	// in hours or days.
+	// https://docs.aws.amazon.com/systems-manager/latest/userguide/reference-cron-and-rate-expressions.html.
+	DialectSSMMaintenanceWindow
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectEventBridgeRule:
+		return "EventBridge rule"
+	case DialectEventBridgeScheduler:
+		return "EventBridge Scheduler"
+	case DialectSSMMaintenanceWindow:
+		return "Systems Manager maintenance window"
+	default:
+		return fmt.Sprintf("Dialect(%d)", d)
+	}
+}
+
+// supportsAt returns whether the dialect supports one-time at() expressions.
+func (d Dialect) supportsAt() bool {
+	return d == DialectEventBridgeScheduler || d == DialectSSMMaintenanceWindow
+}
+
+// rateUnits returns the rate() units supported by the dialect.
+func (d Dialect) rateUnits() map[string]time.Duration {
+	if d == DialectSSMMaintenanceWindow {
+		return map[string]time.Duration{
+			"hour": time.Hour,
+			"day":  24 * time.Hour,
+		}
+	}
+
+	return map[string]time.Duration{
+		"minute": time.Minute,
+		"hour":   time.Hour,
+		"day":    24 * time.Hour,
+	}
+}
+
+// strictRateUnits returns whether the dialect requires the singular rate() unit for a value of 1, and the plural otherwise.
+func (d Dialect) strictRateUnits() bool {
+	return d == DialectEventBridgeRule || d == DialectEventBridgeScheduler
+}
+
+const (
+	atLayout = "2006-01-02T15:04:05"
+)
+
+// Expression is a parsed schedule expression.
+type Expression struct {
+	at   *time.Time // Wall clock time, location is ignored.
+	cron *cron
+	rate time.Duration
+}
+
+// Parse parses a schedule expression in the specified dialect.
+func Parse(dialect Dialect, s string) (*Expression, error) {
+	name, body, ok := strings.Cut(s, "(")
+	if !ok || !strings.HasSuffix(body, ")") {
+		return nil, fmt.Errorf("invalid schedule expression %q: expected at(...), cron(...) or rate(...)", s)
+	}
+	body = strings.TrimSuffix(body, ")")
+
+	switch name {
+	case "at":
+		if !dialect.supportsAt() {
+			return nil, fmt.Errorf("invalid schedule expression %q: at() is not supported by %s", s, dialect)
+		}
+
+		t, err := time.Parse(atLayout, body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule expression %q: expected at(yyyy-mm-ddThh:mm:ss)", s)
+		}
+
+		return &Expression{at: &t}, nil
+
+	case "cron":
+		c, err := parseCron(body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule expression %q: %w", s, err)
+		}
+
+		return &Expression{cron: c}, nil
+
+	case "rate":
+		d, err := parseRate(dialect, body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule expression %q: %w", s, err)
+		}
+
+		return &Expression{rate: d}, nil
+
+	default:
+		return nil, fmt.Errorf("invalid schedule expression %q: expected at(...), cron(...) or rate(...)", s)
+	}
+}
+
+func parseRate(dialect Dialect, s string) (time.Duration, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("expected rate(value unit)")
+	}
+
+	value, err := strconv.Atoi(fields[0])
+	if err != nil || value < 1 {
+		return 0, fmt.Errorf("rate value (%s) must be a positive integer", fields[0])
+	}
+
+	unit := fields[1]
+	units := dialect.rateUnits()
+	d, ok := units[strings.TrimSuffix(unit, "s")]
+	if !ok {
+		return 0, fmt.Errorf("rate unit (%s) is not supported by %s", unit, dialect)
+	}
+
+	if dialect.strictRateUnits() {
+		if plural := strings.HasSuffix(unit, "s"); plural != (value > 1) {
+			if value == 1 {
+				return 0, fmt.Errorf("rate unit (%s) must be singular for a value of 1", unit)
+			}
+			return 0, fmt.Errorf("rate unit (%s) must be plural for a value greater than 1", unit)
+		}
+	}
+
+	return time.Duration(value) * d, nil
+}
+
+// NextRuns returns up to count times, after start, at which the schedule runs.
+// cron() and at() expressions are evaluated in the specified location.
+// rate() expressions run at intervals from start.
+func (e *Expression) NextRuns(start time.Time, loc *time.Location, count int) []time.Time {
+	var runs []time.Time
+
+	if count < 1 {
+		return runs
+	}
+
+	start = start.In(loc)
+
+	switch {
+	case e.at != nil:
+		t := time.Date(e.at.Year(), e.at.Month(), e.at.Day(), e.at.Hour(), e.at.Minute(), e.at.Second(), 0, loc)
+		if t.After(start) {
+			runs = append(runs, t)
+		}
+
+	case e.cron != nil:
+		runs = e.cron.nextRuns(start, loc, count)
+
+	default:
+		for i := 1; i <= count; i++ {
+			runs = append(runs, start.Add(time.Duration(i)*e.rate))
+		}
+	}
+
+	return runs
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dialect     Dialect
+		expression  string
+		expectError bool
+	}{
+		"cron": {
+			dialect:    DialectEventBridgeRule,
+			expression: "cron(0 10 * * ? *)",
+		},
+		"cron names": {
+			dialect:    DialectEventBridgeRule,
+			expression: "cron(15 12 ? JAN-MAR MON-FRI *)",
+		},
+		"cron increments": {
+			dialect:    DialectEventBridgeRule,
+			expression: "cron(0/15 */2 1-7 * ? 2025-2030)",
+		},
+		"cron last day of month": {
+			dialect:    DialectEventBridgeScheduler,
+			expression: "cron(0 18 L * ? *)",
+		},
+		"cron nearest weekday": {
+			dialect:    DialectEventBridgeScheduler,
+			expression: "cron(0 8 15W,LW * ? *)",
+		},
+		"cron last Friday": {
+			dialect:    DialectSSMMaintenanceWindow,
+			expression: "cron(0 10 ? * 6L *)",
+		},
+		"cron third Thursday": {
+			dialect:    DialectSSMMaintenanceWindow,
+			expression: "cron(0 2 ? * THU#3 *)",
+		},
+		"cron both days": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "cron(0 10 * * MON *)",
+			expectError: true,
+		},
+		"cron neither day": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "cron(0 10 ? * ? *)",
+			expectError: true,
+		},
+		"cron five fields": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "cron(0 10 * * ?)",
+			expectError: true,
+		},
+		"cron out of range": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "cron(60 10 * * ? *)",
+			expectError: true,
+		},
+		"cron invalid range": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "cron(0 10 ? * FRI-MON *)",
+			expectError: true,
+		},
+		"rate": {
+			dialect:    DialectEventBridgeRule,
+			expression: "rate(5 minutes)",
+		},
+		"rate singular": {
+			dialect:    DialectEventBridgeScheduler,
+			expression: "rate(1 hour)",
+		},
+		"rate plural value of 1": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "rate(1 minutes)",
+			expectError: true,
+		},
+		"rate singular value of 2": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "rate(2 day)",
+			expectError: true,
+		},
+		"rate zero": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "rate(0 minutes)",
+			expectError: true,
+		},
+		"rate SSM hours": {
+			dialect:    DialectSSMMaintenanceWindow,
+			expression: "rate(12 hours)",
+		},
+		"rate SSM minutes": {
+			dialect:     DialectSSMMaintenanceWindow,
+			expression:  "rate(30 minutes)",
+			expectError: true,
+		},
+		"at": {
+			dialect:    DialectEventBridgeScheduler,
+			expression: "at(2030-01-15T09:30:00)",
+		},
+		"at SSM": {
+			dialect:    DialectSSMMaintenanceWindow,
+			expression: "at(2030-01-15T09:30:00)",
+		},
+		"at EventBridge rule": {
+			dialect:     DialectEventBridgeRule,
+			expression:  "at(2030-01-15T09:30:00)",
+			expectError: true,
+		},
+		"at invalid": {
+			dialect:     DialectEventBridgeScheduler,
+			expression:  "at(2030-01-15 09:30)",
+			expectError: true,
+		},
+		"unknown": {
+			dialect:     DialectEventBridgeScheduler,
+			expression:  "every(5 minutes)",
+			expectError: true,
+		},
+		"unterminated": {
+			dialect:     DialectEventBridgeScheduler,
+			expression:  "rate(5 minutes",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testCase.dialect, testCase.expression)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("Parse(%s, %q) err = %v, want error %t", testCase.dialect, testCase.expression, err, want)
+			}
+		})
+	}
+}
+
+func TestExpressionNextRuns(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.January, 30, 12, 0, 0, 0, time.UTC)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		expression string
+		loc        *time.Location
+		count      int
+		expected   []string
+	}{
+		"daily": {
+			expression: "cron(30 2 * * ? *)",
+			loc:        time.UTC,
+			count:      3,
+			expected:   []string{"2025-01-31T02:30:00Z", "2025-02-01T02:30:00Z", "2025-02-02T02:30:00Z"},
+		},
+		"weekdays": {
+			expression: "cron(0 9 ? * MON-FRI *)",
+			loc:        time.UTC,
+			count:      3,
+			expected:   []string{"2025-01-31T09:00:00Z", "2025-02-03T09:00:00Z", "2025-02-04T09:00:00Z"},
+		},
+		"last day of month": {
+			expression: "cron(0 0 L * ? *)",
+			loc:        time.UTC,
+			count:      2,
+			expected:   []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z"},
+		},
+		"nearest weekday": {
+			// 2025-02-01 is a Saturday, 2025-03-01 is a Saturday.
+			expression: "cron(0 0 1W * ? *)",
+			loc:        time.UTC,
+			count:      2,
+			expected:   []string{"2025-02-03T00:00:00Z", "2025-03-03T00:00:00Z"},
+		},
+		"last Friday": {
+			expression: "cron(0 0 ? * 6L *)",
+			loc:        time.UTC,
+			count:      2,
+			expected:   []string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z"},
+		},
+		"second Tuesday": {
+			expression: "cron(0 0 ? * TUE#2 *)",
+			loc:        time.UTC,
+			count:      2,
+			expected:   []string{"2025-02-11T00:00:00Z", "2025-03-11T00:00:00Z"},
+		},
+		"years": {
+			expression: "cron(0 0 1 1 ? 2027,2029)",
+			loc:        time.UTC,
+			count:      3,
+			expected:   []string{"2027-01-01T00:00:00Z", "2029-01-01T00:00:00Z"},
+		},
+		"time zone": {
+			expression: "cron(0 2 * * ? *)",
+			loc:        newYork,
+			count:      1,
+			expected:   []string{"2025-01-31T02:00:00-05:00"},
+		},
+		"daylight saving time gap": {
+			// 2025-03-09T02:30 doesn't exist in New York.
+			expression: "cron(30 2 9,10 MAR ? 2025)",
+			loc:        newYork,
+			count:      2,
+			expected:   []string{"2025-03-10T02:30:00-04:00"},
+		},
+		"rate": {
+			expression: "rate(6 hours)",
+			loc:        time.UTC,
+			count:      2,
+			expected:   []string{"2025-01-30T18:00:00Z", "2025-01-31T00:00:00Z"},
+		},
+		"at": {
+			expression: "at(2025-02-01T08:00:00)",
+			loc:        newYork,
+			count:      5,
+			expected:   []string{"2025-02-01T08:00:00-05:00"},
+		},
+		"at past": {
+			expression: "at(2024-02-01T08:00:00)",
+			loc:        time.UTC,
+			count:      5,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := Parse(DialectEventBridgeScheduler, testCase.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, v := range e.NextRuns(start, testCase.loc, testCase.count) {
+				got = append(got, v.Format(time.RFC3339))
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("NextRuns(%q) = %v, want %v", testCase.expression, got, testCase.expected)
+			}
+			for i := range got {
+				if got[i] != testCase.expected[i] {
+					t.Errorf("NextRuns(%q) = %v, want %v", testCase.expression, got, testCase.expected)
+					break
+				}
+			}
+		})
+	}
+}
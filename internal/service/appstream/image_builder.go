@@ -233,15 +233,22 @@ func resourceImageBuilderCreate(ctx context.Context, d *schema.ResourceData, met
 		input.VpcConfig = expandImageBuilderVPCConfig(v.([]interface{}))
 	}
 
-	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidRoleException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, aws.ToString(input.IamRoleArn))
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*appstream.CreateImageBuilderOutput, error) {
 		return conn.CreateImageBuilder(ctx, input)
-	}, "encountered an error because your IAM role")
+	}, func(err error) (bool, error) {
+		if errs.IsAErrorMessageContains[*awstypes.InvalidRoleException](err, "encountered an error because your IAM role") {
+			return true, err
+		}
+
+		return false, err
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating AppStream ImageBuilder (%s): %s", name, err)
 	}
 
-	d.SetId(aws.ToString(outputRaw.(*appstream.CreateImageBuilderOutput).ImageBuilder.Name))
+	d.SetId(aws.ToString(output.ImageBuilder.Name))
 
 	if _, err = waitImageBuilderStateRunning(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for AppStream ImageBuilder (%s) create: %s", d.Id(), err)
@@ -27,9 +27,7 @@ const (
 	imageBuilderStateTimeout = 60 * time.Minute
 	// userOperationTimeout Maximum amount of time to wait for User operation eventual consistency
 	userOperationTimeout = 4 * time.Minute
-	// iamPropagationTimeout Maximum amount of time to wait for an iam resource eventual consistency
-	iamPropagationTimeout = 2 * time.Minute
-	userAvailable         = "AVAILABLE"
+	userAvailable        = "AVAILABLE"
 )
 
 // waitFleetStateRunning waits for a fleet running
@@ -298,17 +298,7 @@ func executeAndExpectNoRows(ctx context.Context, conn *athena.Client, qeid strin
 }
 
 func queryExecutionResult(ctx context.Context, conn *athena.Client, qeid string) (*types.ResultSet, error) {
-	executionStateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(types.QueryExecutionStateQueued, types.QueryExecutionStateRunning),
-		Target:     enum.Slice(types.QueryExecutionStateSucceeded),
-		Refresh:    queryExecutionStateRefreshFunc(ctx, conn, qeid),
-		Timeout:    10 * time.Minute,
-		Delay:      3 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-	_, err := executionStateConf.WaitForStateContext(ctx)
-
-	if err != nil {
+	if _, err := waitQueryExecutionSucceeded(ctx, conn, qeid, 10*time.Minute); err != nil {
 		return nil, err
 	}
 
@@ -322,6 +312,25 @@ func queryExecutionResult(ctx context.Context, conn *athena.Client, qeid string)
 	return resp.ResultSet, nil
 }
 
+func waitQueryExecutionSucceeded(ctx context.Context, conn *athena.Client, qeid string, timeout time.Duration) (*types.QueryExecution, error) {
+	executionStateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.QueryExecutionStateQueued, types.QueryExecutionStateRunning),
+		Target:     enum.Slice(types.QueryExecutionStateSucceeded),
+		Refresh:    queryExecutionStateRefreshFunc(ctx, conn, qeid),
+		Timeout:    timeout,
+		Delay:      3 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := executionStateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.GetQueryExecutionOutput); ok {
+		return output.QueryExecution, err
+	}
+
+	return nil, err
+}
+
 func queryExecutionStateRefreshFunc(ctx context.Context, conn *athena.Client, qeid string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &athena.GetQueryExecutionInput{
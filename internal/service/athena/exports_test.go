@@ -10,7 +10,9 @@ var (
 	FindNamedQueryByID                = findNamedQueryByID
 	FindPreparedStatementByTwoPartKey = findPreparedStatementByTwoPartKey
 	FindWorkGroupByName               = findWorkGroupByName
+	FlattenQueryResultRows            = flattenQueryResultRows
 	QueryExecutionResult              = queryExecutionResult
+	QueryResultValue                  = queryResultValue
 
 	ResourceDataCatalog       = resourceDataCatalog
 	ResourceDatabase          = resourceDatabase
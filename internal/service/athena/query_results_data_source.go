@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	queryResultsMaxRowsDefault = 1000
+	// See https://docs.aws.amazon.com/athena/latest/APIReference/API_GetQueryResults.html.
+	getQueryResultsMaxResults = 1000
+)
+
+// @SDKDataSource("aws_athena_query_results", name="Query Results")
+func dataSourceQueryResults() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQueryResultsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDatabase: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"execution_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_rows": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      queryResultsMaxRowsDefault,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output_location": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"rows_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "primary",
+			},
+		},
+	}
+}
+
+func dataSourceQueryResultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AthenaClient(ctx)
+
+	workGroup := d.Get("workgroup").(string)
+	input := &athena.StartQueryExecutionInput{
+		QueryExecutionContext: &types.QueryExecutionContext{},
+		QueryString:           aws.String(d.Get("query").(string)),
+		WorkGroup:             aws.String(workGroup),
+	}
+
+	if v, ok := d.GetOk("catalog"); ok {
+		input.QueryExecutionContext.Catalog = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDatabase); ok {
+		input.QueryExecutionContext.Database = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("execution_parameters"); ok && len(v.([]interface{})) > 0 {
+		input.ExecutionParameters = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("output_location"); ok {
+		input.ResultConfiguration = &types.ResultConfiguration{
+			OutputLocation: aws.String(v.(string)),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Athena Query in WorkGroup (%s): %s", workGroup, err)
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+	queryExecution, err := waitQueryExecutionSucceeded(ctx, conn, queryExecutionID, d.Timeout(schema.TimeoutRead))
+
+	if tfresource.TimedOut(err) {
+		// Don't leave the query running, and incurring charges, after giving up on it.
+		if _, err := conn.StopQueryExecution(ctx, &athena.StopQueryExecutionInput{QueryExecutionId: aws.String(queryExecutionID)}); err != nil {
+			log.Printf("[WARN] Stopping Athena Query Execution (%s): %s", queryExecutionID, err)
+		}
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Athena Query Execution (%s): %s", queryExecutionID, err)
+	}
+
+	maxRows := d.Get("max_rows").(int)
+	columns, rows, err := findQueryResults(ctx, conn, queryExecution, maxRows)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Athena Query Execution (%s) results: %s", queryExecutionID, err)
+	}
+
+	typedRows := flattenQueryResultRows(columns, rows)
+	rowsJSON, err := tfjson.EncodeToString(typedRows)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(queryExecutionID)
+	if err := d.Set("columns", flattenQueryResultColumns(columns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting columns: %s", err)
+	}
+	d.Set("query_execution_id", queryExecutionID)
+	if err := d.Set("rows", flattenQueryResultStringRows(columns, rows)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rows: %s", err)
+	}
+	d.Set("rows_json", strings.TrimSpace(rowsJSON))
+
+	return diags
+}
+
+// findQueryResults returns the columns and rows of a query's results, excluding the header row.
+// It's an error for the results to have more than maxRows rows.
+func findQueryResults(ctx context.Context, conn *athena.Client, queryExecution *types.QueryExecution, maxRows int) ([]types.ColumnInfo, []types.Row, error) {
+	input := &athena.GetQueryResultsInput{
+		MaxResults:       aws.Int32(getQueryResultsMaxResults),
+		QueryExecutionId: queryExecution.QueryExecutionId,
+	}
+	var columns []types.ColumnInfo
+	var rows []types.Row
+
+	pages := athena.NewGetQueryResultsPaginator(conn, input)
+	for first := true; pages.HasMorePages(); first = false {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page.ResultSet == nil {
+			continue
+		}
+
+		pageRows := page.ResultSet.Rows
+
+		if first {
+			if page.ResultSet.ResultSetMetadata != nil {
+				columns = page.ResultSet.ResultSetMetadata.ColumnInfo
+			}
+
+			// The results of SELECT queries start with a row of column names.
+			if queryExecution.StatementType == types.StatementTypeDml && len(pageRows) > 0 && isQueryResultHeaderRow(columns, pageRows[0]) {
+				pageRows = pageRows[1:]
+			}
+		}
+
+		rows = append(rows, pageRows...)
+
+		if len(rows) > maxRows {
+			return nil, nil, fmt.Errorf("query returned more than %d rows; increase max_rows or limit the query", maxRows)
+		}
+	}
+
+	return columns, rows, nil
+}
+
+func isQueryResultHeaderRow(columns []types.ColumnInfo, row types.Row) bool {
+	return slices.EqualFunc(columns, row.Data, func(column types.ColumnInfo, datum types.Datum) bool {
+		return aws.ToString(column.Name) == aws.ToString(datum.VarCharValue)
+	})
+}
+
+func flattenQueryResultColumns(columns []types.ColumnInfo) []interface{} {
+	tfList := make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.ToString(column.Name),
+			names.AttrType: aws.ToString(column.Type),
+		})
+	}
+
+	return tfList
+}
+
+// flattenQueryResultStringRows returns each row as a map of column name to value.
+// NULL values are omitted.
+func flattenQueryResultStringRows(columns []types.ColumnInfo, rows []types.Row) []interface{} {
+	tfList := make([]interface{}, 0, len(rows))
+
+	for _, row := range rows {
+		tfMap := make(map[string]interface{}, len(columns))
+
+		for i, datum := range row.Data {
+			if i >= len(columns) || datum.VarCharValue == nil {
+				continue
+			}
+
+			tfMap[aws.ToString(columns[i].Name)] = aws.ToString(datum.VarCharValue)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenQueryResultRows returns each row as a map of column name to value, typed according to the column's type.
+func flattenQueryResultRows(columns []types.ColumnInfo, rows []types.Row) []map[string]any {
+	apiObjects := make([]map[string]any, 0, len(rows))
+
+	for _, row := range rows {
+		apiObject := make(map[string]any, len(columns))
+
+		for i, column := range columns {
+			var v *string
+			if i < len(row.Data) {
+				v = row.Data[i].VarCharValue
+			}
+
+			apiObject[aws.ToString(column.Name)] = queryResultValue(aws.ToString(column.Type), v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// queryResultValue returns the JSON value of a query result datum of the specified Athena data type.
+// Booleans and finite numbers are converted, other values are strings.
+// See https://docs.aws.amazon.com/athena/latest/ug/data-types.html.
+func queryResultValue(dataType string, v *string) any {
+	if v == nil {
+		return nil
+	}
+
+	switch s := aws.ToString(v); dataType {
+	case "boolean":
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+	case "tinyint", "smallint", "integer", "int", "bigint", "float", "real", "double", "decimal":
+		if _, err := strconv.ParseFloat(s, 64); err == nil && json.Valid([]byte(s)) {
+			return json.Number(s)
+		}
+	}
+
+	return aws.ToString(v)
+}
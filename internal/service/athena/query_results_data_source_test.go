@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestQueryResultValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		dataType string
+		value    *string
+		expected any
+	}{
+		{"varchar", nil, nil},
+		{"varchar", aws.String("abc"), "abc"},
+		{"varchar", aws.String("1"), "1"},
+		{"boolean", aws.String("true"), true},
+		{"boolean", aws.String("false"), false},
+		{"integer", aws.String("-42"), json.Number("-42")},
+		{"bigint", aws.String("9223372036854775807"), json.Number("9223372036854775807")},
+		{"double", aws.String("1.5E-7"), json.Number("1.5E-7")},
+		{"double", aws.String("NaN"), "NaN"},
+		{"double", aws.String("Infinity"), "Infinity"},
+		{"decimal", aws.String("12345678901234567890.123"), json.Number("12345678901234567890.123")},
+		{"date", aws.String("2024-01-02"), "2024-01-02"},
+		{"array", aws.String("[1, 2]"), "[1, 2]"},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfathena.QueryResultValue(testCase.dataType, testCase.value); got != testCase.expected {
+			t.Errorf("QueryResultValue(%q, %v) = %#v, want %#v", testCase.dataType, aws.ToString(testCase.value), got, testCase.expected)
+		}
+	}
+}
+
+func TestFlattenQueryResultRows(t *testing.T) {
+	t.Parallel()
+
+	columns := []types.ColumnInfo{
+		{Name: aws.String("id"), Type: aws.String("integer")},
+		{Name: aws.String("name"), Type: aws.String("varchar")},
+	}
+	rows := []types.Row{
+		{Data: []types.Datum{{VarCharValue: aws.String("1")}, {VarCharValue: aws.String("a")}}},
+		{Data: []types.Datum{{VarCharValue: aws.String("2")}, {}}},
+	}
+
+	output, err := json.Marshal(tfathena.FlattenQueryResultRows(columns, rows))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := string(output), `[{"id":1,"name":"a"},{"id":2,"name":null}]`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestAccAthenaQueryResultsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query_results.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryResultsDataSourceConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "integer"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.name", names.AttrEnabled),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.type", "boolean"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_execution_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.%", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.name", "a"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows_json", `[{"enabled":true,"id":1,"name":"a"},{"enabled":null,"id":2,"name":"b"}]`),
+					resource.TestCheckOutput("names", "a,b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryResultsDataSource_maxRows(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryResultsDataSourceConfig_basic(rName, 1),
+				ExpectError: regexache.MustCompile(`query returned more than 1 rows`),
+			},
+		},
+	})
+}
+
+func testAccQueryResultsDataSourceConfig_basic(rName string, maxRows int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/"
+    }
+  }
+}
+
+data "aws_athena_query_results" "test" {
+  workgroup = aws_athena_workgroup.test.name
+  max_rows  = %[2]d
+
+  query = <<EOT
+SELECT * FROM (VALUES (1, 'a', true), (2, 'b', NULL)) AS t (id, name, enabled)
+EOT
+}
+
+output "names" {
+  value = join(",", [for row in jsondecode(data.aws_athena_query_results.test.rows_json) : row.name])
+}
+`, rName, maxRows)
+}
@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_athena_named_query",
 			Name:     "Named Query",
 		},
+		{
+			Factory:  dataSourceQueryResults,
+			TypeName: "aws_athena_query_results",
+			Name:     "Query Results",
+		},
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/batch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/batch/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -303,7 +304,14 @@ func resourceComputeEnvironmentCreate(ctx context.Context, d *schema.ResourceDat
 		input.State = awstypes.CEState(v.(string))
 	}
 
-	output, err := conn.CreateComputeEnvironment(ctx, input)
+	var instanceRole, spotIAMFleetRole string
+	if v := input.ComputeResources; v != nil {
+		instanceRole, spotIAMFleetRole = aws.ToString(v.InstanceRole), aws.ToString(v.SpotIamFleetRole)
+	}
+	timeout := computeEnvironmentIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), aws.ToString(input.ServiceRole), instanceRole, spotIAMFleetRole)
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*batch.CreateComputeEnvironmentOutput, error) {
+		return conn.CreateComputeEnvironment(ctx, input)
+	}, retryableComputeEnvironmentIAMError)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Batch Compute Environment (%s): %s", computeEnvironmentName, err)
@@ -512,7 +520,14 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 			input.ComputeResources = computeResourceUpdate
 		}
 
-		_, err := conn.UpdateComputeEnvironment(ctx, input)
+		var instanceRole string
+		if v := input.ComputeResources; v != nil {
+			instanceRole = aws.ToString(v.InstanceRole)
+		}
+		timeout := computeEnvironmentIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), aws.ToString(input.ServiceRole), instanceRole, "")
+		_, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*batch.UpdateComputeEnvironmentOutput, error) {
+			return conn.UpdateComputeEnvironment(ctx, input)
+		}, retryableComputeEnvironmentIAMError)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Batch Compute Environment (%s): %s", d.Id(), err)
@@ -526,6 +541,26 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 	return append(diags, resourceComputeEnvironmentRead(ctx, d, meta)...)
 }
 
+// computeEnvironmentIAMPropagationTimeout returns how long to retry IAM eventual consistency errors
+// for the specified service role ARN, instance profile name or ARN and Spot fleet role ARN.
+func computeEnvironmentIAMPropagationTimeout(ctx context.Context, c *conns.AWSClient, serviceRole, instanceRole, spotIAMFleetRole string) time.Duration {
+	if instanceRole != "" && !arn.IsARN(instanceRole) {
+		instanceRole = c.GlobalARN(ctx, "iam", "instance-profile/"+instanceRole)
+	}
+
+	return c.IAMPropagationTimeout(ctx, serviceRole, instanceRole, spotIAMFleetRole)
+}
+
+// retryableComputeEnvironmentIAMError retries errors caused by a recently created or modified role or instance profile.
+func retryableComputeEnvironmentIAMError(err error) (bool, error) {
+	// ClientException: Unable to assume role.
+	if errs.IsAErrorMessageContains[*awstypes.ClientException](err, "assume") || errs.IsAErrorMessageContains[*awstypes.ClientException](err, "AssumeRole") {
+		return true, err
+	}
+
+	return false, err
+}
+
 func resourceComputeEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BatchClient(ctx)
@@ -43,6 +43,70 @@ func findChangeSetByTwoPartKey(ctx context.Context, conn *cloudformation.Client,
 	return output, nil
 }
 
+// stackResourceChange is a change to a resource in a stack or in one of its nested stacks.
+type stackResourceChange struct {
+	awstypes.ResourceChange
+	// nestedStack is the logical ID of the nested stack containing the resource,
+	// with nested stacks in nested stacks separated by "/".
+	nestedStack string
+}
+
+// findResourceChangesByChangeSetID returns the resource changes in a change set, followed by
+// the resource changes in the change sets of any nested stacks.
+func findResourceChangesByChangeSetID(ctx context.Context, conn *cloudformation.Client, changeSetID, nestedStack string) ([]stackResourceChange, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+	}
+	var output []stackResourceChange
+
+	for {
+		page, err := conn.DescribeChangeSet(ctx, input)
+
+		if errs.IsA[*awstypes.ChangeSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Changes {
+			if v.ResourceChange == nil {
+				continue
+			}
+
+			output = append(output, stackResourceChange{
+				ResourceChange: *v.ResourceChange,
+				nestedStack:    nestedStack,
+			})
+
+			if nestedChangeSetID := aws.ToString(v.ResourceChange.ChangeSetId); nestedChangeSetID != "" {
+				path := aws.ToString(v.ResourceChange.LogicalResourceId)
+				if nestedStack != "" {
+					path = nestedStack + "/" + path
+				}
+
+				nestedChanges, err := findResourceChangesByChangeSetID(ctx, conn, nestedChangeSetID, path)
+
+				if err != nil {
+					return nil, err
+				}
+
+				output = append(output, nestedChanges...)
+			}
+		}
+
+		if input.NextToken = page.NextToken; input.NextToken == nil {
+			break
+		}
+	}
+
+	return output, nil
+}
+
 func statusChangeSet(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findChangeSetByTwoPartKey(ctx, conn, stackID, changeSetName)
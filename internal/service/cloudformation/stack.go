@@ -4,10 +4,15 @@
 package cloudformation
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -97,6 +103,47 @@ func resourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"planned_change_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nested_stack": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrScope: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"policy_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -111,6 +158,10 @@ func resourceStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"preview_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
@@ -137,6 +188,7 @@ func resourceStack() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			resourceStackCustomizeDiff,
 		),
 	}
 }
@@ -269,6 +321,9 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
 	}
 	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
+	// The planned changes only describe the next update and are cleared once the stack is read.
+	d.Set("planned_change_set_id", "")
+	d.Set("planned_changes", nil)
 
 	setTagsOut(ctx, stack.Tags)
 
@@ -279,6 +334,10 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
+	if d.Get("preview_changes").(bool) {
+		return append(diags, resourceStackUpdateWithChangeSet(ctx, d, meta)...)
+	}
+
 	requestToken := id.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		ClientRequestToken: aws.String(requestToken),
@@ -345,6 +404,80 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return append(diags, resourceStackRead(ctx, d, meta)...)
 }
 
+// resourceStackUpdateWithChangeSet updates the stack by executing the change set previewed in
+// planned_changes, rather than calling UpdateStack.
+// A change set is only created here if none was created when planning.
+func resourceStackUpdateWithChangeSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	// Change sets don't include the stack policy, which applies to the update once set.
+	if d.HasChanges("policy_body", "policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("policy_body"); ok {
+			policy, err := structure.NormalizeJsonString(v)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			input.StackPolicyBody = aws.String(policy)
+		} else if v, ok := d.GetOk("policy_url"); ok {
+			input.StackPolicyURL = aws.String(v.(string))
+		}
+
+		if input.StackPolicyBody != nil || input.StackPolicyURL != nil {
+			if _, err := conn.SetStackPolicy(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting CloudFormation Stack (%s) policy: %s", d.Id(), err)
+			}
+		}
+	}
+
+	changeSetID := d.Get("planned_change_set_id").(string)
+
+	if changeSetID == "" {
+		tags := getTagsIn(ctx)
+		if tags == nil {
+			tags = []awstypes.Tag{}
+		}
+
+		input, err := expandStackChangeSetInput(d, tags)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		changeSet, err := findOrCreateStackChangeSet(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudFormation Stack (%s) change set: %s", d.Id(), err)
+		}
+
+		if changeSet == nil {
+			return append(diags, resourceStackRead(ctx, d, meta)...)
+		}
+
+		changeSetID = aws.ToString(changeSet.ChangeSetId)
+	}
+
+	requestToken := id.UniqueId()
+	_, err := conn.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		ChangeSetName:      aws.String(changeSetID),
+		ClientRequestToken: aws.String(requestToken),
+	})
+
+	if err != nil {
+		deleteChangeSet(ctx, conn, changeSetID)
+		return sdkdiag.AppendErrorf(diags, "executing CloudFormation Stack (%s) change set (%s): %s", d.Id(), changeSetID, err)
+	}
+
+	if _, err := waitStackUpdated(ctx, conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation Stack (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceStackRead(ctx, d, meta)...)
+}
+
 func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
@@ -694,3 +827,228 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// resourceStackCustomizeDiff previews the changes to an existing stack, when preview_changes
+// is set, by creating a change set.
+// Change sets are named after a hash of their inputs, so the change set created when planning
+// is found again when Terraform re-plans during apply, and that change set is the one executed.
+// Change sets from plans that are never applied are deleted by CloudFormation when
+// another change set for the stack is executed.
+func resourceStackCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !d.Get("preview_changes").(bool) {
+		return clearStackPlannedChanges(d)
+	}
+
+	if !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	for _, key := range []string{"capabilities", names.AttrIAMRoleARN, "notification_arns", names.AttrParameters, names.AttrTagsAll, "template_body", "template_url"} {
+		if !d.NewValueKnown(key) {
+			// The change set is created when Terraform re-plans during apply instead.
+			if err := d.SetNewComputed("planned_change_set_id"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	tags := Tags(tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]interface{})).IgnoreAWS())
+	if tags == nil {
+		tags = []awstypes.Tag{}
+	}
+
+	input, err := expandStackChangeSetInput(d, tags)
+	if err != nil {
+		return err
+	}
+
+	changeSet, err := findOrCreateStackChangeSet(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("creating CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	if changeSet == nil {
+		return clearStackPlannedChanges(d)
+	}
+
+	changeSetID := aws.ToString(changeSet.ChangeSetId)
+	changes, err := findResourceChangesByChangeSetID(ctx, conn, changeSetID, "")
+
+	if err != nil {
+		deleteChangeSet(ctx, conn, changeSetID)
+		return fmt.Errorf("reading CloudFormation Stack (%s) change set (%s): %w", d.Id(), changeSetID, err)
+	}
+
+	if err := d.SetNew("planned_change_set_id", changeSetID); err != nil {
+		return err
+	}
+
+	return d.SetNew("planned_changes", flattenStackResourceChanges(changes))
+}
+
+func clearStackPlannedChanges(d *schema.ResourceDiff) error {
+	if d.Get("planned_change_set_id").(string) != "" {
+		if err := d.SetNew("planned_change_set_id", ""); err != nil {
+			return err
+		}
+	}
+
+	if len(d.Get("planned_changes").([]interface{})) > 0 {
+		return d.SetNew("planned_changes", []interface{}{})
+	}
+
+	return nil
+}
+
+func expandStackChangeSetInput(d sdkv2.ResourceDiffer, tags []awstypes.Tag) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetType:       awstypes.ChangeSetTypeUpdate,
+		IncludeNestedStacks: aws.Bool(true),
+		StackName:           aws.String(d.Id()),
+		Tags:                tags,
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+	}
+	if d.HasChange(names.AttrIAMRoleARN) {
+		input.RoleARN = aws.String(d.Get(names.AttrIAMRoleARN).(string))
+	}
+	if d.HasChange("notification_arns") {
+		input.NotificationARNs = flex.ExpandStringValueSet(d.Get("notification_arns").(*schema.Set))
+	}
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	name, err := stackChangeSetName(input)
+	if err != nil {
+		return nil, err
+	}
+	input.ChangeSetName = aws.String(name)
+
+	return input, nil
+}
+
+// stackChangeSetName returns a change set name derived from a hash of the change set's inputs.
+func stackChangeSetName(input *cloudformation.CreateChangeSetInput) (string, error) {
+	apiObject := *input
+	apiObject.ChangeSetName = nil
+	apiObject.Capabilities = slices.Sorted(slices.Values(apiObject.Capabilities))
+	apiObject.NotificationARNs = slices.Sorted(slices.Values(apiObject.NotificationARNs))
+	apiObject.Parameters = slices.SortedFunc(slices.Values(apiObject.Parameters), func(a, b awstypes.Parameter) int {
+		return cmp.Compare(aws.ToString(a.ParameterKey), aws.ToString(b.ParameterKey))
+	})
+	apiObject.Tags = slices.SortedFunc(slices.Values(apiObject.Tags), func(a, b awstypes.Tag) int {
+		return cmp.Compare(aws.ToString(a.Key), aws.ToString(b.Key))
+	})
+
+	b, err := json.Marshal(apiObject)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(b)
+
+	return "terraform-" + hex.EncodeToString(hash[:]), nil
+}
+
+// findOrCreateStackChangeSet returns the change set with the input's name if it can still be executed,
+// otherwise it creates the change set, replacing any change set with the same name.
+func findOrCreateStackChangeSet(ctx context.Context, conn *cloudformation.Client, input *cloudformation.CreateChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+	changeSet, err := findChangeSetByTwoPartKey(ctx, conn, aws.ToString(input.StackName), aws.ToString(input.ChangeSetName))
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return nil, err
+	case changeSet.Status == awstypes.ChangeSetStatusCreateComplete && changeSet.ExecutionStatus == awstypes.ExecutionStatusAvailable:
+		return changeSet, nil
+	default:
+		deleteChangeSet(ctx, conn, aws.ToString(changeSet.ChangeSetId))
+	}
+
+	return createStackChangeSet(ctx, conn, input)
+}
+
+// createStackChangeSet creates a change set and waits for it to be created.
+// A change set that contains no changes is deleted and nil is returned.
+func createStackChangeSet(ctx context.Context, conn *cloudformation.Client, input *cloudformation.CreateChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateChangeSet(ctx, input)
+	}, errCodeValidationError, "is invalid or cannot be assumed")
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := outputRaw.(*cloudformation.CreateChangeSetOutput)
+	changeSetID := aws.ToString(output.Id)
+	changeSet, err := waitChangeSetCreated(ctx, conn, aws.ToString(output.StackId), changeSetID)
+
+	if changeSet != nil && changeSet.Status == awstypes.ChangeSetStatusFailed && changeSetHasNoChanges(changeSet) {
+		deleteChangeSet(ctx, conn, changeSetID)
+		return nil, nil
+	}
+
+	if err != nil {
+		deleteChangeSet(ctx, conn, changeSetID)
+		return nil, fmt.Errorf("waiting for change set (%s) create: %w", changeSetID, err)
+	}
+
+	return changeSet, nil
+}
+
+func changeSetHasNoChanges(changeSet *cloudformation.DescribeChangeSetOutput) bool {
+	reason := aws.ToString(changeSet.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+func deleteChangeSet(ctx context.Context, conn *cloudformation.Client, changeSetID string) {
+	_, err := conn.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Deleting CloudFormation Change Set (%s): %s", changeSetID, err)
+	}
+}
+
+func flattenStackResourceChanges(apiObjects []stackResourceChange) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAction:       string(apiObject.Action),
+			"logical_resource_id":  aws.ToString(apiObject.LogicalResourceId),
+			"nested_stack":         apiObject.nestedStack,
+			"physical_resource_id": aws.ToString(apiObject.PhysicalResourceId),
+			"replacement":          string(apiObject.Replacement),
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+			names.AttrScope:        flex.FlattenStringyValueList(apiObject.Scope),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
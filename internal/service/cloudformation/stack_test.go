@@ -9,9 +9,11 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -572,6 +574,67 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_previewChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	var changeSetID string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_previewChanges(rName, "10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "planned_change_set_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "preview_changes", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, "12.0.0.0/16"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_change_set_id"), testAccStackChangeSetID(&changeSetID)),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								names.AttrAction:       knownvalue.StringExact("Modify"),
+								"logical_resource_id":  knownvalue.StringExact("MyVPC"),
+								"nested_stack":         knownvalue.StringExact(""),
+								"replacement":          knownvalue.StringExact("True"),
+								names.AttrResourceType: knownvalue.StringExact("AWS::EC2::VPC"),
+							}),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					testAccCheckStackChangeSetExecuted(&stack, &changeSetID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", "12.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "planned_change_set_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				Config:   testAccStackConfig_previewChanges(rName, "12.0.0.0/16"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"on_failure", names.AttrParameters, "planned_change_set_id", "planned_changes", "preview_changes"},
+			},
+		},
+	})
+}
+
 func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -593,6 +656,44 @@ func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) r
 	}
 }
 
+// testAccCheckStackChangeSetExecuted checks that the stack was last updated by the change set with the given ID.
+func testAccCheckStackChangeSetExecuted(v *awstypes.Stack, changeSetID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got, want := aws.ToString(v.ChangeSetId), *changeSetID; got != want {
+			return fmt.Errorf("CloudFormation Stack %s executed change set %q, want %q", aws.ToString(v.StackName), got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccStackChangeSetID returns a known value check for a change set ARN that saves the ARN in v.
+func testAccStackChangeSetID(v *string) knownvalue.Check {
+	return stackChangeSetIDCheck{
+		check: knownvalue.StringRegexp(regexache.MustCompile(`^arn:[^:]+:cloudformation:[^:]+:\d{12}:changeSet/`)),
+		v:     v,
+	}
+}
+
+type stackChangeSetIDCheck struct {
+	check knownvalue.Check
+	v     *string
+}
+
+func (c stackChangeSetIDCheck) CheckValue(other any) error {
+	if err := c.check.CheckValue(other); err != nil {
+		return err
+	}
+
+	*c.v = other.(string)
+
+	return nil
+}
+
+func (c stackChangeSetIDCheck) String() string {
+	return c.check.String()
+}
+
 func testAccCheckStackDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationClient(ctx)
@@ -1210,3 +1311,36 @@ output "stack_output" {
 }
 `, rName, name, value)
 }
+
+func testAccStackConfig_previewChanges(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+
+  preview_changes = true
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"}
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidr)
+}
@@ -3,14 +3,6 @@
 
 package dms
 
-import (
-	"time"
-)
-
-const (
-	propagationTimeout = 2 * time.Minute
-)
-
 const (
 	endpointStatusDeleting = "deleting"
 
@@ -1022,10 +1022,11 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		expandTopLevelConnectionInfo(d, input)
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedFault](ctx, d.Timeout(schema.TimeoutCreate),
-		func() (interface{}, error) {
+	timeout := max(d.Timeout(schema.TimeoutCreate), meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, endpointServiceAccessRoleARNs(d)...))
+	_, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout,
+		func() (*dms.CreateEndpointOutput, error) {
 			return conn.CreateEndpoint(ctx, input)
-		})
+		}, retryableAccessDeniedFault)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating DMS Endpoint (%s): %s", endpointID, err)
@@ -1036,6 +1037,32 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 	return append(diags, resourceEndpointRead(ctx, d, meta)...)
 }
 
+// endpointServiceAccessRoleARNs returns the ARNs of the service access roles configured for the endpoint.
+func endpointServiceAccessRoleARNs(d *schema.ResourceData) []string {
+	var roleARNs []string
+
+	if v, ok := d.GetOk("service_access_role"); ok {
+		roleARNs = append(roleARNs, v.(string))
+	}
+
+	for _, k := range []string{"elasticsearch_settings", "kinesis_settings", "redshift_settings", "s3_settings"} {
+		if v, ok := d.GetOk(k + ".0.service_access_role_arn"); ok {
+			roleARNs = append(roleARNs, v.(string))
+		}
+	}
+
+	return roleARNs
+}
+
+// retryableAccessDeniedFault retries errors caused by a recently created or modified service role.
+func retryableAccessDeniedFault(err error) (bool, error) {
+	if errs.IsA[*awstypes.AccessDeniedFault](err) {
+		return true, err
+	}
+
+	return false, err
+}
+
 func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSClient(ctx)
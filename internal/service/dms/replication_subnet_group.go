@@ -84,9 +84,11 @@ func resourceReplicationSubnetGroupCreate(ctx context.Context, d *schema.Resourc
 		Tags:                              getTagsIn(ctx),
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedFault](ctx, propagationTimeout, func() (interface{}, error) {
+	// The "dms-vpc-role" service role is required to create a replication subnet group.
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, meta.(*conns.AWSClient).GlobalARN(ctx, "iam", "role/dms-vpc-role"))
+	_, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*dms.CreateReplicationSubnetGroupOutput, error) {
 		return conn.CreateReplicationSubnetGroup(ctx, input)
-	})
+	}, retryableAccessDeniedFault)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating DMS Replication Subnet Group (%s): %s", replicationSubnetGroupID, err)
 	}
@@ -353,16 +353,17 @@ func resourceS3EndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	input.ExtraConnectionAttributes = extraConnectionAnomalies(d)
 
-	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedFault](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	timeout := max(d.Timeout(schema.TimeoutCreate), meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, aws.ToString(input.ServiceAccessRoleArn)))
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*dms.CreateEndpointOutput, error) {
 		return conn.CreateEndpoint(ctx, input)
-	})
+	}, retryableAccessDeniedFault)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating DMS S3 Endpoint (%s): %s", endpointID, err)
 	}
 
 	d.SetId(endpointID)
-	d.Set("endpoint_arn", outputRaw.(*dms.CreateEndpointOutput).Endpoint.EndpointArn)
+	d.Set("endpoint_arn", output.Endpoint.EndpointArn)
 
 	// AWS bug? ssekki is ignored on create but sets on update
 	if _, ok := d.GetOk("server_side_encryption_kms_key_id"); ok {
@@ -495,9 +496,10 @@ func resourceS3EndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.ExtraConnectionAttributes = extraConnectionAnomalies(d)
 		}
 
-		_, err := tfresource.RetryWhenIsA[*awstypes.AccessDeniedFault](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		timeout := max(d.Timeout(schema.TimeoutUpdate), meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, d.Get("service_access_role_arn").(string)))
+		_, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*dms.ModifyEndpointOutput, error) {
 			return conn.ModifyEndpoint(ctx, input)
-		})
+		}, retryableAccessDeniedFault)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating DMS S3 Endpoint (%s): %s", d.Id(), err)
@@ -11,6 +11,7 @@ var (
 	ResourceTable                       = resourceTable
 	ResourceTableExport                 = resourceTableExport
 	ResourceTableItem                   = resourceTableItem
+	ResourceTableItems                  = newTableItemsResource
 	ResourceTableReplica                = resourceTableReplica
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
@@ -28,6 +29,8 @@ var (
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
+	ReadTableItemsCSV                            = readTableItemsCSV
+	TableItemKeyAndHash                          = tableItemKeyAndHash
 	ListTags                                     = listTags
 	RegionFromARN                                = regionFromARN
 	ReplicaForRegion                             = replicaForRegion
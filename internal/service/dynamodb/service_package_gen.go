@@ -23,6 +23,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_dynamodb_resource_policy",
 			Name:     "Resource Policy",
 		},
+		{
+			Factory:  newTableItemsResource,
+			TypeName: "aws_dynamodb_table_items",
+			Name:     "Table Items",
+		},
 	}
 }
 
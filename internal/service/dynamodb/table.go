@@ -326,6 +326,18 @@ func resourceTable() *schema.Resource {
 				ConflictsWith: []string{"restore_source_name", "restore_source_table_arn"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"error_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"import_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"imported_item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"input_compression_type": {
 							Type:             schema.TypeString,
 							Optional:         true,
@@ -363,6 +375,10 @@ func resourceTable() *schema.Resource {
 								},
 							},
 						},
+						"processed_item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"s3_bucket_source": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -543,6 +559,7 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	sourceName, nameOk := d.GetOk("restore_source_name")
 	sourceArn, arnOk := d.GetOk("restore_source_table_arn")
+	imported := false
 
 	if nameOk || arnOk {
 		input := &dynamodb.RestoreTableToPointInTimeInput{
@@ -623,6 +640,7 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, tableName, err)
 		}
 	} else if vit, ok := d.GetOk("import_table"); ok && len(vit.([]interface{})) > 0 && vit.([]interface{})[0] != nil {
+		imported = true
 		input := expandImportTable(vit.([]interface{})[0].(map[string]interface{}))
 
 		tcp := &awstypes.TableCreationParameters{
@@ -691,10 +709,26 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, tableName, err)
 		}
 
-		importARN := importTableOutput.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn
-		if _, err := waitImportComplete(ctx, conn, aws.ToString(importARN), d.Timeout(schema.TimeoutCreate)); err != nil {
+		importARN := aws.ToString(importTableOutput.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn)
+		importOutput, err := waitImportComplete(ctx, conn, importARN, d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
 			d.SetId(tableName)
-			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, tableName, err)
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, tableName, fmt.Errorf("waiting for import (%s): %w", importARN, err))
+		}
+
+		// Read doesn't refresh import_table, so the import's results are only set here.
+		tfMap := vit.([]interface{})[0].(map[string]interface{})
+		tfMap["error_count"] = importOutput.ErrorCount
+		tfMap["import_arn"] = importARN
+		tfMap["imported_item_count"] = importOutput.ImportedItemCount
+		tfMap["processed_item_count"] = importOutput.ProcessedItemCount
+		if err := d.Set("import_table", []interface{}{tfMap}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting import_table: %s", err)
+		}
+
+		if importOutput.ErrorCount > 0 {
+			diags = sdkdiag.AppendWarningf(diags, "DynamoDB Table (%s) import (%s): %d items could not be imported. See the import's CloudWatch Logs log group (%s) for details.", tableName, importARN, importOutput.ErrorCount, aws.ToString(importOutput.CloudWatchLogGroupArn))
 		}
 	} else {
 		input := &dynamodb.CreateTableInput{
@@ -791,6 +825,12 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTable, d.Id(), err)
 	}
 
+	if imported {
+		if err := updateImportedTable(ctx, conn, d, aws.ToString(output.TableArn), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), fmt.Errorf("updating imported table: %w", err))
+		}
+	}
+
 	if v, ok := d.GetOk("global_secondary_index"); ok {
 		gsiSet := v.(*schema.Set)
 
@@ -1340,6 +1380,63 @@ func cycleStreamEnabled(ctx context.Context, conn *dynamodb.Client, id string, s
 	return nil
 }
 
+// updateImportedTable applies the tags and settings that ImportTable doesn't accept to a table created by an import.
+func updateImportedTable(ctx context.Context, conn *dynamodb.Client, d *schema.ResourceData, arn string, timeout time.Duration) error {
+	if tags := getTagsIn(ctx); len(tags) > 0 {
+		if err := createTags(ctx, conn, arn, tags); err != nil {
+			return fmt.Errorf("setting tags: %w", err)
+		}
+	}
+
+	// Table Class cannot be changed concurrently with other values
+	if v := awstypes.TableClass(d.Get("table_class").(string)); v != "" && v != awstypes.TableClassStandard {
+		_, err := conn.UpdateTable(ctx, &dynamodb.UpdateTableInput{
+			TableClass: v,
+			TableName:  aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("setting table class: %w", err)
+		}
+
+		if _, err := waitTableActive(ctx, conn, d.Id(), timeout); err != nil {
+			return fmt.Errorf("setting table class: waiting for completion: %w", err)
+		}
+	}
+
+	hasTableUpdate := false
+	input := &dynamodb.UpdateTableInput{
+		TableName: aws.String(d.Id()),
+	}
+
+	if v := d.Get("deletion_protection_enabled").(bool); v {
+		hasTableUpdate = true
+		input.DeletionProtectionEnabled = aws.Bool(v)
+	}
+
+	if v := d.Get("stream_enabled").(bool); v {
+		hasTableUpdate = true
+		input.StreamSpecification = &awstypes.StreamSpecification{
+			StreamEnabled:  aws.Bool(v),
+			StreamViewType: awstypes.StreamViewType(d.Get("stream_view_type").(string)),
+		}
+	}
+
+	if !hasTableUpdate {
+		return nil
+	}
+
+	if _, err := conn.UpdateTable(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitTableActive(ctx, conn, d.Id(), timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []interface{}, create bool, timeout time.Duration) error {
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -0,0 +1,733 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	tableItemsSourceFormatCSV  = "csv"
+	tableItemsSourceFormatJSON = "json"
+
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxRequests = 25
+
+	// Unprocessed keys and items are retried with exponential backoff, as recommended.
+	batchRetryMinDelay = 50 * time.Millisecond
+	batchRetryMaxDelay = 5 * time.Second
+	// batchMaxAttempts is the number of requests made for each batch before giving up on its unprocessed keys or items,
+	// e.g. when the table's throughput is exhausted. Retries take about a minute in total.
+	batchMaxAttempts = 20
+)
+
+// @FrameworkResource("aws_dynamodb_table_items", name="Table Items")
+func newTableItemsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableItemsResource{}
+
+	return r, nil
+}
+
+type tableItemsResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*tableItemsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_dynamodb_table_items"
+}
+
+func (r *tableItemsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hash_key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_hashes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"items": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"range_key": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Optional: true,
+			},
+			"source_format": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tableItemsSourceFormatCSV, tableItemsSourceFormatJSON),
+				},
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *tableItemsResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("items"),
+			path.MatchRoot(names.AttrSource),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("items"),
+			path.MatchRoot("source_format"),
+		),
+	}
+}
+
+func (r *tableItemsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.write(ctx, &data, nil)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableItemsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	hashes := fwflex.ExpandFrameworkStringValueMap(ctx, data.ItemHashes)
+	keys, err := expandTableItemsKeys(slices.Collect(maps.Keys(hashes)))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	items, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	// Items deleted or modified outside Terraform are written again.
+	remote, err := tableItemsHashes(items, data.HashKey.ValueString(), data.RangeKey.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	data.ItemHashes = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, remote)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableItemsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.write(ctx, &new, fwflex.ExpandFrameworkStringValueMap(ctx, old.ItemHashes))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableItemsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	keys, err := expandTableItemsKeys(slices.Collect(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.ItemHashes))))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+
+	requests := tableItemsDeleteRequests(keys)
+
+	err = batchWriteTableItems(ctx, conn, tableName, requests)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return
+	}
+}
+
+func (r *tableItemsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.HashKey.IsUnknown() || plan.RangeKey.IsUnknown() || plan.Items.IsUnknown() || plan.Source.IsUnknown() || plan.SourceFormat.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("item_hashes"), types.MapUnknown(types.StringType))...)
+
+		return
+	}
+
+	items, diags := plan.items(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, err := tableItemsHashes(items, plan.HashKey.ValueString(), plan.RangeKey.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("Invalid Table Items", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("item_hashes"), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes))...)
+}
+
+// write puts the items that are new or have changed since oldHashes and deletes the items that have been removed.
+func (r *tableItemsResource) write(ctx context.Context, data *tableItemsResourceModel, oldHashes map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	hashKey, rangeKey := data.HashKey.ValueString(), data.RangeKey.ValueString()
+
+	items, d := data.items(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	newHashes := fwflex.ExpandFrameworkStringValueMap(ctx, data.ItemHashes)
+
+	var requests []awstypes.WriteRequest
+	for _, item := range items {
+		key, hash, err := tableItemKeyAndHash(item, hashKey, rangeKey)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("writing DynamoDB Table (%s) Items", tableName), err.Error())
+
+			return diags
+		}
+
+		if v := newHashes[key]; v != hash {
+			diags.AddError(fmt.Sprintf("writing DynamoDB Table (%s) Items", tableName), fmt.Sprintf("item (%s) changed after plan", key))
+
+			return diags
+		}
+
+		if oldHashes[key] != hash {
+			requests = append(requests, awstypes.WriteRequest{PutRequest: &awstypes.PutRequest{Item: item}})
+		}
+	}
+
+	var removed []string
+	for key := range oldHashes {
+		if _, ok := newHashes[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	slices.Sort(removed)
+
+	keys, err := expandTableItemsKeys(removed)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("writing DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return diags
+	}
+
+	requests = append(requests, tableItemsDeleteRequests(keys)...)
+
+	tflog.Info(ctx, "Writing DynamoDB Table Items", map[string]any{
+		"puts":              len(requests) - len(keys),
+		"deletes":           len(keys),
+		names.AttrTableName: tableName,
+	})
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests); err != nil {
+		diags.AddError(fmt.Sprintf("writing DynamoDB Table (%s) Items", tableName), err.Error())
+
+		return diags
+	}
+
+	return diags
+}
+
+func tableItemsDeleteRequests(keys []map[string]awstypes.AttributeValue) []awstypes.WriteRequest {
+	requests := make([]awstypes.WriteRequest, 0, len(keys))
+	for _, key := range keys {
+		requests = append(requests, awstypes.WriteRequest{DeleteRequest: &awstypes.DeleteRequest{Key: key}})
+	}
+
+	return requests
+}
+
+// batchWriteTableItems writes items in batches, retrying unprocessed items up to batchMaxAttempts times per batch.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest) error {
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxRequests) {
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]awstypes.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		for attempt, delay := 1, batchRetryMinDelay; ; attempt, delay = attempt+1, min(2*delay, batchRetryMaxDelay) {
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			n := len(output.UnprocessedItems[tableName])
+			if n == 0 {
+				break
+			}
+
+			if attempt == batchMaxAttempts {
+				return fmt.Errorf("%d items still unprocessed after %d attempts", n, attempt)
+			}
+
+			input.RequestItems = output.UnprocessedItems
+
+			if err := sleepWithContext(ctx, delay); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys returns the items with the specified keys that exist, retrying unprocessed keys up to batchMaxAttempts times per batch.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]awstypes.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		for attempt, delay := 1, batchRetryMinDelay; ; attempt, delay = attempt+1, min(2*delay, batchRetryMaxDelay) {
+			output, err := conn.BatchGetItem(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			n := len(output.UnprocessedKeys[tableName].Keys)
+			if n == 0 {
+				break
+			}
+
+			if attempt == batchMaxAttempts {
+				return nil, fmt.Errorf("%d keys still unprocessed after %d attempts", n, attempt)
+			}
+
+			input.RequestItems = output.UnprocessedKeys
+
+			if err := sleepWithContext(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type tableItemsResourceModel struct {
+	HashKey      types.String `tfsdk:"hash_key"`
+	ItemHashes   types.Map    `tfsdk:"item_hashes"`
+	Items        types.List   `tfsdk:"items"`
+	RangeKey     types.String `tfsdk:"range_key"`
+	Source       types.String `tfsdk:"source"`
+	SourceFormat types.String `tfsdk:"source_format"`
+	TableName    types.String `tfsdk:"table_name"`
+}
+
+// items returns the configured items, from either items or source.
+func (data *tableItemsResourceModel) items(ctx context.Context) ([]map[string]awstypes.AttributeValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.Source.IsNull() {
+		source := data.Source.ValueString()
+		items, err := readTableItemsSource(source, data.SourceFormat.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(path.Root(names.AttrSource), "Invalid Table Items Source", err.Error())
+
+			return nil, diags
+		}
+
+		return items, diags
+	}
+
+	var items []map[string]awstypes.AttributeValue
+	for i, v := range fwflex.ExpandFrameworkStringValueList(ctx, data.Items) {
+		item, err := expandTableItemAttributes(v)
+
+		if err != nil {
+			diags.AddAttributeError(path.Root("items").AtListIndex(i), "Invalid Table Item", err.Error())
+
+			return nil, diags
+		}
+
+		items = append(items, item)
+	}
+
+	return items, diags
+}
+
+// readTableItemsSource reads items from a file.
+// If format is empty, it's determined by the file's extension.
+func readTableItemsSource(name, format string) ([]map[string]awstypes.AttributeValue, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if format == "" {
+		format = tableItemsSourceFormatJSON
+		if strings.EqualFold(filepath.Ext(name), ".csv") {
+			format = tableItemsSourceFormatCSV
+		}
+	}
+
+	switch format {
+	case tableItemsSourceFormatCSV:
+		return readTableItemsCSV(f)
+	default:
+		return readTableItemsJSON(f)
+	}
+}
+
+// readTableItemsJSON reads a JSON array of items in DynamoDB JSON format, as accepted by aws_dynamodb_table_item.
+func readTableItemsJSON(r io.Reader) ([]map[string]awstypes.AttributeValue, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	items := make([]map[string]awstypes.AttributeValue, 0, len(raw))
+	for i, v := range raw {
+		item, err := expandTableItemAttributes(string(v))
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// readTableItemsCSV reads items from CSV with a header row of attribute names.
+// An attribute name may be followed by a colon and its data type, one of S (the default), N or BOOL.
+// Empty values are omitted from the item.
+func readTableItemsCSV(r io.Reader) ([]map[string]awstypes.AttributeValue, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("decoding CSV: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("decoding CSV: missing header row")
+	}
+
+	type column struct {
+		name     string
+		dataType string
+	}
+	var columns []column
+	for _, v := range records[0] {
+		name, dataType, _ := strings.Cut(v, ":")
+		if dataType == "" {
+			dataType = dataTypeDescriptorString
+		}
+		switch dataType {
+		case dataTypeDescriptorBoolean, dataTypeDescriptorNumber, dataTypeDescriptorString:
+		default:
+			return nil, fmt.Errorf("column %q: unsupported data type: %s", name, dataType)
+		}
+		columns = append(columns, column{name: name, dataType: dataType})
+	}
+
+	items := make([]map[string]awstypes.AttributeValue, 0, len(records)-1)
+	for i, record := range records[1:] {
+		item := make(map[string]awstypes.AttributeValue)
+
+		for j, v := range record {
+			if v == "" {
+				continue
+			}
+
+			switch column := columns[j]; column.dataType {
+			case dataTypeDescriptorBoolean:
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf("line %d, column %q: %w", i+2, column.name, err)
+				}
+				item[column.name] = &awstypes.AttributeValueMemberBOOL{Value: b}
+			case dataTypeDescriptorNumber:
+				item[column.name] = &awstypes.AttributeValueMemberN{Value: v}
+			default:
+				item[column.name] = &awstypes.AttributeValueMemberS{Value: v}
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// tableItemsHashes returns the hashes of items, keyed by primary key.
+func tableItemsHashes(items []map[string]awstypes.AttributeValue, hashKey, rangeKey string) (map[string]string, error) {
+	hashes := make(map[string]string, len(items))
+
+	for _, item := range items {
+		key, hash, err := tableItemKeyAndHash(item, hashKey, rangeKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := hashes[key]; ok {
+			return nil, fmt.Errorf("duplicate item: %s", key)
+		}
+
+		hashes[key] = hash
+	}
+
+	return hashes, nil
+}
+
+// tableItemKeyAndHash returns an item's primary key, in DynamoDB JSON format, and the SHA-256 hash of the item.
+// The hash doesn't depend on the order of set elements or the formatting of numbers, which DynamoDB doesn't preserve.
+func tableItemKeyAndHash(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, string, error) {
+	for _, name := range []string{hashKey, rangeKey} {
+		if name == "" {
+			continue
+		}
+
+		switch v := item[name].(type) {
+		case *awstypes.AttributeValueMemberB, *awstypes.AttributeValueMemberN, *awstypes.AttributeValueMemberS:
+		case nil:
+			return "", "", fmt.Errorf("item is missing key attribute %q", name)
+		default:
+			return "", "", fmt.Errorf("key attribute %q has unsupported type: %T", name, v)
+		}
+	}
+
+	key, err := flattenTableItemAttributes(normalizeTableItemAttributes(expandTableItemQueryKey(item, hashKey, rangeKey)))
+	if err != nil {
+		return "", "", err
+	}
+
+	v, err := flattenTableItemAttributes(normalizeTableItemAttributes(item))
+	if err != nil {
+		return "", "", err
+	}
+
+	sum := sha256.Sum256([]byte(v))
+
+	return strings.TrimSpace(key), hex.EncodeToString(sum[:]), nil
+}
+
+// expandTableItemsKeys returns the primary keys of items from their DynamoDB JSON format.
+func expandTableItemsKeys(keys []string) ([]map[string]awstypes.AttributeValue, error) {
+	apiObjects := make([]map[string]awstypes.AttributeValue, 0, len(keys))
+
+	for _, v := range keys {
+		apiObject, err := expandTableItemAttributes(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item key (%s): %w", v, err)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// normalizeTableItemAttributes returns a copy of attributes with sorted set elements and numbers in canonical form.
+func normalizeTableItemAttributes(attributes map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+	normalized := make(map[string]awstypes.AttributeValue, len(attributes))
+
+	for k, v := range attributes {
+		normalized[k] = normalizeTableItemAttribute(v)
+	}
+
+	return normalized
+}
+
+func normalizeTableItemAttribute(a awstypes.AttributeValue) awstypes.AttributeValue {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberBS:
+		v := slices.Clone(a.Value)
+		slices.SortFunc(v, func(x, y []byte) int {
+			return strings.Compare(itypes.Base64Encode(x), itypes.Base64Encode(y))
+		})
+		return &awstypes.AttributeValueMemberBS{Value: v}
+	case *awstypes.AttributeValueMemberL:
+		v := make([]awstypes.AttributeValue, 0, len(a.Value))
+		for _, e := range a.Value {
+			v = append(v, normalizeTableItemAttribute(e))
+		}
+		return &awstypes.AttributeValueMemberL{Value: v}
+	case *awstypes.AttributeValueMemberM:
+		return &awstypes.AttributeValueMemberM{Value: normalizeTableItemAttributes(a.Value)}
+	case *awstypes.AttributeValueMemberN:
+		return &awstypes.AttributeValueMemberN{Value: normalizeNumber(a.Value)}
+	case *awstypes.AttributeValueMemberNS:
+		v := make([]string, 0, len(a.Value))
+		for _, e := range a.Value {
+			v = append(v, normalizeNumber(e))
+		}
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberNS{Value: v}
+	case *awstypes.AttributeValueMemberSS:
+		v := slices.Clone(a.Value)
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberSS{Value: v}
+	default:
+		return a
+	}
+}
+
+// normalizeNumber returns a number in the canonical form DynamoDB returns it in, without an exponent or insignificant zeros.
+// Invalid numbers are returned unchanged.
+func normalizeNumber(s string) string {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return s
+	}
+
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	// DynamoDB numbers have up to 38 significant digits and magnitudes down to 1e-130.
+	for prec := 1; prec <= 130; prec++ {
+		v := r.FloatString(prec)
+		if w, _ := new(big.Rat).SetString(v); w.Cmp(r) == 0 {
+			return v
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTableItemKeyAndHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		item1, item2 string
+		hashKey      string
+		rangeKey     string
+		expectedKey  string
+		expectEqual  bool
+	}{
+		"hash key": {
+			item1:       `{"pk": {"S": "a"}, "n": {"N": "1"}}`,
+			item2:       `{"n": {"N": "1"}, "pk": {"S": "a"}}`,
+			hashKey:     "pk",
+			expectedKey: `{"pk":{"S":"a"}}`,
+			expectEqual: true,
+		},
+		"range key": {
+			item1:       `{"pk": {"S": "a"}, "sk": {"N": "10"}}`,
+			item2:       `{"pk": {"S": "a"}, "sk": {"N": "1e1"}}`,
+			hashKey:     "pk",
+			rangeKey:    "sk",
+			expectedKey: `{"pk":{"S":"a"},"sk":{"N":"10"}}`,
+			expectEqual: true,
+		},
+		"number formatting": {
+			item1:       `{"pk": {"S": "a"}, "n": {"N": "1.50"}, "ns": {"NS": ["100", "0.25"]}}`,
+			item2:       `{"pk": {"S": "a"}, "n": {"N": "1.5"}, "ns": {"NS": [".25", "1E2"]}}`,
+			hashKey:     "pk",
+			expectedKey: `{"pk":{"S":"a"}}`,
+			expectEqual: true,
+		},
+		"set order": {
+			item1:       `{"pk": {"S": "a"}, "m": {"M": {"ss": {"SS": ["x", "y"]}}}}`,
+			item2:       `{"pk": {"S": "a"}, "m": {"M": {"ss": {"SS": ["y", "x"]}}}}`,
+			hashKey:     "pk",
+			expectedKey: `{"pk":{"S":"a"}}`,
+			expectEqual: true,
+		},
+		"list order": {
+			item1:       `{"pk": {"S": "a"}, "l": {"L": [{"S": "x"}, {"S": "y"}]}}`,
+			item2:       `{"pk": {"S": "a"}, "l": {"L": [{"S": "y"}, {"S": "x"}]}}`,
+			hashKey:     "pk",
+			expectedKey: `{"pk":{"S":"a"}}`,
+		},
+		"changed value": {
+			item1:       `{"pk": {"S": "a"}, "s": {"S": "x"}}`,
+			item2:       `{"pk": {"S": "a"}, "s": {"S": "y"}}`,
+			hashKey:     "pk",
+			expectedKey: `{"pk":{"S":"a"}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key1, hash1 := testTableItemKeyAndHash(t, testCase.item1, testCase.hashKey, testCase.rangeKey)
+			key2, hash2 := testTableItemKeyAndHash(t, testCase.item2, testCase.hashKey, testCase.rangeKey)
+
+			if key1 != testCase.expectedKey || key2 != testCase.expectedKey {
+				t.Errorf("keys: got %s and %s, want %s", key1, key2, testCase.expectedKey)
+			}
+
+			if got := hash1 == hash2; got != testCase.expectEqual {
+				t.Errorf("hashes equal: got %t, want %t", got, testCase.expectEqual)
+			}
+		})
+	}
+}
+
+func TestTableItemKeyAndHash_invalidKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"missing hash key":  `{"sk": {"S": "a"}}`,
+		"missing range key": `{"pk": {"S": "a"}}`,
+		"unsupported type":  `{"pk": {"BOOL": true}, "sk": {"S": "a"}}`,
+	}
+
+	for name, item := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes, err := tfdynamodb.ExpandTableItemAttributes(item)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, _, err := tfdynamodb.TableItemKeyAndHash(attributes, "pk", "sk"); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestReadTableItemsCSV(t *testing.T) {
+	t.Parallel()
+
+	input := `pk,count:N,active:BOOL,note
+a,1,true,"hello, world"
+b,2,false,
+`
+
+	items, err := tfdynamodb.ReadTableItemsCSV(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, item := range items {
+		v, err := tfdynamodb.FlattenTableItemAttributes(item)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got = append(got, strings.TrimSpace(v))
+	}
+
+	want := []string{
+		`{"active":{"BOOL":true},"count":{"N":"1"},"note":{"S":"hello, world"},"pk":{"S":"a"}}`,
+		`{"active":{"BOOL":false},"count":{"N":"2"},"pk":{"S":"b"}}`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d items, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d: got %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestReadTableItemsCSV_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"empty":                 "",
+		"unsupported data type": "pk,tags:SS\na,x\n",
+		"invalid boolean":       "pk,active:BOOL\na,yes\n",
+		"wrong number of cells": "pk,note\na\n",
+	}
+
+	for name, input := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := tfdynamodb.ReadTableItemsCSV(strings.NewReader(input)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestTableItems_fake(t *testing.T) {
+	resourceName := "aws_dynamodb_table_items.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// The fake processes only part of each batch, so writes and reads are retried.
+	fake.Test(t, fake.NewDynamoDB(), []resource.TestStep{
+		{
+			Config: testAccTableItemsConfig_count(rName, 30, "v1"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "30"),
+				resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"pk":{"S":"item-29"}}`),
+			),
+		},
+		{
+			Config: testAccTableItemsConfig_count(rName, 20, "v2"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "20"),
+				resource.TestCheckNoResourceAttr(resourceName, `item_hashes.{"pk":{"S":"item-29"}}`),
+			),
+		},
+		{
+			Config:   testAccTableItemsConfig_count(rName, 20, "v2"),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_count(rName, 60, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 60),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "60"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_count(rName, 40, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 40),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "40"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_sourceCSV(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := filepath.Join(t.TempDir(), "items.csv")
+	testAccTableItemsWriteSource(t, source, "pk,sk:N,note\na,1,first\na,2,second\nb,1,third\n")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_source(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"pk":{"S":"a"},"sk":{"N":"2"}}`),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccTableItemsWriteSource(t, source, "pk,sk:N,note\na,1,first\nb,1,updated\nc,1,fourth\n")
+				},
+				Config: testAccTableItemsConfig_source(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, `item_hashes.{"pk":{"S":"a"},"sk":{"N":"2"}}`),
+					resource.TestCheckResourceAttrSet(resourceName, `item_hashes.{"pk":{"S":"c"},"sk":{"N":"1"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_outOfBandUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_count(rName, 5, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 5),
+					testAccCheckTableItemsDeleteItem(ctx, rName, "item-0"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_count(rName, 5, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 5),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			for k := range rs.Primary.Attributes {
+				v, ok := strings.CutPrefix(k, "item_hashes.")
+				if !ok || v == "%" {
+					continue
+				}
+
+				key, err := tfdynamodb.ExpandTableItemAttributes(v)
+				if err != nil {
+					return err
+				}
+
+				_, err = tfdynamodb.FindTableItemByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrTableName], key)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DynamoDB Table Item %s still exists.", v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsDeleteItem(ctx context.Context, tableName, pk string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		_, err := conn.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			Key: map[string]awstypes.AttributeValue{
+				"pk": &awstypes.AttributeValueMemberS{Value: pk},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testTableItemKeyAndHash(t *testing.T, item, hashKey, rangeKey string) (string, string) {
+	t.Helper()
+
+	attributes, err := tfdynamodb.ExpandTableItemAttributes(item)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	key, hash, err := tfdynamodb.TableItemKeyAndHash(attributes, hashKey, rangeKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return key, hash
+}
+
+func testAccTableItemsWriteSource(t *testing.T, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccTableItemsConfig_count(rName string, n int, version string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [for i in range(%[2]d) : jsonencode({
+    pk      = { S = "item-${i}" }
+    index   = { N = tostring(i) }
+    version = { S = %[3]q }
+  })]
+}
+`, rName, n, version)
+}
+
+func testAccTableItemsConfig_source(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+  source     = %[2]q
+}
+`, rName, source)
+}
@@ -2965,6 +2965,11 @@ func TestAccDynamoDBTable_importTable(t *testing.T) {
 						names.AttrName: rName,
 						names.AttrType: "S",
 					}),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.error_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "import_table.0.import_arn"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.imported_item_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.processed_item_count", "1"),
 					resource.TestCheckResourceAttr(resourceName, "table_class", "STANDARD"),
 				),
 			},
@@ -2972,6 +2977,45 @@ func TestAccDynamoDBTable_importTable(t *testing.T) {
 	})
 }
 
+// lintignore:AT002
+func TestAccDynamoDBTable_importTableSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_importSettings(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.error_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.imported_item_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.processed_item_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "stream_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "stream_view_type", "KEYS_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "table_class", "STANDARD_INFREQUENT_ACCESS"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config:   testAccTableConfig_importSettings(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
@@ -4901,6 +4945,51 @@ resource "aws_dynamodb_table" "test" {
 `, rName)
 }
 
+func testAccTableConfig_importSettings(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+# The second item is missing the hash key and fails to import.
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/somedoc.json"
+  content = <<EOT
+{"Item":{"%[1]s":{"S":"test"},"field":{"S":"test"}}}
+{"Item":{"field":{"S":"test"}}}
+EOT
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  billing_mode     = "PAY_PER_REQUEST"
+  hash_key         = %[1]q
+  stream_enabled   = true
+  stream_view_type = "KEYS_ONLY"
+  table_class      = "STANDARD_INFREQUENT_ACCESS"
+
+  attribute {
+    name = %[1]q
+    type = "S"
+  }
+
+  import_table {
+    input_format = "DYNAMODB_JSON"
+
+    s3_bucket_source {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = aws_s3_object.test.key
+    }
+  }
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
+
 func testAccTableConfig_restoreCrossRegion(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(2),
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -227,24 +228,37 @@ func resourceEBSSnapshotImportCreate(ctx context.Context, d *schema.ResourceData
 		input.RoleName = aws.String(v.(string))
 	}
 
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
+	// The service role defaults to "vmimport".
+	roleName := "vmimport"
+	if v := aws.ToString(input.RoleName); v != "" {
+		roleName = v
+	}
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, meta.(*conns.AWSClient).GlobalARN(ctx, "iam", "role/"+roleName))
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout,
+		func() (*ec2.ImportSnapshotOutput, error) {
 			return conn.ImportSnapshot(ctx, input)
 		},
-		errCodeInvalidParameter, "provided does not exist or does not have sufficient permissions")
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameter, "provided does not exist or does not have sufficient permissions") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EBS Snapshot Import: %s", err)
 	}
 
-	taskID := aws.ToString(outputRaw.(*ec2.ImportSnapshotOutput).ImportTaskId)
-	output, err := waitEBSSnapshotImportComplete(ctx, conn, taskID, d.Timeout(schema.TimeoutCreate))
+	taskID := aws.ToString(output.ImportTaskId)
+	task, err := waitEBSSnapshotImportComplete(ctx, conn, taskID, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EBS Snapshot Import (%s) create: %s", taskID, err)
 	}
 
-	d.SetId(aws.ToString(output.SnapshotId))
+	d.SetId(aws.ToString(task.SnapshotId))
 
 	if err := createTags(ctx, conn, d.Id(), getTagsIn(ctx)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting EBS Snapshot Import (%s) tags: %s", d.Id(), err)
@@ -1048,9 +1048,11 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.DisableApiStop = instanceOpts.DisableAPIStop
 	}
 
+	timeout := instanceProfileIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), input.IamInstanceProfile)
+
 	log.Printf("[DEBUG] Creating EC2 Instance: %s", d.Id())
-	outputRaw, err := tfresource.RetryWhen(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout,
+		func() (*ec2.RunInstancesOutput, error) {
 			return conn.RunInstances(ctx, input)
 		},
 		func(err error) (bool, error) {
@@ -1073,7 +1075,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "creating EC2 Instance: %s", err)
 	}
 
-	instanceId := outputRaw.(*ec2.RunInstancesOutput).Instances[0].InstanceId
+	instanceId := output.Instances[0].InstanceId
 
 	d.SetId(aws.ToString(instanceId))
 
@@ -1579,7 +1581,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, ok := d.GetOk("iam_instance_profile"); ok {
 			// Does not have an Iam Instance Profile associated with it, need to associate
 			if len(resp.IamInstanceProfileAssociations) == 0 {
-				if err := associateInstanceProfile(ctx, d, conn); err != nil {
+				if err := associateInstanceProfile(ctx, d, meta.(*conns.AWSClient), conn); err != nil {
 					return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 				}
 			} else {
@@ -1601,23 +1603,14 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 						if err := disassociateInstanceProfile(ctx, associationId, conn); err != nil {
 							return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 						}
-						if err := associateInstanceProfile(ctx, d, conn); err != nil {
+						if err := associateInstanceProfile(ctx, d, meta.(*conns.AWSClient), conn); err != nil {
 							return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
 						}
 					} else {
-						err := retry.RetryContext(ctx, iamPropagationTimeout, func() *retry.RetryError {
-							_, err := conn.ReplaceIamInstanceProfileAssociation(ctx, input)
-							if err != nil {
-								if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
-									return retry.RetryableError(err)
-								}
-								return retry.NonRetryableError(err)
-							}
-							return nil
-						})
-						if tfresource.TimedOut(err) {
-							_, err = conn.ReplaceIamInstanceProfileAssociation(ctx, input)
-						}
+						timeout := instanceProfileIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), input.IamInstanceProfile)
+						_, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*ec2.ReplaceIamInstanceProfileAssociationOutput, error) {
+							return conn.ReplaceIamInstanceProfileAssociation(ctx, input)
+						}, retryableInvalidIAMInstanceProfile)
 						if err != nil {
 							return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): replacing instance profile: %s", d.Id(), err)
 						}
@@ -2482,32 +2475,49 @@ func blockDeviceIsRoot(bd awstypes.InstanceBlockDeviceMapping, instance *awstype
 		aws.ToString(bd.DeviceName) == aws.ToString(instance.RootDeviceName)
 }
 
-func associateInstanceProfile(ctx context.Context, d *schema.ResourceData, conn *ec2.Client) error {
+func associateInstanceProfile(ctx context.Context, d *schema.ResourceData, c *conns.AWSClient, conn *ec2.Client) error {
 	input := &ec2.AssociateIamInstanceProfileInput{
 		InstanceId: aws.String(d.Id()),
 		IamInstanceProfile: &awstypes.IamInstanceProfileSpecification{
 			Name: aws.String(d.Get("iam_instance_profile").(string)),
 		},
 	}
-	err := retry.RetryContext(ctx, iamPropagationTimeout, func() *retry.RetryError {
-		_, err := conn.AssociateIamInstanceProfile(ctx, input)
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
-				return retry.RetryableError(err)
-			}
-			return retry.NonRetryableError(err)
-		}
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.AssociateIamInstanceProfile(ctx, input)
-	}
+	timeout := instanceProfileIAMPropagationTimeout(ctx, c, input.IamInstanceProfile)
+	_, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*ec2.AssociateIamInstanceProfileOutput, error) {
+		return conn.AssociateIamInstanceProfile(ctx, input)
+	}, retryableInvalidIAMInstanceProfile)
 	if err != nil {
 		return fmt.Errorf("associating instance profile: %s", err)
 	}
 	return nil
 }
 
+// instanceProfileIAMPropagationTimeout returns how long to retry IAM eventual consistency errors
+// for the specified instance profile, which may be nil if the instance profile is set in a launch template.
+// See conns.AWSClient.IAMPropagationTimeout.
+func instanceProfileIAMPropagationTimeout(ctx context.Context, c *conns.AWSClient, apiObject *awstypes.IamInstanceProfileSpecification) time.Duration {
+	if apiObject != nil {
+		if v := apiObject.Arn; v != nil {
+			return c.IAMPropagationTimeout(ctx, aws.ToString(v))
+		}
+
+		if v := apiObject.Name; v != nil {
+			return c.IAMPropagationTimeout(ctx, c.GlobalARN(ctx, "iam", "instance-profile/"+aws.ToString(v)))
+		}
+	}
+
+	return c.IAMPropagationTimeout(ctx)
+}
+
+// retryableInvalidIAMInstanceProfile retries errors caused by a recently created or modified instance profile.
+func retryableInvalidIAMInstanceProfile(err error) (bool, error) {
+	if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "Invalid IAM Instance Profile") {
+		return true, err
+	}
+
+	return false, err
+}
+
 func disassociateInstanceProfile(ctx context.Context, associationId *string, conn *ec2.Client) error {
 	_, err := conn.DisassociateIamInstanceProfile(ctx, &ec2.DisassociateIamInstanceProfileInput{
 		AssociationId: associationId,
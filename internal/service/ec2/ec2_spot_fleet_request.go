@@ -999,18 +999,25 @@ func resourceSpotFleetRequestCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[DEBUG] Creating EC2 Spot Fleet Request: %s", d.Id())
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, aws.ToString(spotFleetConfig.IamFleetRole))
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout,
+		func() (*ec2.RequestSpotFleetOutput, error) {
 			return conn.RequestSpotFleet(ctx, input)
 		},
-		errCodeInvalidSpotFleetRequestConfig, "SpotFleetRequestConfig.IamFleetRole",
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, errCodeInvalidSpotFleetRequestConfig, "SpotFleetRequestConfig.IamFleetRole") {
+				return true, err
+			}
+
+			return false, err
+		},
 	)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EC2 Spot Fleet Request: %s", err)
 	}
 
-	d.SetId(aws.ToString(outputRaw.(*ec2.RequestSpotFleetOutput).SpotFleetRequestId))
+	d.SetId(aws.ToString(output.SpotFleetRequestId))
 
 	if _, err := waitSpotFleetRequestCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Spot Fleet Request (%s) create: %s", d.Id(), err)
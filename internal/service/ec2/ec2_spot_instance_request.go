@@ -206,8 +206,9 @@ func resourceSpotInstanceRequestCreate(ctx context.Context, d *schema.ResourceDa
 		input.LaunchSpecification.Placement = instanceOpts.SpotPlacement
 	}
 
-	outputRaw, err := tfresource.RetryWhen(ctx, iamPropagationTimeout,
-		func() (interface{}, error) {
+	timeout := instanceProfileIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), input.LaunchSpecification.IamInstanceProfile)
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout,
+		func() (*ec2.RequestSpotInstancesOutput, error) {
 			return conn.RequestSpotInstances(ctx, input)
 		},
 		func(err error) (bool, error) {
@@ -230,7 +231,7 @@ func resourceSpotInstanceRequestCreate(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "requesting EC2 Spot Instance: %s", err)
 	}
 
-	d.SetId(aws.ToString(outputRaw.(*ec2.RequestSpotInstancesOutput).SpotInstanceRequests[0].SpotInstanceRequestId))
+	d.SetId(aws.ToString(output.SpotInstanceRequests[0].SpotInstanceRequestId))
 
 	if d.Get("wait_for_fulfillment").(bool) {
 		if _, err := waitSpotInstanceRequestFulfilled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
@@ -192,6 +192,7 @@ var (
 	FindSecurityGroupByID                                      = findSecurityGroupByID
 	FindSecurityGroupEgressRuleByID                            = findSecurityGroupEgressRuleByID
 	FindSecurityGroupIngressRuleByID                           = findSecurityGroupIngressRuleByID
+	FindSecurityGroupRuleIDsBySecurityGroupID                  = findSecurityGroupRuleIDsBySecurityGroupID
 	FindSnapshot                                               = findSnapshot
 	FindSnapshotByID                                           = findSnapshotByID
 	FindSpotDatafeedSubscription                               = findSpotDatafeedSubscription
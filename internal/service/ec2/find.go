@@ -14,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -6658,3 +6659,118 @@ func findVPCBlockPublicAccessExclusionByID(ctx context.Context, conn *ec2.Client
 
 	return output, nil
 }
+
+// coalescedDescribeBatchSize is the maximum number of IDs in a coalesced Describe call.
+// EC2 allows up to 200 values per filter.
+const coalescedDescribeBatchSize = 200
+
+// findByIDCoalesced finds an EC2 resource by ID.
+// If read coalescing is enabled, concurrent finds are batched into a single Describe call filtered on all the requested IDs.
+// Filters are used rather than the IDs request parameter so that a missing resource doesn't fail the whole batch.
+func findByIDCoalesced[T any](ctx context.Context, c *conns.AWSClient, batchName, id string, findOne func(context.Context, string) (*T, error), findMany func(context.Context, []string) ([]T, error), idOf func(*T) *string) (*T, error) {
+	if !c.CoalesceReads(ctx) {
+		return findOne(ctx, id)
+	}
+
+	output, ok, err := conns.CoalescedRead(ctx, c, batchName, coalescedDescribeBatchSize, id, func(ctx context.Context, ids []string) (map[string]*T, error) {
+		outputs, err := findMany(ctx, ids)
+
+		if tfresource.NotFound(err) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]*T, len(outputs))
+		for i := range outputs {
+			v := &outputs[i]
+			values[aws.ToString(idOf(v))] = v
+		}
+
+		return values, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("%s (%s) not found", batchName, id),
+		}
+	}
+
+	return output, nil
+}
+
+func findNetworkInterfaceByIDCoalesced(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.NetworkInterface, error) {
+	conn := c.EC2Client(ctx)
+
+	return findByIDCoalesced(ctx, c, "ec2.DescribeNetworkInterfaces", id, func(ctx context.Context, id string) (*awstypes.NetworkInterface, error) {
+		return findNetworkInterfaceByID(ctx, conn, id)
+	}, func(ctx context.Context, ids []string) ([]awstypes.NetworkInterface, error) {
+		return findNetworkInterfaces(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+			Filters: []awstypes.Filter{newFilter("network-interface-id", ids)},
+		})
+	}, func(v *awstypes.NetworkInterface) *string {
+		return v.NetworkInterfaceId
+	})
+}
+
+func findRouteTableByIDCoalesced(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.RouteTable, error) {
+	conn := c.EC2Client(ctx)
+
+	return findByIDCoalesced(ctx, c, "ec2.DescribeRouteTables", id, func(ctx context.Context, id string) (*awstypes.RouteTable, error) {
+		return findRouteTableByID(ctx, conn, id)
+	}, func(ctx context.Context, ids []string) ([]awstypes.RouteTable, error) {
+		return findRouteTables(ctx, conn, &ec2.DescribeRouteTablesInput{
+			Filters: []awstypes.Filter{newFilter("route-table-id", ids)},
+		})
+	}, func(v *awstypes.RouteTable) *string {
+		return v.RouteTableId
+	})
+}
+
+func findSecurityGroupByIDCoalesced(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.SecurityGroup, error) {
+	conn := c.EC2Client(ctx)
+
+	return findByIDCoalesced(ctx, c, "ec2.DescribeSecurityGroups", id, func(ctx context.Context, id string) (*awstypes.SecurityGroup, error) {
+		return findSecurityGroupByID(ctx, conn, id)
+	}, func(ctx context.Context, ids []string) ([]awstypes.SecurityGroup, error) {
+		return findSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+			Filters: []awstypes.Filter{newFilter("group-id", ids)},
+		})
+	}, func(v *awstypes.SecurityGroup) *string {
+		return v.GroupId
+	})
+}
+
+func findSubnetByIDCoalesced(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.Subnet, error) {
+	conn := c.EC2Client(ctx)
+
+	return findByIDCoalesced(ctx, c, "ec2.DescribeSubnets", id, func(ctx context.Context, id string) (*awstypes.Subnet, error) {
+		return findSubnetByID(ctx, conn, id)
+	}, func(ctx context.Context, ids []string) ([]awstypes.Subnet, error) {
+		return findSubnets(ctx, conn, &ec2.DescribeSubnetsInput{
+			Filters: []awstypes.Filter{newFilter("subnet-id", ids)},
+		})
+	}, func(v *awstypes.Subnet) *string {
+		return v.SubnetId
+	})
+}
+
+func findVPCByIDCoalesced(ctx context.Context, c *conns.AWSClient, id string) (*awstypes.Vpc, error) {
+	conn := c.EC2Client(ctx)
+
+	return findByIDCoalesced(ctx, c, "ec2.DescribeVpcs", id, func(ctx context.Context, id string) (*awstypes.Vpc, error) {
+		return findVPCByID(ctx, conn, id)
+	}, func(ctx context.Context, ids []string) ([]awstypes.Vpc, error) {
+		return findVPCs(ctx, conn, &ec2.DescribeVpcsInput{
+			Filters: []awstypes.Filter{newFilter("vpc-id", ids)},
+		})
+	}, func(v *awstypes.Vpc) *string {
+		return v.VpcId
+	})
+}
@@ -119,6 +119,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newSecurityGroupRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+		},
 		{
 			Factory:  newResourceSecurityGroupVPCAssociation,
 			TypeName: "aws_vpc_security_group_vpc_association",
@@ -271,7 +271,7 @@ func resourceVPCRead(ctx context.Context, d *schema.ResourceData, meta interface
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return findVPCByID(ctx, conn, d.Id())
+		return findVPCByIDCoalesced(ctx, meta.(*conns.AWSClient), d.Id())
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -248,19 +248,26 @@ func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.MaxAggregationInterval = aws.Int32(int32(v.(int)))
 	}
 
-	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, iamPropagationTimeout, func() (interface{}, error) {
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, aws.ToString(input.DeliverLogsPermissionArn), aws.ToString(input.DeliverCrossAccountRole))
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*ec2.CreateFlowLogsOutput, error) {
 		return conn.CreateFlowLogs(ctx, input)
-	}, errCodeInvalidParameter, "Unable to assume given IAM role")
+	}, func(err error) (bool, error) {
+		if tfawserr.ErrMessageContains(err, errCodeInvalidParameter, "Unable to assume given IAM role") {
+			return true, err
+		}
+
+		return false, err
+	})
 
-	if err == nil && outputRaw != nil {
-		err = unsuccessfulItemsError(outputRaw.(*ec2.CreateFlowLogsOutput).Unsuccessful)
+	if err == nil && output != nil {
+		err = unsuccessfulItemsError(output.Unsuccessful)
 	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Flow Log (%s): %s", resourceID, err)
 	}
 
-	d.SetId(outputRaw.(*ec2.CreateFlowLogsOutput).FlowLogIds[0])
+	d.SetId(output.FlowLogIds[0])
 
 	return append(diags, resourceLogFlowRead(ctx, d, meta)...)
 }
@@ -218,7 +218,7 @@ func resourceRouteTableRead(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return findRouteTableByID(ctx, conn, d.Id())
+		return findRouteTableByIDCoalesced(ctx, meta.(*conns.AWSClient), d.Id())
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -270,9 +270,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
-
-	sg, err := findSecurityGroupByID(ctx, conn, d.Id())
+	sg, err := findSecurityGroupByIDCoalesced(ctx, meta.(*conns.AWSClient), d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", d.Id())
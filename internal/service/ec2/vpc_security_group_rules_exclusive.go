@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupRulesExclusiveResource{}
+
+	return r, nil
+}
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*securityGroupRulesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules_exclusive"
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	if err := r.syncRules(ctx, securityGroupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating VPC Security Group (%s) Rules Exclusive", securityGroupID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	securityGroupID := data.SecurityGroupID.ValueString()
+	ingressRuleIDs, egressRuleIDs, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) Rules Exclusive", securityGroupID), err.Error())
+
+		return
+	}
+
+	data.EgressRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, egressRuleIDs)
+	data.IngressRuleIDs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, ingressRuleIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.EgressRuleIDs.Equal(old.EgressRuleIDs) || !new.IngressRuleIDs.Equal(old.IngressRuleIDs) {
+		securityGroupID := new.SecurityGroupID.ValueString()
+		if err := r.syncRules(ctx, securityGroupID, fwflex.ExpandFrameworkStringValueSet(ctx, new.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.EgressRuleIDs)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Security Group (%s) Rules Exclusive", securityGroupID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncRules revokes any of the security group's rules that are not configured.
+// Configured rules are created by `aws_vpc_security_group_ingress_rule` and `aws_vpc_security_group_egress_rule` resources
+// (or any other interface) and must already exist.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, securityGroupID string, wantIngress, wantEgress []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range []struct {
+		direction string
+		have      []string
+		want      []string
+	}{
+		{"ingress", haveIngress, wantIngress},
+		{"egress", haveEgress, wantEgress},
+	} {
+		missing, _, _ := intflex.DiffSlices(v.have, v.want, func(s1, s2 string) bool { return s1 == s2 })
+		if len(missing) > 0 {
+			return fmt.Errorf("%s rules %v not found in VPC Security Group (%s)", v.direction, missing, securityGroupID)
+		}
+	}
+
+	if _, remove, _ := intflex.DiffSlices(haveIngress, wantIngress, func(s1, s2 string) bool { return s1 == s2 }); len(remove) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: remove,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, input); err != nil {
+			return fmt.Errorf("revoking ingress rules %v: %w", remove, err)
+		}
+	}
+
+	if _, remove, _ := intflex.DiffSlices(haveEgress, wantEgress, func(s1, s2 string) bool { return s1 == s2 }); len(remove) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: remove,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, input); err != nil {
+			return fmt.Errorf("revoking egress rules %v: %w", remove, err)
+		}
+	}
+
+	return nil
+}
+
+// findSecurityGroupRuleIDsBySecurityGroupID returns the IDs of a security group's ingress and egress rules.
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	// Ensure the security group exists, as a filtered rule query doesn't error for a non-existent group.
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingressRuleIDs, egressRuleIDs []string
+	for _, rule := range rules {
+		if aws.ToBool(rule.IsEgress) {
+			egressRuleIDs = append(egressRuleIDs, aws.ToString(rule.SecurityGroupRuleId))
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, aws.ToString(rule.SecurityGroupRuleId))
+		}
+	}
+
+	return ingressRuleIDs, egressRuleIDs, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	EgressRuleIDs   types.Set    `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  types.Set    `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
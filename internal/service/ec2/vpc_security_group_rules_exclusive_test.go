@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress_rule_ids.*", "aws_vpc_security_group_ingress_rule.test", "security_group_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "egress_rule_ids.*", "aws_vpc_security_group_egress_rule.test", "security_group_rule_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "security_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "security_group_id",
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// A rule added out of band should be removed.
+func TestAccVPCSecurityGroupRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, "aws_security_group.test", &group),
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					testAccCheckSecurityGroupAuthorizeIngress(ctx, &group, "192.168.0.0/16"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		ingressRuleIDs, egressRuleIDs, err := tfec2.FindSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, rs.Primary.Attributes["security_group_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["ingress_rule_ids.#"], strconv.Itoa(len(ingressRuleIDs)); got != want {
+			return fmt.Errorf("ingress_rule_ids.# = %s, want %s", got, want)
+		}
+
+		if got, want := rs.Primary.Attributes["egress_rule_ids.#"], strconv.Itoa(len(egressRuleIDs)); got != want {
+			return fmt.Errorf("egress_rule_ids.# = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupAuthorizeIngress(ctx context.Context, v *awstypes.SecurityGroup, cidrBlock string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: v.GroupId,
+			IpPermissions: []awstypes.IpPermission{{
+				FromPort:   aws.Int32(443),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []awstypes.IpRange{{CidrIp: aws.String(cidrBlock)}},
+				ToPort:     aws.Int32(443),
+			}},
+		})
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  ip_protocol = "-1"
+}
+
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+  ingress_rule_ids  = [aws_vpc_security_group_ingress_rule.test.security_group_rule_id]
+  egress_rule_ids   = [aws_vpc_security_group_egress_rule.test.security_group_rule_id]
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+  ingress_rule_ids  = []
+  egress_rule_ids   = []
+}
+`)
+}
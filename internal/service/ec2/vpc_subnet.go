@@ -231,10 +231,9 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, ec2PropagationTimeout, func() (interface{}, error) {
-		return findSubnetByID(ctx, conn, d.Id())
+		return findSubnetByIDCoalesced(ctx, meta.(*conns.AWSClient), d.Id())
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -23,7 +23,6 @@ const (
 	availabilityZoneGroupOptInStatusTimeout            = 10 * time.Minute
 	ebsSnapshotArchivedTimeout                         = 60 * time.Minute
 	ec2PropagationTimeout                              = 5 * time.Minute // nosemgrep:ci.ec2-in-const-name, ci.ec2-in-var-name
-	iamPropagationTimeout                              = 2 * time.Minute
 	instanceReadyTimeout                               = 10 * time.Minute
 	instanceStartTimeout                               = 10 * time.Minute
 	instanceStopTimeout                                = 10 * time.Minute
@@ -1269,13 +1269,14 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.VolumeConfigurations = expandVolumeConfigurations(ctx, v.([]interface{}))
 	}
 
-	output, err := retryServiceCreate(ctx, conn, input)
+	iamTimeout := serviceRoleIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), aws.ToString(input.Role))
+	output, err := retryServiceCreate(ctx, conn, input, iamTimeout)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(partition, err) {
 		input.Tags = nil
 
-		output, err = retryServiceCreate(ctx, conn, input)
+		output, err = retryServiceCreate(ctx, conn, input, iamTimeout)
 	}
 
 	if err != nil {
@@ -1578,7 +1579,8 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			serviceUpdateTimeout = 2 * time.Minute
 			timeout              = propagationTimeout + serviceUpdateTimeout
 		)
-		_, err := tfresource.RetryWhen(ctx, timeout,
+		iamTimeout := serviceRoleIAMPropagationTimeout(ctx, meta.(*conns.AWSClient), d.Get("iam_role").(string))
+		_, err := tfresource.RetryWhen(ctx, max(timeout, iamTimeout),
 			func() (interface{}, error) {
 				return conn.UpdateService(ctx, input)
 			},
@@ -1701,12 +1703,15 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 	return []*schema.ResourceData{d}, nil
 }
 
-func retryServiceCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateServiceInput) (*ecs.CreateServiceOutput, error) {
+// retryServiceCreate retries a CreateService operation.
+// The operation is retried for at least propagationTimeout plus the time needed to create the service,
+// extended to iamTimeout if longer, see conns.AWSClient.IAMPropagationTimeout.
+func retryServiceCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateServiceInput, iamTimeout time.Duration) (*ecs.CreateServiceOutput, error) {
 	const (
 		serviceCreateTimeout = 2 * time.Minute
 		timeout              = propagationTimeout + serviceCreateTimeout
 	)
-	outputRaw, err := tfresource.RetryWhen(ctx, timeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, max(timeout, iamTimeout),
 		func() (interface{}, error) {
 			return conn.CreateService(ctx, input)
 		},
@@ -1738,6 +1743,19 @@ func retryServiceCreate(ctx context.Context, conn *ecs.Client, input *ecs.Create
 	return outputRaw.(*ecs.CreateServiceOutput), err
 }
 
+// serviceRoleIAMPropagationTimeout returns how long to retry IAM eventual consistency errors for the specified service role name or ARN.
+func serviceRoleIAMPropagationTimeout(ctx context.Context, c *conns.AWSClient, role string) time.Duration {
+	if role == "" {
+		return c.IAMPropagationTimeout(ctx)
+	}
+
+	if !arn.IsARN(role) {
+		role = c.GlobalARN(ctx, "iam", "role/"+role)
+	}
+
+	return c.IAMPropagationTimeout(ctx, role)
+}
+
 func findService(ctx context.Context, conn *ecs.Client, input *ecs.DescribeServicesInput) (*awstypes.Service, error) {
 	output, err := findServices(ctx, conn, input)
 
@@ -226,16 +226,21 @@ func resourceMLTransformCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] Creating Glue ML Transform: %+v", input)
 
-	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidInputException](ctx, iamPropagationTimeout, func() (any, error) {
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, aws.ToString(input.Role))
+	output, err := tfresource.RetryGWhenIAMPropagating(ctx, timeout, func() (*glue.CreateMLTransformOutput, error) {
 		return conn.CreateMLTransform(ctx, input)
-	}, "Unable to assume role")
+	}, func(err error) (bool, error) {
+		if errs.IsAErrorMessageContains[*awstypes.InvalidInputException](err, "Unable to assume role") {
+			return true, err
+		}
+
+		return false, err
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Glue ML Transform: %s", err)
 	}
 
-	output := outputRaw.(*glue.CreateMLTransformOutput)
-
 	d.SetId(aws.ToString(output.TransformId))
 
 	return append(diags, resourceMLTransformRead(ctx, d, meta)...)
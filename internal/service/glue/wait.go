@@ -19,7 +19,6 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Deleted
 	mlTransformDeleteTimeout      = 2 * time.Minute
-	iamPropagationTimeout         = 2 * time.Minute
 	registryDeleteTimeout         = 2 * time.Minute
 	schemaAvailableTimeout        = 2 * time.Minute
 	schemaDeleteTimeout           = 2 * time.Minute
@@ -120,6 +120,7 @@ func resourceInstanceProfileCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	d.SetId(aws.ToString(output.InstanceProfile.InstanceProfileName))
+	meta.(*conns.AWSClient).RecordIAMInstanceProfileChange(ctx, d.Id())
 
 	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
 		return findInstanceProfileByName(ctx, conn, d.Id())
@@ -233,6 +234,8 @@ func resourceInstanceProfileUpdate(ctx context.Context, d *schema.ResourceData,
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
+
+		meta.(*conns.AWSClient).RecordIAMInstanceProfileChange(ctx, d.Id())
 	}
 
 	if err := waitInstanceProfileReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
@@ -140,6 +140,7 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.SetId(aws.ToString(output.Policy.Arn))
+	meta.(*conns.AWSClient).RecordIAMPrincipalChange(ctx, d.Id())
 
 	// For partitions not supporting tag-on-create, attempt tag after create.
 	if tags := getTagsIn(ctx); input.Tags == nil && len(tags) > 0 {
@@ -248,6 +249,8 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM Policy (%s): %s", d.Id(), err)
 		}
+
+		meta.(*conns.AWSClient).RecordIAMPrincipalChange(ctx, d.Id())
 	}
 
 	return append(diags, resourcePolicyRead(ctx, d, meta)...)
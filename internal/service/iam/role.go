@@ -231,6 +231,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	}
 
 	roleName := aws.ToString(output.Role.RoleName)
+	meta.(*conns.AWSClient).RecordIAMRoleChange(ctx, roleName)
 
 	if v, ok := d.GetOk("inline_policy"); ok && v.(*schema.Set).Len() > 0 {
 		policies := expandRoleInlinePolicies(roleName, v.(*schema.Set).List())
@@ -488,6 +489,11 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	// Only changes to what the role is trusted by or allowed to do need time to propagate.
+	if d.HasChanges("assume_role_policy", "inline_policy", "managed_policy_arns", "permissions_boundary") {
+		meta.(*conns.AWSClient).RecordIAMRoleChange(ctx, d.Id())
+	}
+
 	return append(diags, resourceRoleRead(ctx, d, meta)...)
 }
 
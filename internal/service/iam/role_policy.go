@@ -104,6 +104,8 @@ func resourceRolePolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "putting IAM Role (%s) Policy (%s): %s", roleName, policyName, err)
 	}
 
+	meta.(*conns.AWSClient).RecordIAMRoleChange(ctx, roleName)
+
 	if d.IsNewResource() {
 		d.SetId(fmt.Sprintf("%s:%s", roleName, policyName))
 
@@ -63,6 +63,8 @@ func resourceRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	meta.(*conns.AWSClient).RecordIAMRoleChange(ctx, role)
+
 	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
 	d.SetId(id.PrefixedUniqueId(fmt.Sprintf("%s-", role)))
 
@@ -71,7 +73,6 @@ func resourceRolePolicyAttachmentCreate(ctx context.Context, d *schema.ResourceD
 
 func resourceRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
 	role := d.Get(names.AttrRole).(string)
 	policyARN := d.Get("policy_arn").(string)
@@ -79,7 +80,7 @@ func resourceRolePolicyAttachmentRead(ctx context.Context, d *schema.ResourceDat
 	id := fmt.Sprintf("%s:%s", role, policyARN)
 
 	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
-		return findAttachedRolePolicyByTwoPartKey(ctx, conn, role, policyARN)
+		return findAttachedRolePolicyByTwoPartKeyCoalesced(ctx, meta.(*conns.AWSClient), role, policyARN)
 	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -168,6 +169,53 @@ func findAttachedRolePolicyByTwoPartKey(ctx context.Context, conn *iam.Client, r
 	})
 }
 
+// coalescedListBatchSize is the maximum number of policy attachments in a coalesced role listing.
+const coalescedListBatchSize = 1000
+
+// findAttachedRolePolicyByTwoPartKeyCoalesced finds a managed policy attached to a role.
+// If read coalescing is enabled, concurrent finds for the same role are satisfied from a single listing of the role's attached policies.
+func findAttachedRolePolicyByTwoPartKeyCoalesced(ctx context.Context, c *conns.AWSClient, roleName, policyARN string) (*awstypes.AttachedPolicy, error) {
+	conn := c.IAMClient(ctx)
+
+	if !c.CoalesceReads(ctx) {
+		return findAttachedRolePolicyByTwoPartKey(ctx, conn, roleName, policyARN)
+	}
+
+	output, ok, err := conns.CoalescedRead(ctx, c, "iam.ListAttachedRolePolicies/"+roleName, coalescedListBatchSize, policyARN, func(ctx context.Context, policyARNs []string) (map[string]*awstypes.AttachedPolicy, error) {
+		input := &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(roleName),
+		}
+		policies, err := findAttachedRolePolicies(ctx, conn, input, tfslices.PredicateTrue[awstypes.AttachedPolicy]())
+
+		if tfresource.NotFound(err) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]*awstypes.AttachedPolicy, len(policies))
+		for _, v := range policies {
+			values[aws.ToString(v.PolicyArn)] = &v
+		}
+
+		return values, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("IAM Role (%s) policy attachment (%s) not found", roleName, policyARN),
+		}
+	}
+
+	return output, nil
+}
+
 func findAttachedRolePolicy(ctx context.Context, conn *iam.Client, input *iam.ListAttachedRolePoliciesInput, filter tfslices.Predicate[awstypes.AttachedPolicy]) (*awstypes.AttachedPolicy, error) {
 	output, err := findAttachedRolePolicies(ctx, conn, input, filter)
 
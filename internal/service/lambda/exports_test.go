@@ -17,6 +17,7 @@ var (
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
+	BuildSourceArchive                           = buildSourceArchive
 	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
 	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
 	FindEventSourceMappingByID                   = findEventSourceMappingByID
@@ -35,6 +36,9 @@ var (
 	LayerVersionParseResourceID                  = layerVersionParseResourceID
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	SourceArchiveS3Key                           = sourceArchiveS3Key
+	SourceArchiveS3KeyFromHash                   = sourceArchiveS3KeyFromHash
+	SourceCodeHash                               = sourceCodeHash
 
 	ValidFunctionName               = validFunctionName
 	ValidPermissionAction           = validPermissionAction
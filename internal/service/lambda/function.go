@@ -174,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -213,7 +213,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -327,7 +327,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +338,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -377,6 +377,23 @@ func resourceFunction() *schema.Resource {
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -459,6 +476,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			customizeDiffSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -498,6 +516,19 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandSourceDirCode(ctx, d, meta, functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+		}
+
+		input.Code.ZipFile = code.ZipFile
+		input.Code.S3Bucket = code.S3Bucket
+		input.Code.S3Key = code.S3Key
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -582,7 +613,8 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	_, err := retryFunctionOp(ctx, func() (*lambda.CreateFunctionOutput, error) {
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, aws.ToString(input.Role))
+	_, err := retryFunctionOp(ctx, timeout, func() (*lambda.CreateFunctionOutput, error) {
 		return conn.CreateFunction(ctx, input)
 	})
 
@@ -920,7 +952,8 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
-		_, err := retryFunctionOp(ctx, func() (*lambda.UpdateFunctionConfigurationOutput, error) {
+		timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, d.Get(names.AttrRole).(string))
+		_, err := retryFunctionOp(ctx, timeout, func() (*lambda.UpdateFunctionConfigurationOutput, error) {
 			return conn.UpdateFunctionConfiguration(ctx, input)
 		})
 
@@ -964,6 +997,19 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandSourceDirCode(ctx, d, meta, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+			}
+
+			input.ZipFile = code.ZipFile
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
 		} else {
 			input.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -989,6 +1035,16 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: waiting for completion: %s", d.Id(), err)
 		}
+
+		// Delete the previous deployment package built from source_dir.
+		oldSourceDir, _ := d.GetChange("source_dir")
+		oldBucket, newBucket := d.GetChange("source_s3_bucket")
+		oldHash, newHash := d.GetChange("source_code_hash")
+		if oldSourceDir.(string) != "" && oldBucket.(string) != "" && (oldBucket != newBucket || oldHash != newHash || d.Get("source_dir").(string) == "") {
+			if err := deleteSourceArchive(ctx, meta, oldBucket.(string), d.Id(), oldHash.(string)); err != nil {
+				diags = sdkdiag.AppendWarningf(diags, "deleting Lambda Function (%s) previous deployment package: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("reserved_concurrent_executions") {
@@ -1070,6 +1126,12 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s): %s", d.Id(), err)
 	}
 
+	if bucket := d.Get("source_s3_bucket").(string); d.Get("source_dir").(string) != "" && bucket != "" {
+		if err := deleteSourceArchive(ctx, meta, bucket, d.Id(), d.Get("source_code_hash").(string)); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "deleting Lambda Function (%s) deployment package: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -1178,7 +1240,8 @@ func replaceSecurityGroupsOnDestroy(ctx context.Context, d *schema.ResourceData,
 		},
 	}
 
-	if _, err := retryFunctionOp(ctx, func() (*lambda.UpdateFunctionConfigurationOutput, error) {
+	timeout := meta.(*conns.AWSClient).IAMPropagationTimeout(ctx, d.Get(names.AttrRole).(string))
+	if _, err := retryFunctionOp(ctx, timeout, func() (*lambda.UpdateFunctionConfigurationOutput, error) {
 		return conn.UpdateFunctionConfiguration(ctx, input)
 	}); err != nil {
 		return fmt.Errorf("updating Lambda Function (%s) configuration: %s", d.Id(), err)
@@ -1265,12 +1328,14 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
+// Operations are retried for at least lambdaPropagationTimeout, extended to iamTimeout if longer,
+// see conns.AWSClient.IAMPropagationTimeout.
 type functionCU interface {
 	lambda.CreateFunctionOutput | lambda.UpdateFunctionConfigurationOutput
 }
 
-func retryFunctionOp[T functionCU](ctx context.Context, f func() (*T, error)) (*T, error) {
-	output, err := tfresource.RetryWhen(ctx, lambdaPropagationTimeout,
+func retryFunctionOp[T functionCU](ctx context.Context, iamTimeout time.Duration, f func() (*T, error)) (*T, error) {
+	output, err := tfresource.RetryWhen(ctx, max(lambdaPropagationTimeout, iamTimeout),
 		func() (interface{}, error) {
 			return f()
 		},
@@ -1282,6 +1347,7 @@ func retryFunctionOp[T functionCU](ctx context.Context, f func() (*T, error)) (*
 			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "The provided execution role does not have permissions") {
 				return true, err
 			}
+
 			if errs.IsAErrorMessageContains[*awstypes.InvalidParameterValueException](err, "throttled by EC2") {
 				return true, err
 			}
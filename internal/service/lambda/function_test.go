@@ -1807,6 +1807,96 @@ func TestAccLambdaFunction_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+	testAccFunctionWriteSourceDir(t, dir, "test-fixtures/lambda_func.js")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_excludes"},
+			},
+			{
+				// Modification times and permissions don't affect the archive.
+				PreConfig: func() {
+					now := time.Now()
+					if err := os.Chtimes(filepath.Join(dir, "lambda.js"), now, now); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.Chmod(filepath.Join(dir, "lambda.js"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccFunctionConfig_sourceDir(rName, dir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccFunctionWriteSourceDir(t, dir, "test-fixtures/lambda_func_modified.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_sourceDirS3(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+	testAccFunctionWriteSourceDir(t, dir, "test-fixtures/lambda_func.js")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDirS3(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccFunctionWriteSourceDir(t, dir, "test-fixtures/lambda_func_modified.js")
+				},
+				Config: testAccFunctionConfig_sourceDirS3(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3736,6 +3826,60 @@ resource "aws_lambda_function" "test" {
 `, rName)
 }
 
+// testAccFunctionWriteSourceDir writes a fixture to lambda.js in a source directory, along with a file excluded from the archive.
+func testAccFunctionWriteSourceDir(t *testing.T, dir, fixture string) {
+	t.Helper()
+
+	content, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "lambda.js"), content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte(time.Now().String()), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccFunctionConfig_sourceDir(rName, dir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir      = %[2]q
+  source_excludes = ["*.md"]
+  function_name   = %[1]q
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "lambda.handler"
+  runtime         = "nodejs20.x"
+}
+`, rName, dir))
+}
+
+func testAccFunctionConfig_sourceDirS3(rName, dir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir       = %[2]q
+  source_excludes  = ["*.md"]
+  source_s3_bucket = aws_s3_bucket.test.bucket
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "lambda.handler"
+  runtime          = "nodejs20.x"
+}
+`, rName, dir))
+}
+
 func testAccFunctionConfig_local(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
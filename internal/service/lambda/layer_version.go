@@ -35,6 +35,9 @@ func resourceLayerVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLayerVersionCreate,
 		ReadWithoutTimeout:   resourceLayerVersionRead,
+		// source_dir, source_excludes and source_s3_bucket only affect how the deployment package is built,
+		// so changing them replaces the layer version only when source_code_hash changes.
+		UpdateWithoutTimeout: schema.NoopContext,
 		DeleteWithoutTimeout: resourceLayerVersionDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -84,7 +87,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -105,19 +108,19 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -143,11 +146,29 @@ func resourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffSourceDir,
 	}
 }
 
@@ -161,12 +182,28 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
+
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
-	if hasFilename {
+	if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		code, err := expandSourceDirCode(ctx, d, meta, layerName)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", sourceDir, err)
+		}
+
+		layerContent = &awstypes.LayerVersionContentInput{
+			S3Bucket: code.S3Bucket,
+			S3Key:    code.S3Key,
+			ZipFile:  code.ZipFile,
+		}
+	} else if hasFilename {
 		conns.GlobalMutexKV.Lock(mutexLayerKey)
 		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
 
@@ -280,6 +317,12 @@ func resourceLayerVersionDelete(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "deleting Lambda Layer Version (%s): %s", d.Id(), err)
 	}
 
+	if bucket := d.Get("source_s3_bucket").(string); d.Get("source_dir").(string) != "" && bucket != "" {
+		if err := deleteSourceArchive(ctx, meta, bucket, layerName, d.Get("source_code_hash").(string)); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "deleting Lambda Layer Version (%s) deployment package: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
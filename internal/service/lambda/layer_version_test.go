@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -150,6 +151,58 @@ func TestAccLambdaLayerVersion_sourceCodeHash(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+	testAccFunctionWriteSourceDir(t, dir, "test-fixtures/lambda_func.js")
+	movedDir := t.TempDir()
+	testAccFunctionWriteSourceDir(t, movedDir, "test-fixtures/lambda_func_modified.js")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccFunctionWriteSourceDir(t, dir, "test-fixtures/lambda_func_modified.js")
+				},
+				Config: testAccLayerVersionConfig_sourceDir(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				// Moving the files without changing them doesn't publish a new layer version.
+				Config: testAccLayerVersionConfig_sourceDir(rName, movedDir),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", movedDir),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -409,6 +462,16 @@ resource "aws_lambda_layer_version" "test" {
 `, rName)
 }
 
+func testAccLayerVersionConfig_sourceDir(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  source_dir      = %[2]q
+  source_excludes = ["*.md"]
+  layer_name      = %[1]q
+}
+`, rName, dir)
+}
+
 func testAccLayerVersionConfig_s3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "lambda_bucket" {
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	errCodeNoSuchBucket = "NoSuchBucket"
+)
+
+const (
+	// sourceArchiveMaxZipFileSize is the maximum size of a deployment package uploaded directly to Lambda.
+	// Larger deployment packages must be uploaded through S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceArchiveMaxZipFileSize = 50 * 1024 * 1024
+	// sourceArchiveFileMode is the mode of every file in the archive.
+	// Lambda requires files to be world-readable and executables such as custom runtime bootstraps to be executable.
+	// Using a single mode keeps archives identical regardless of local file permissions and host OS.
+	sourceArchiveFileMode fs.FileMode = 0o755
+)
+
+// sourceArchiveModified is the modification time of every file in the archive, the earliest time representable in a ZIP file.
+var sourceArchiveModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// buildSourceArchive returns a ZIP archive of the files in a directory.
+// The archive's content depends only on the relative paths and contents of the files, so it's reproducible across machines:
+// entries are sorted by path and have fixed modification times and modes.
+// Files and directories matching any of the exclude patterns are skipped, as are non-regular files other than symbolic links to regular files.
+func buildSourceArchive(dir string, excludes []string) ([]byte, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if slices.ContainsFunc(excludes, func(pattern string) bool { return matchSourceArchivePattern(pattern, rel) }) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		switch mode := d.Type(); {
+		case mode.IsRegular():
+		case mode&fs.ModeSymlink != 0:
+			info, err := os.Stat(p)
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+		default:
+			return nil
+		}
+
+		names = append(names, rel)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", dir, err)
+	}
+
+	// Sort by byte order, independent of the order in which the host OS returns directory entries.
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range names {
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: sourceArchiveModified,
+		}
+		header.SetMode(sourceArchiveFileMode)
+
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// matchSourceArchivePattern returns whether a slash-separated relative path matches a glob pattern.
+// Patterns without a slash match against the last element of the path, other patterns match against the whole path.
+func matchSourceArchivePattern(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(name)
+	}
+
+	ok, _ := path.Match(pattern, name)
+
+	return ok
+}
+
+// sourceCodeHash returns the base64-encoded SHA-256 hash of a deployment package, as returned by Lambda as CodeSha256.
+func sourceCodeHash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// sourceArchiveS3Key returns the S3 object key of a deployment package.
+// The key includes the package's hash so that each version is a distinct object.
+func sourceArchiveS3Key(name string, content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(sum[:]))
+}
+
+// sourceArchiveS3KeyFromHash returns the S3 object key of a deployment package from its base64-encoded SHA-256 hash.
+func sourceArchiveS3KeyFromHash(name, hash string) (string, error) {
+	sum, err := base64.StdEncoding.DecodeString(hash)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s.zip", name, hex.EncodeToString(sum)), nil
+}
+
+// deleteSourceArchive deletes the S3 object of a deployment package uploaded to source_s3_bucket.
+// Lambda keeps its own copy of a deployment package, so the object isn't needed once the function or layer uses another one.
+func deleteSourceArchive(ctx context.Context, meta interface{}, bucket, name, hash string) error {
+	key, err := sourceArchiveS3KeyFromHash(name, hash)
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if _, err := meta.(*conns.AWSClient).S3Client(ctx).DeleteObject(ctx, input); err != nil && !tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return fmt.Errorf("deleting S3 Object (%s) from Bucket (%s): %w", key, bucket, err)
+	}
+
+	return nil
+}
+
+// customizeDiffSourceDir sets source_code_hash to the hash of the archive of source_dir,
+// so that the plan shows a code change only when the files' contents change.
+func customizeDiffSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("source_dir") && d.Get("source_dir").(string) == "" {
+		return nil
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	v := d.Get("source_dir")
+
+	content, err := buildSourceArchive(v.(string), flex.ExpandStringValueList(d.Get("source_excludes").([]interface{})))
+	if err != nil {
+		return err
+	}
+
+	if len(content) > sourceArchiveMaxZipFileSize && d.Get("source_s3_bucket").(string) == "" {
+		return fmt.Errorf("archive of source_dir (%s) is %d bytes, larger than the %d bytes that can be uploaded directly; set source_s3_bucket to upload through S3", v, len(content), sourceArchiveMaxZipFileSize)
+	}
+
+	if hash := sourceCodeHash(content); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
+// sourceDirCode is the deployment package built from source_dir,
+// either as the package's content or as the location of the package in S3.
+type sourceDirCode struct {
+	ZipFile  []byte
+	S3Bucket *string
+	S3Key    *string
+}
+
+// expandSourceDirCode builds the archive of source_dir and, if source_s3_bucket is set, uploads it to S3.
+// Callers should hold an exclusive lock to prevent memory exhaustion, as when reading filename.
+func expandSourceDirCode(ctx context.Context, d *schema.ResourceData, meta interface{}, name string) (*sourceDirCode, error) {
+	dir := d.Get("source_dir").(string)
+	content, err := buildSourceArchive(dir, flex.ExpandStringValueList(d.Get("source_excludes").([]interface{})))
+	if err != nil {
+		return nil, err
+	}
+
+	if v, hash := d.Get("source_code_hash").(string), sourceCodeHash(content); v != "" && v != hash {
+		return nil, fmt.Errorf("contents of source_dir (%s) changed after plan: planned source_code_hash %s, got %s", dir, v, hash)
+	}
+
+	v, ok := d.GetOk("source_s3_bucket")
+	if !ok {
+		return &sourceDirCode{
+			ZipFile: content,
+		}, nil
+	}
+
+	bucket, key := v.(string), sourceArchiveS3Key(name, content)
+	input := &s3.PutObjectInput{
+		Body:   bytes.NewReader(content),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if _, err := meta.(*conns.AWSClient).S3Client(ctx).PutObject(ctx, input); err != nil {
+		return nil, fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return &sourceDirCode{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}, nil
+}
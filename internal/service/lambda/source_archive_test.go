@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestBuildSourceArchive(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.py":             "def handler(event, context): pass",
+		"lib/util.py":          "",
+		"lib/util.pyc":         "compiled",
+		"bootstrap":            "#!/bin/sh",
+		"tests/test_index.py":  "",
+		"node_modules/.bin/x":  "",
+		"node_modules/a/a.js":  "",
+		"node_modules/a/a.map": "",
+	}
+	excludes := []string{"*.pyc", "tests", "node_modules/.bin", "*.map"}
+
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	testSourceArchiveWriteFiles(t, dir1, files, 0o644, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	testSourceArchiveWriteFiles(t, dir2, files, 0o600, time.Date(2024, time.June, 30, 12, 0, 0, 0, time.UTC))
+
+	content1, err := tflambda.BuildSourceArchive(dir1, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	content2, err := tflambda.BuildSourceArchive(dir2, excludes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(content1, content2) {
+		t.Errorf("archives differ: %s, %s", tflambda.SourceCodeHash(content1), tflambda.SourceCodeHash(content2))
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(content1), int64(len(content1)))
+	if err != nil {
+		t.Fatalf("reading archive: %s", err)
+	}
+
+	var got []string
+	for _, f := range r.File {
+		got = append(got, f.Name)
+
+		if got, want := f.Mode(), os.FileMode(0o755); got != want {
+			t.Errorf("%s: got mode %s, want %s", f.Name, got, want)
+		}
+	}
+
+	want := []string{"bootstrap", "index.py", "lib/util.py", "node_modules/a/a.js"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestBuildSourceArchive_notFound(t *testing.T) {
+	t.Parallel()
+
+	if _, err := tflambda.BuildSourceArchive(filepath.Join(t.TempDir(), "missing"), nil); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestSourceCodeHash(t *testing.T) {
+	t.Parallel()
+
+	content := []byte("test")
+
+	if got, want := tflambda.SourceCodeHash(content), "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg="; got != want {
+		t.Errorf("SourceCodeHash: got %s, want %s", got, want)
+	}
+
+	if got, want := tflambda.SourceArchiveS3Key("example", content), "example/9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.zip"; got != want {
+		t.Errorf("SourceArchiveS3Key: got %s, want %s", got, want)
+	}
+
+	// The key of a previous deployment package is derived from the hash in state.
+	key, err := tflambda.SourceArchiveS3KeyFromHash("example", tflambda.SourceCodeHash(content))
+	if err != nil {
+		t.Fatalf("SourceArchiveS3KeyFromHash: %s", err)
+	}
+	if got, want := key, tflambda.SourceArchiveS3Key("example", content); got != want {
+		t.Errorf("SourceArchiveS3KeyFromHash: got %s, want %s", got, want)
+	}
+}
+
+func testSourceArchiveWriteFiles(t *testing.T, dir string, files map[string]string, mode os.FileMode, modified time.Time) {
+	t.Helper()
+
+	for name, content := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(name, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
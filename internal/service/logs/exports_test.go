@@ -39,6 +39,7 @@ var (
 	FindResourcePolicyByName                               = findResourcePolicyByName
 	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
 
+	InsightsQueryTimeRange                 = insightsQueryTimeRange
 	TrimLogGroupARNWildcardSuffix          = trimLogGroupARNWildcardSuffix
 	ValidLogGroupName                      = validLogGroupName
 	ValidLogGroupNamePrefix                = validLogGroupNamePrefix
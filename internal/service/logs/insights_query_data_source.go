@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_log_insights_query", name="Insights Query")
+func dataSourceInsightsQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInsightsQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			"log_group_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validLogGroupName,
+				},
+			},
+			"query_definition_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"query_definition_id", "query_string"},
+			},
+			"query_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"query_definition_id", "query_string"},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			names.AttrStartTime: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				ExactlyOneOf: []string{names.AttrStartTime, "window"},
+			},
+			"statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_matched": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"records_scanned": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+				ExactlyOneOf: []string{names.AttrStartTime, "window"},
+			},
+		},
+	}
+}
+
+func dataSourceInsightsQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	queryString := d.Get("query_string").(string)
+	logGroupNames := flex.ExpandStringValueList(d.Get("log_group_names").([]interface{}))
+
+	// A query definition supplies the query and, unless overridden, the log groups.
+	if v, ok := d.GetOk("query_definition_id"); ok {
+		queryDefinitionID := v.(string)
+		queryDefinition, err := findQueryDefinitionByTwoPartKey(ctx, conn, "", queryDefinitionID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudWatch Logs Query Definition (%s): %s", queryDefinitionID, err)
+		}
+
+		queryString = aws.ToString(queryDefinition.QueryString)
+		if len(logGroupNames) == 0 {
+			logGroupNames = queryDefinition.LogGroupNames
+		}
+	}
+
+	if len(logGroupNames) == 0 {
+		return sdkdiag.AppendErrorf(diags, "log_group_names must be set if the query definition doesn't specify log groups")
+	}
+
+	startTime, endTime, err := insightsQueryTimeRange(d.Get(names.AttrStartTime).(string), d.Get("end_time").(string), d.Get("window").(string), time.Now())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		EndTime:       aws.Int64(endTime.Unix()),
+		LogGroupNames: logGroupNames,
+		QueryString:   aws.String(queryString),
+		StartTime:     aws.Int64(startTime.Unix()),
+	}
+
+	if v, ok := d.GetOk("limit"); ok {
+		input.Limit = aws.Int32(int32(v.(int)))
+	}
+
+	// Only a limited number of queries can run concurrently in an account.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.LimitExceededException](ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, error) {
+		return conn.StartQuery(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting CloudWatch Logs Insights Query: %s", err)
+	}
+
+	queryID := aws.ToString(outputRaw.(*cloudwatchlogs.StartQueryOutput).QueryId)
+	output, err := waitInsightsQueryComplete(ctx, conn, queryID, d.Timeout(schema.TimeoutRead))
+
+	if tfresource.TimedOut(err) {
+		// Don't leave the query running, and scanning log data, after giving up on it.
+		if _, err := conn.StopQuery(ctx, &cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryID)}); err != nil {
+			log.Printf("[WARN] Stopping CloudWatch Logs Insights Query (%s): %s", queryID, err)
+		}
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudWatch Logs Insights Query (%s): %s", queryID, err)
+	}
+
+	d.SetId(queryID)
+	d.Set("log_group_names", logGroupNames)
+	d.Set("query_id", queryID)
+	d.Set("query_string", queryString)
+	if err := d.Set("results", flattenInsightsQueryResults(output.Results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+	if err := d.Set("statistics", flattenQueryStatistics(output.Statistics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting statistics: %s", err)
+	}
+
+	return diags
+}
+
+// insightsQueryTimeRange returns the time range to query, either from startTime to endTime or
+// for the window before endTime. endTime defaults to now.
+func insightsQueryTimeRange(startTime, endTime, window string, now time.Time) (time.Time, time.Time, error) {
+	var start, end time.Time
+
+	end = now
+	if endTime != "" {
+		v, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return start, end, err
+		}
+		end = v
+	}
+
+	switch {
+	case startTime != "":
+		v, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return start, end, err
+		}
+		start = v
+	case window != "":
+		v, err := time.ParseDuration(window)
+		if err != nil {
+			return start, end, err
+		}
+		start = end.Add(-v)
+	default:
+		return start, end, errors.New("one of start_time or window must be set")
+	}
+
+	if !start.Before(end) {
+		return start, end, fmt.Errorf("start time (%s) must be before end time (%s)", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+
+	return start, end, nil
+}
+
+func findInsightsQueryResultsByID(ctx context.Context, conn *cloudwatchlogs.Client, id string) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	input := &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryResults(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusInsightsQuery(ctx context.Context, conn *cloudwatchlogs.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInsightsQueryResultsByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitInsightsQueryComplete(ctx context.Context, conn *cloudwatchlogs.Client, id string, timeout time.Duration) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueryStatusScheduled, awstypes.QueryStatusRunning),
+		Target:     enum.Slice(awstypes.QueryStatusComplete),
+		Refresh:    statusInsightsQuery(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchlogs.GetQueryResultsOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenInsightsQueryResults returns each result as a map of field name to value.
+// The @ptr field, an internal identifier of the log event, is omitted.
+func flattenInsightsQueryResults(apiObjects [][]awstypes.ResultField) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := make(map[string]interface{}, len(apiObject))
+
+		for _, field := range apiObject {
+			if name := aws.ToString(field.Field); name != "@ptr" {
+				tfMap[name] = aws.ToString(field.Value)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenQueryStatistics(apiObject *awstypes.QueryStatistics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bytes_scanned":   apiObject.BytesScanned,
+		"records_matched": apiObject.RecordsMatched,
+		"records_scanned": apiObject.RecordsScanned,
+	}
+
+	return []interface{}{tfMap}
+}
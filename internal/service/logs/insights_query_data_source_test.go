@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflogs "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestInsightsQueryTimeRange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		startTime, endTime, window string
+		expectedStart, expectedEnd time.Time
+		expectError                bool
+	}{
+		"start time": {
+			startTime:     "2024-03-01T11:00:00Z",
+			expectedStart: time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC),
+			expectedEnd:   now,
+		},
+		"start and end time": {
+			startTime:     "2024-02-01T00:00:00Z",
+			endTime:       "2024-02-02T00:00:00+01:00",
+			expectedStart: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2024, time.February, 1, 23, 0, 0, 0, time.UTC),
+		},
+		"window": {
+			window:        "15m",
+			expectedStart: time.Date(2024, time.March, 1, 11, 45, 0, 0, time.UTC),
+			expectedEnd:   now,
+		},
+		"window and end time": {
+			window:        "24h",
+			endTime:       "2024-02-02T00:00:00Z",
+			expectedStart: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedEnd:   time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+		"start after end": {
+			startTime:   "2024-03-02T00:00:00Z",
+			expectError: true,
+		},
+		"no start": {
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start, end, err := tflogs.InsightsQueryTimeRange(testCase.startTime, testCase.endTime, testCase.window, now)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !start.Equal(testCase.expectedStart) || !end.Equal(testCase.expectedEnd) {
+				t.Errorf("got %s to %s, want %s to %s", start, end, testCase.expectedStart, testCase.expectedEnd)
+			}
+		})
+	}
+}
+
+func TestAccLogsInsightsQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "log_group_names.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.0.records_matched", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogsInsightsQueryDataSource_queryDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_log_insights_query.test"
+	resourceName := "aws_cloudwatch_query_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightsQueryDataSourceConfig_queryDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "log_group_names.#", resourceName, "log_group_names.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "query_string", resourceName, "query_string"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "statistics.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInsightsQueryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+data "aws_cloudwatch_log_insights_query" "test" {
+  log_group_names = [aws_cloudwatch_log_group.test.name]
+  window          = "1h"
+
+  query_string = <<EOF
+filter @message like /ERROR/
+| stats count(*) as errors by bin(5m)
+EOF
+}
+`, rName)
+}
+
+func testAccInsightsQueryDataSourceConfig_queryDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccQueryDefinitionConfig_logGroups(rName, 2), `
+data "aws_cloudwatch_log_insights_query" "test" {
+  query_definition_id = aws_cloudwatch_query_definition.test.query_definition_id
+  window              = "1h"
+  limit               = 10
+}
+`)
+}
@@ -85,6 +85,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudwatch_log_groups",
 			Name:     "Log Groups",
 		},
+		{
+			Factory:  dataSourceInsightsQuery,
+			TypeName: "aws_cloudwatch_log_insights_query",
+			Name:     "Insights Query",
+		},
 	}
 }
 
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/permissions"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_provider_required_permissions", name="Provider Required Permissions")
+func newRequiredPermissionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &requiredPermissionsDataSource{}
+
+	return d, nil
+}
+
+type requiredPermissionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*requiredPermissionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_provider_required_permissions"
+}
+
+func (d *requiredPermissionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrActions: schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"data_source_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"operations": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(permissions.Operations()...)),
+				},
+			},
+			"resource_types": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *requiredPermissionsDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("data_source_types"),
+			path.MatchRoot("resource_types"),
+		),
+	}
+}
+
+func (d *requiredPermissionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data requiredPermissionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	operations := fwflex.ExpandFrameworkStringValueSet(ctx, data.Operations)
+	actions := make(map[string]struct{})
+
+	for _, typeName := range fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypes) {
+		v, err := permissions.ResourceActions(typeName, operations...)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("resource_types"), fmt.Sprintf("reading required permissions for resource type (%s)", typeName), err.Error())
+
+			continue
+		}
+
+		for _, action := range v {
+			actions[action] = struct{}{}
+		}
+	}
+
+	for _, typeName := range fwflex.ExpandFrameworkStringValueSet(ctx, data.DataSourceTypes) {
+		v, err := permissions.DataSourceActions(typeName)
+
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("data_source_types"), fmt.Sprintf("reading required permissions for data source type (%s)", typeName), err.Error())
+
+			continue
+		}
+
+		for _, action := range v {
+			actions[action] = struct{}{}
+		}
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An IAM policy statement must have at least one action.
+	if len(actions) == 0 {
+		response.Diagnostics.AddError("reading required permissions", "the specified resource and data source types require no IAM actions")
+
+		return
+	}
+
+	sortedActions := slices.Sorted(maps.Keys(actions))
+
+	policy := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Effect:    "Allow",
+				Actions:   sortedActions,
+				Resources: "*",
+			},
+		},
+	}
+
+	policyJSON, err := json.MarshalIndent(policy, "", "  ")
+
+	if err != nil {
+		response.Diagnostics.AddError("writing IAM Policy Document", err.Error())
+
+		return
+	}
+
+	data.Actions = fwflex.FlattenFrameworkStringValueListLegacy(ctx, sortedActions)
+	data.JSON = fwflex.StringValueToFrameworkLegacy(ctx, string(policyJSON))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type requiredPermissionsDataSourceModel struct {
+	Actions         types.List   `tfsdk:"actions"`
+	DataSourceTypes types.Set    `tfsdk:"data_source_types"`
+	JSON            types.String `tfsdk:"json"`
+	Operations      types.Set    `tfsdk:"operations"`
+	ResourceTypes   types.Set    `tfsdk:"resource_types"`
+}
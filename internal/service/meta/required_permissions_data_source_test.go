@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaRequiredPermissionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_required_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredPermissionsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "sqs:CreateQueue"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "sqs:GetQueueUrl"),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrJSON, regexache.MustCompile(`"Resource": "\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaRequiredPermissionsDataSource_operations(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_required_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRequiredPermissionsDataSourceConfig_operations,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "sqs:GetQueueAttributes"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "actions.*", "sqs:ListQueueTags"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaRequiredPermissionsDataSource_unknownType(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRequiredPermissionsDataSourceConfig_unknownType,
+				ExpectError: regexache.MustCompile(`resource type \(aws_not_a_resource\) not found`),
+			},
+		},
+	})
+}
+
+func TestAccMetaRequiredPermissionsDataSource_noActions(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRequiredPermissionsDataSourceConfig_noActions,
+				ExpectError: regexache.MustCompile(`require no IAM actions`),
+			},
+		},
+	})
+}
+
+const testAccRequiredPermissionsDataSourceConfig_basic = `
+data "aws_provider_required_permissions" "test" {
+  resource_types    = ["aws_sqs_queue"]
+  data_source_types = ["aws_sqs_queue"]
+}
+`
+
+const testAccRequiredPermissionsDataSourceConfig_operations = `
+data "aws_provider_required_permissions" "test" {
+  resource_types = ["aws_sqs_queue"]
+  operations     = ["read"]
+}
+`
+
+const testAccRequiredPermissionsDataSourceConfig_noActions = `
+data "aws_provider_required_permissions" "test" {
+  data_source_types = ["aws_partition"]
+}
+`
+
+const testAccRequiredPermissionsDataSourceConfig_unknownType = `
+data "aws_provider_required_permissions" "test" {
+  resource_types = ["aws_not_a_resource"]
+}
+`
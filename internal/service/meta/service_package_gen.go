@@ -33,6 +33,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_partition",
 			Name:     "Partition",
 		},
+		{
+			Factory:  newRequiredPermissionsDataSource,
+			TypeName: "aws_provider_required_permissions",
+			Name:     "Provider Required Permissions",
+		},
 		{
 			Factory:  newRegionDataSource,
 			TypeName: "aws_region",
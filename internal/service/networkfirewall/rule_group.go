@@ -5,13 +5,16 @@ package networkfirewall
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -22,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfsuricata "github.com/hashicorp/terraform-provider-aws/internal/suricata"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -143,8 +147,9 @@ func resourceRuleGroup() *schema.Resource {
 											},
 										},
 										"rules_string": {
-											Type:     schema.TypeString,
-											Optional: true,
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: validSuricataRulesWarnings,
 										},
 										"stateful_rule": {
 											Type:     schema.TypeList,
@@ -435,8 +440,9 @@ func resourceRuleGroup() *schema.Resource {
 					},
 				},
 				"rules": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validSuricataRulesWarnings,
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -458,6 +464,7 @@ func resourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			resourceRuleGroupValidateSuricataRules,
 			verify.SetTagsDiff,
 		),
 	}
@@ -677,6 +684,121 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.Client, arn
 	return nil, err
 }
 
+// resourceRuleGroupValidateSuricataRules checks the Suricata compatible rules of a stateful rule group during plan,
+// so that errors are reported before the rule group is created or updated.
+func resourceRuleGroupValidateSuricataRules(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get(names.AttrType).(string) != string(awstypes.RuleGroupTypeStateful) {
+		return nil
+	}
+
+	var key string
+	for _, k := range []string{"rules", "rule_group.0.rules_source.0.rules_string"} {
+		if v, ok := d.GetOk(k); ok && v.(string) != "" {
+			key = k
+			break
+		}
+	}
+	if key == "" || !d.NewValueKnown(key) {
+		return nil
+	}
+
+	rulesString := strings.TrimSpace(d.Get(key).(string))
+	// rules_string can also be the location of a rules file in S3.
+	if !strings.ContainsAny(rulesString, " \t\n") {
+		return nil
+	}
+
+	rules, err := tfsuricata.Parse(rulesString)
+	if err != nil {
+		return fmt.Errorf("invalid Suricata rules in %s:\n%w", key, err)
+	}
+
+	if vars, ok := expandSuricataVariables(d); ok {
+		if err := tfsuricata.Validate(rules, vars); err != nil {
+			return fmt.Errorf("invalid Suricata rules in %s:\n%w", key, err)
+		}
+	}
+
+	if d.NewValueKnown("capacity") {
+		if capacity, estimate := d.Get("capacity").(int), tfsuricata.EstimateCapacity(rules); estimate > capacity {
+			return fmt.Errorf("rules in %s need a capacity of at least %d, but capacity is %d", key, estimate, capacity)
+		}
+	}
+
+	return nil
+}
+
+// validSuricataRulesWarnings returns a warning for each keyword and flow option in Suricata compatible rules that isn't known,
+// as Network Firewall's version of Suricata may support them.
+// Errors in the rules are reported by resourceRuleGroupValidateSuricataRules.
+func validSuricataRulesWarnings(v any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rulesString := strings.TrimSpace(v.(string))
+	// rules_string can also be the location of a rules file in S3.
+	if !strings.ContainsAny(rulesString, " \t\n") {
+		return diags
+	}
+
+	rules, err := tfsuricata.Parse(rulesString)
+	if err != nil {
+		return diags
+	}
+
+	for _, warning := range tfsuricata.Warnings(rules) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unknown Suricata rule option",
+			Detail:        fmt.Sprintf("%s. Network Firewall may not support it.", warning),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// expandSuricataVariables returns the rule group's IP set variables, port set variables and IP set references.
+// ok is false if any of their names are not yet known.
+func expandSuricataVariables(d *schema.ResourceDiff) (tfsuricata.Variables, bool) {
+	vars := tfsuricata.Variables{
+		IPSetReferences: []string{},
+	}
+
+	for _, v := range []struct {
+		key string
+		dst *[]string
+	}{
+		{"rule_group.0.rule_variables.0.ip_sets", &vars.IPSets},
+		{"rule_group.0.rule_variables.0.port_sets", &vars.PortSets},
+		{"rule_group.0.reference_sets.0.ip_set_references", &vars.IPSetReferences},
+	} {
+		if !d.NewValueKnown(v.key) {
+			return vars, false
+		}
+
+		set, ok := d.Get(v.key).(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, tfMapRaw := range set.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, ok := tfMap[names.AttrKey].(string)
+			if !ok || name == "" {
+				return vars, false
+			}
+
+			*v.dst = append(*v.dst, name)
+		}
+	}
+
+	return vars, true
+}
+
 func expandStatefulRuleHeader(tfList []interface{}) *awstypes.Header {
 	if len(tfList) == 0 || tfList[0] == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
@@ -458,6 +459,36 @@ func TestAccNetworkFirewallRuleGroup_updateRules(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_invalidRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_sourceString(rName, `pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"example.com" sid:1;)`),
+				ExpectError: regexache.MustCompile(`line 1: invalid content option: value must be a quoted string`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_sourceString(rName, `pass tls $HOME_NET any -> $WEB_SERVERS 443 (tls.sni; content:"example.com"; sid:1;)`),
+				ExpectError: regexache.MustCompile(`line 1: IP set variable \$WEB_SERVERS is not defined`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_basic(rName, "pass tcp any any -> any 80 (sid:1;)\ndrop tcp any any -> any any (sid:1;)"),
+				ExpectError: regexache.MustCompile(`line 2: sid 1 is already used by the rule on line 1`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_sourceStringCapacity(rName, "pass tcp any any -> any 80 (sid:1;)\ndrop tcp any any -> any any (sid:2;)", 1),
+				ExpectError: regexache.MustCompile(`need a capacity of at least 2, but capacity is 1`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_updateRulesSourceList(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -1615,6 +1646,22 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_sourceStringCapacity(rName, rules string, capacity int) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = %[3]d
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_string = %[2]q
+    }
+  }
+}
+`, rName, rules, capacity)
+}
+
 func testAccRuleGroupConfig_statefulOptions(rName, rules, ruleOrder string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
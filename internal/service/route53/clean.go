@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -111,3 +112,34 @@ func fqdn(name string) string {
 		return name + "."
 	}
 }
+
+// zoneFileDomainName converts a domain name from the Route 53 API's representation, which uses octal escapes,
+// to the zone file representation, which uses decimal escapes. The name is made fully qualified.
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html.
+func zoneFileDomainName(name string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(name); i++ {
+		ch, escaped := name[i], false
+		if ch == '\\' && i+4 <= len(name) {
+			if v, err := strconv.ParseUint(name[i+1:i+4], 8, 8); err == nil {
+				ch, escaped = byte(v), true
+				i += 3
+			}
+		}
+
+		switch {
+		case ch == '.' && escaped:
+			sb.WriteString(`\.`)
+		case ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '-' || ch == '_' || ch == '.' || ch == '*':
+			sb.WriteByte(ch)
+		case ch > ' ' && ch < 0x7f:
+			sb.WriteByte('\\')
+			sb.WriteByte(ch)
+		default:
+			fmt.Fprintf(&sb, "\\%03d", ch)
+		}
+	}
+
+	return fqdn(sb.String())
+}
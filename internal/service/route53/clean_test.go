@@ -126,3 +126,28 @@ func TestNormalizeDomainName(t *testing.T) {
 		}
 	}
 }
+
+func TestZoneFileDomainName(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input  string
+		output string
+	}{
+		{"example.com.", "example.com."},
+		{"example.com", "example.com."},
+		{`\052.example.com.`, "*.example.com."},
+		{`a\056b.example.com.`, `a\.b.example.com.`},
+		{`a\040b.example.com.`, `a\032b.example.com.`},
+		{`a\073b.example.com.`, `a\;b.example.com.`},
+		{".", "."},
+	}
+
+	for _, tc := range cases {
+		output := zoneFileDomainName(tc.input)
+
+		if got, want := output, tc.output; got != want {
+			t.Errorf("zoneFileDomainName(%q) = %v, want %v", tc.input, got, want)
+		}
+	}
+}
@@ -19,11 +19,14 @@ var (
 	ResourceZone                        = resourceZone
 	ResourceZoneAssociation             = resourceZoneAssociation
 
+	ChunkChanges                                = chunkChanges
 	CleanZoneID                                 = cleanZoneID
+	DiffResourceRecordSets                      = diffResourceRecordSets
 	ExpandRecordName                            = expandRecordName
 	FindCIDRCollectionByID                      = findCIDRCollectionByID
 	FindCIDRLocationByTwoPartKey                = findCIDRLocationByTwoPartKey
 	FindDelegationSetByID                       = findDelegationSetByID
+	FindExclusiveResourceRecordSets             = findExclusiveResourceRecordSets
 	FindHealthCheckByID                         = findHealthCheckByID
 	FindHostedZoneByID                          = findHostedZoneByID
 	FindHostedZoneDNSSECByZoneID                = findHostedZoneDNSSECByZoneID
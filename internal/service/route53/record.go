@@ -398,9 +398,8 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).Route53Client(ctx)
 
-	record, fqdn, err := findResourceRecordSetByFourPartKey(ctx, conn, cleanZoneID(d.Get("zone_id").(string)), d.Get(names.AttrName).(string), d.Get(names.AttrType).(string), d.Get("set_identifier").(string))
+	record, fqdn, err := findResourceRecordSetByFourPartKeyCoalesced(ctx, meta.(*conns.AWSClient), cleanZoneID(d.Get("zone_id").(string)), d.Get(names.AttrName).(string), d.Get(names.AttrType).(string), d.Get("set_identifier").(string))
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route 53 Record (%s) not found, removing from state", d.Id())
@@ -830,6 +829,135 @@ func findResourceRecordSetByFourPartKey(ctx context.Context, conn *route53.Clien
 	return output, &name, nil
 }
 
+// coalescedListBatchSize is the maximum number of records in a coalesced hosted zone listing.
+const coalescedListBatchSize = 1000
+
+// recordSetKey identifies a resource record set within a hosted zone.
+type recordSetKey struct {
+	recordName  string
+	recordType  string
+	recordSetID string
+}
+
+type recordSetResult struct {
+	name   string
+	record *awstypes.ResourceRecordSet
+}
+
+// findResourceRecordSetByFourPartKeyCoalesced finds a resource record set.
+// If read coalescing is enabled, concurrent finds in the same hosted zone are satisfied from a single listing of the zone.
+func findResourceRecordSetByFourPartKeyCoalesced(ctx context.Context, c *conns.AWSClient, zoneID, recordName, recordType, recordSetID string) (*awstypes.ResourceRecordSet, *string, error) {
+	conn := c.Route53Client(ctx)
+
+	if !c.CoalesceReads(ctx) {
+		return findResourceRecordSetByFourPartKey(ctx, conn, zoneID, recordName, recordType, recordSetID)
+	}
+
+	key := recordSetKey{
+		recordName:  recordName,
+		recordType:  recordType,
+		recordSetID: recordSetID,
+	}
+	output, ok, err := conns.CoalescedRead(ctx, c, "route53.ListResourceRecordSets/"+zoneID, coalescedListBatchSize, key, func(ctx context.Context, keys []recordSetKey) (map[recordSetKey]recordSetResult, error) {
+		return findResourceRecordSetsByFourPartKeys(ctx, conn, zoneID, keys)
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !ok {
+		return nil, nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("Route 53 Record (%s) not found", strings.Join([]string{zoneID, recordName, recordType, recordSetID}, "_")),
+		}
+	}
+
+	return output.record, &output.name, nil
+}
+
+// findResourceRecordSetsByFourPartKeys finds the specified resource record sets in a hosted zone.
+// Records that are not found are omitted from the result.
+func findResourceRecordSetsByFourPartKeys(ctx context.Context, conn *route53.Client, zoneID string, keys []recordSetKey) (map[recordSetKey]recordSetResult, error) {
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[recordSetKey]recordSetResult, len(keys))
+
+	// Listing the whole zone only pays off if it takes fewer API calls than finding each record.
+	const maxItemsPerPage = 300
+	if pages := (aws.ToInt64(zone.HostedZone.ResourceRecordSetCount) + maxItemsPerPage - 1) / maxItemsPerPage; pages >= int64(len(keys)) {
+		for _, key := range keys {
+			record, name, err := findResourceRecordSetByFourPartKey(ctx, conn, zoneID, key.recordName, key.recordType, key.recordSetID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output[key] = recordSetResult{
+				name:   aws.ToString(name),
+				record: record,
+			}
+		}
+
+		return output, nil
+	}
+
+	// Map each listed record's normalized key to the requested keys.
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	names := make(map[recordSetKey]string, len(keys))
+	wanted := make(map[recordSetKey][]recordSetKey, len(keys))
+	for _, key := range keys {
+		name := expandRecordName(key.recordName, zoneName)
+		names[key] = name
+		k := recordSetKey{
+			recordName:  fqdn(name),
+			recordType:  key.recordType,
+			recordSetID: key.recordSetID,
+		}
+		wanted[k] = append(wanted[k], key)
+	}
+
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	_, err = findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		k := recordSetKey{
+			recordName:  strings.ToLower(aws.ToString(v.Name)),
+			recordType:  strings.ToUpper(string(v.Type)),
+			recordSetID: aws.ToString(v.SetIdentifier),
+		}
+		for _, key := range wanted[k] {
+			output[key] = recordSetResult{
+				name:   names[key],
+				record: v,
+			}
+		}
+
+		return false
+	})
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func findResourceRecordSet(ctx context.Context, conn *route53.Client, input *route53.ListResourceRecordSetsInput, morePages tfslices.Predicate[*route53.ListResourceRecordSetsOutput], filter tfslices.Predicate[*awstypes.ResourceRecordSet]) (*awstypes.ResourceRecordSet, error) {
 	output, err := findResourceRecordSets(ctx, conn, input, morePages, filter)
 
@@ -0,0 +1,713 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	// UPSERT changes count twice towards the limits.
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueCharacters = 32000
+)
+
+// @FrameworkResource("aws_route53_records_exclusive", name="Records Exclusive")
+func newRecordsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recordsExclusiveResource{}
+
+	return r, nil
+}
+
+type recordsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (*recordsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route53_records_exclusive"
+}
+
+func (r *recordsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	domainNameValidators := []validator.String{
+		stringvalidator.RegexMatches(regexache.MustCompile(`^[^A-Z]*[^.A-Z]$`), "must be lowercase and must not end with a period"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_suffix": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: domainNameValidators,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_record_set": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[recordsExclusiveRecordSetModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"failover": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.ResourceRecordSetFailover]()...),
+							},
+						},
+						"health_check_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"multivalue_answer": schema.BoolAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required:   true,
+							Validators: domainNameValidators,
+						},
+						"records": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						names.AttrRegion: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.ResourceRecordSetRegion]()...),
+							},
+						},
+						"set_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						"ttl": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(enum.Values[awstypes.RRType]()...),
+							},
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrAlias: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recordsExclusiveAliasModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"evaluate_target_health": schema.BoolAttribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required:   true,
+										Validators: domainNameValidators,
+									},
+									"zone_id": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"geolocation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recordsExclusiveGeoLocationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"continent": schema.StringAttribute{
+										Optional: true,
+									},
+									"country": schema.StringAttribute{
+										Optional: true,
+									},
+									"subdivision": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recordsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	response.Diagnostics.Append(r.syncRecords(ctx, zoneID, data.NameSuffix.ValueString(), data.ResourceRecordSets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	_, output, err := findExclusiveResourceRecordSets(ctx, conn, zoneID, data.NameSuffix.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Records Exclusive (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	recordSets, diags := flattenRecordsExclusiveRecordSets(ctx, output)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ResourceRecordSets = recordSets
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recordsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recordsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.ResourceRecordSets.Equal(old.ResourceRecordSets) {
+		zoneID := cleanZoneID(new.ZoneID.ValueString())
+		response.Diagnostics.Append(r.syncRecords(ctx, zoneID, new.NameSuffix.ValueString(), new.ResourceRecordSets)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recordsExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// ZONEID[,NAME_SUFFIX].
+	zoneID, nameSuffix, found := strings.Cut(request.ID, ",")
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("zone_id"), zoneID)...)
+	if found {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name_suffix"), nameSuffix)...)
+	}
+}
+
+// syncRecords brings the resource record sets within scope in a hosted zone into line with the configured resource record sets.
+//
+// Configured resource record sets that don't exist or differ are upserted. Resource record sets within scope that are not
+// configured are deleted. Changes are submitted in as few change batches as the API's limits allow.
+func (r *recordsExclusiveResource) syncRecords(ctx context.Context, zoneID, nameSuffix string, recordSets fwtypes.SetNestedObjectValueOf[recordsExclusiveRecordSetModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneName, have, err := findExclusiveResourceRecordSets(ctx, conn, zoneID, nameSuffix)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Route 53 Records Exclusive (%s)", zoneID), err.Error())
+
+		return diags
+	}
+
+	want, d := expandRecordsExclusiveRecordSets(ctx, recordSets)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, v := range want {
+		if name := normalizeDomainName(v.Name); !recordNameInScope(name, zoneName, nameSuffix) {
+			diags.AddAttributeError(path.Root("resource_record_set"), "Invalid Resource Record Set", fmt.Sprintf("record (%s) is outside the scope of this resource", name))
+		} else if isIgnoredResourceRecordSet(&v, zoneName) {
+			diags.AddAttributeError(path.Root("resource_record_set"), "Invalid Resource Record Set", fmt.Sprintf("%s record (%s) can't be managed by this resource", v.Type, name))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	changes := diffResourceRecordSets(have, want)
+	if len(changes) == 0 {
+		return diags
+	}
+
+	var changeIDs []string
+	for _, batch := range chunkChanges(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+			err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("changing Route 53 Records Exclusive (%s)", zoneID), err.Error())
+
+			return diags
+		}
+
+		if output.ChangeInfo != nil {
+			changeIDs = append(changeIDs, aws.ToString(output.ChangeInfo.Id))
+		}
+	}
+
+	for _, id := range changeIDs {
+		if _, err := waitChangeInsync(ctx, conn, id); err != nil {
+			diags.AddError(fmt.Sprintf("waiting for Route 53 Records Exclusive (%s) synchronize", zoneID), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// findExclusiveResourceRecordSets returns the name of a hosted zone and its resource record sets within scope,
+// excluding those that can't be managed by the aws_route53_records_exclusive resource.
+func findExclusiveResourceRecordSets(ctx context.Context, conn *route53.Client, zoneID, nameSuffix string) (string, []awstypes.ResourceRecordSet, error) {
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	zoneName := normalizeDomainName(zone.HostedZone.Name)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), func(v *awstypes.ResourceRecordSet) bool {
+		return recordNameInScope(normalizeDomainName(v.Name), zoneName, nameSuffix) && !isIgnoredResourceRecordSet(v, zoneName) && !isUnsupportedResourceRecordSet(v)
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return zoneName, output, nil
+}
+
+// recordNameInScope returns whether a normalized record name is in the hosted zone and, optionally, the subtree rooted at nameSuffix.
+func recordNameInScope(name, zoneName, nameSuffix string) bool {
+	inSubtree := func(name, root string) bool {
+		return name == root || strings.HasSuffix(name, "."+root)
+	}
+
+	if !inSubtree(name, zoneName) {
+		return false
+	}
+
+	return nameSuffix == "" || inSubtree(name, normalizeDomainNameToAPI(nameSuffix))
+}
+
+// isIgnoredResourceRecordSet returns whether a resource record set is managed by Route 53: the SOA record and the apex NS record.
+func isIgnoredResourceRecordSet(v *awstypes.ResourceRecordSet, zoneName string) bool {
+	switch v.Type {
+	case awstypes.RRTypeSoa:
+		return true
+	case awstypes.RRTypeNs:
+		return normalizeDomainName(v.Name) == zoneName
+	}
+
+	return false
+}
+
+// isUnsupportedResourceRecordSet returns whether a resource record set uses a routing configuration not supported by the
+// aws_route53_records_exclusive resource. Such records are left untouched.
+func isUnsupportedResourceRecordSet(v *awstypes.ResourceRecordSet) bool {
+	return v.CidrRoutingConfig != nil || v.GeoProximityLocation != nil || v.TrafficPolicyInstanceId != nil
+}
+
+type resourceRecordSetKey struct {
+	name          string
+	rrType        awstypes.RRType
+	setIdentifier string
+}
+
+func newResourceRecordSetKey(v *awstypes.ResourceRecordSet) resourceRecordSetKey {
+	return resourceRecordSetKey{
+		name:          normalizeDomainName(v.Name),
+		rrType:        v.Type,
+		setIdentifier: aws.ToString(v.SetIdentifier),
+	}
+}
+
+// diffResourceRecordSets returns the changes that turn the have resource record sets into the want resource record sets.
+// The changes to the resource record sets of each name are kept together, with deletions ordered before upserts so that,
+// for example, a CNAME record can be replaced by an A record of the same name.
+func diffResourceRecordSets(have, want []awstypes.ResourceRecordSet) []awstypes.Change {
+	haveByKey := make(map[resourceRecordSetKey]*awstypes.ResourceRecordSet, len(have))
+	for i := range have {
+		haveByKey[newResourceRecordSetKey(&have[i])] = &have[i]
+	}
+	wantByKey := make(map[resourceRecordSetKey]*awstypes.ResourceRecordSet, len(want))
+	for i := range want {
+		wantByKey[newResourceRecordSetKey(&want[i])] = &want[i]
+	}
+
+	var names []string
+	deletes, upserts := make(map[string][]awstypes.Change), make(map[string][]awstypes.Change)
+	addName := func(name string) {
+		if _, ok := deletes[name]; !ok {
+			if _, ok := upserts[name]; !ok {
+				names = append(names, name)
+			}
+		}
+	}
+	for i := range have {
+		if key := newResourceRecordSetKey(&have[i]); wantByKey[key] == nil {
+			addName(key.name)
+			deletes[key.name] = append(deletes[key.name], awstypes.Change{
+				Action:            awstypes.ChangeActionDelete,
+				ResourceRecordSet: &have[i],
+			})
+		}
+	}
+	for i := range want {
+		if key := newResourceRecordSetKey(&want[i]); haveByKey[key] == nil || !resourceRecordSetsEqual(haveByKey[key], &want[i]) {
+			addName(key.name)
+			upserts[key.name] = append(upserts[key.name], awstypes.Change{
+				Action:            awstypes.ChangeActionUpsert,
+				ResourceRecordSet: &want[i],
+			})
+		}
+	}
+
+	var changes []awstypes.Change
+	for _, name := range names {
+		changes = append(changes, deletes[name]...)
+		changes = append(changes, upserts[name]...)
+	}
+
+	return changes
+}
+
+// resourceRecordSetsEqual returns whether two resource record sets with the same key are equivalent.
+func resourceRecordSetsEqual(v1, v2 *awstypes.ResourceRecordSet) bool {
+	if aws.ToInt64(v1.TTL) != aws.ToInt64(v2.TTL) ||
+		aws.ToInt64(v1.Weight) != aws.ToInt64(v2.Weight) ||
+		v1.Region != v2.Region ||
+		v1.Failover != v2.Failover ||
+		aws.ToString(v1.HealthCheckId) != aws.ToString(v2.HealthCheckId) ||
+		aws.ToBool(v1.MultiValueAnswer) != aws.ToBool(v2.MultiValueAnswer) {
+		return false
+	}
+
+	values := func(v *awstypes.ResourceRecordSet) []string {
+		values := tfslices.ApplyToAll(v.ResourceRecords, func(v awstypes.ResourceRecord) string {
+			return aws.ToString(v.Value)
+		})
+		slices.Sort(values)
+		return values
+	}
+	if !slices.Equal(values(v1), values(v2)) {
+		return false
+	}
+
+	if (v1.AliasTarget == nil) != (v2.AliasTarget == nil) {
+		return false
+	}
+	if v1.AliasTarget != nil {
+		if normalizeAliasDomainName(v1.AliasTarget.DNSName) != normalizeAliasDomainName(v2.AliasTarget.DNSName) ||
+			aws.ToString(v1.AliasTarget.HostedZoneId) != aws.ToString(v2.AliasTarget.HostedZoneId) ||
+			v1.AliasTarget.EvaluateTargetHealth != v2.AliasTarget.EvaluateTargetHealth {
+			return false
+		}
+	}
+
+	if (v1.GeoLocation == nil) != (v2.GeoLocation == nil) {
+		return false
+	}
+	if v1.GeoLocation != nil {
+		if aws.ToString(v1.GeoLocation.ContinentCode) != aws.ToString(v2.GeoLocation.ContinentCode) ||
+			aws.ToString(v1.GeoLocation.CountryCode) != aws.ToString(v2.GeoLocation.CountryCode) ||
+			aws.ToString(v1.GeoLocation.SubdivisionCode) != aws.ToString(v2.GeoLocation.SubdivisionCode) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// chunkChanges splits changes into batches within the ChangeResourceRecordSets API's limits.
+// Consecutive changes to resource record sets of the same name are kept in the same batch, so that a
+// resource record set that is replaced is never missing between batches.
+func chunkChanges(changes []awstypes.Change) [][]awstypes.Change {
+	var batches [][]awstypes.Change
+	var batch []awstypes.Change
+	var nRecords, nCharacters int
+
+	for len(changes) > 0 {
+		name := normalizeDomainName(changes[0].ResourceRecordSet.Name)
+		n := 1
+		for n < len(changes) && normalizeDomainName(changes[n].ResourceRecordSet.Name) == name {
+			n++
+		}
+		group := changes[:n]
+		changes = changes[n:]
+
+		var records, characters int
+		for _, change := range group {
+			r, c := changeSize(change)
+			records += r
+			characters += c
+		}
+
+		if len(batch) > 0 && (nRecords+records > changeBatchMaxResourceRecords || nCharacters+characters > changeBatchMaxValueCharacters) {
+			batches = append(batches, batch)
+			batch, nRecords, nCharacters = nil, 0, 0
+		}
+
+		batch = append(batch, group...)
+		nRecords += records
+		nCharacters += characters
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// changeSize returns the number of resource records and value characters a change counts towards the API's limits.
+func changeSize(change awstypes.Change) (int, int) {
+	records, characters := 1, 0
+	if v := change.ResourceRecordSet.ResourceRecords; len(v) > 0 {
+		records = len(v)
+		for _, v := range v {
+			characters += len(aws.ToString(v.Value))
+		}
+	}
+	if change.Action == awstypes.ChangeActionUpsert {
+		records, characters = 2*records, 2*characters
+	}
+
+	return records, characters
+}
+
+func expandRecordsExclusiveRecordSets(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[recordsExclusiveRecordSetModel]) ([]awstypes.ResourceRecordSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.ResourceRecordSet, 0, len(data))
+	for _, v := range data {
+		rrType := awstypes.RRType(v.Type.ValueString())
+		apiObject := awstypes.ResourceRecordSet{
+			Failover:         awstypes.ResourceRecordSetFailover(v.Failover.ValueString()),
+			HealthCheckId:    fwflex.StringFromFramework(ctx, v.HealthCheckID),
+			MultiValueAnswer: fwflex.BoolFromFramework(ctx, v.MultiValueAnswer),
+			Name:             aws.String(normalizeDomainNameToAPI(v.Name.ValueString())),
+			Region:           awstypes.ResourceRecordSetRegion(v.Region.ValueString()),
+			SetIdentifier:    fwflex.StringFromFramework(ctx, v.SetIdentifier),
+			TTL:              fwflex.Int64FromFramework(ctx, v.TTL),
+			Type:             rrType,
+			Weight:           fwflex.Int64FromFramework(ctx, v.Weight),
+		}
+
+		if records := fwflex.ExpandFrameworkStringValueSet(ctx, v.Records); len(records) > 0 {
+			apiObject.ResourceRecords = expandResourceRecords(records, rrType)
+		}
+
+		alias, d := v.Alias.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if alias != nil {
+			apiObject.AliasTarget = &awstypes.AliasTarget{
+				DNSName:              fwflex.StringFromFramework(ctx, alias.Name),
+				EvaluateTargetHealth: alias.EvaluateTargetHealth.ValueBool(),
+				HostedZoneId:         fwflex.StringFromFramework(ctx, alias.ZoneID),
+			}
+		}
+
+		geoLocation, d := v.GeoLocation.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if geoLocation != nil {
+			apiObject.GeoLocation = &awstypes.GeoLocation{
+				ContinentCode:   fwflex.StringFromFramework(ctx, geoLocation.Continent),
+				CountryCode:     fwflex.StringFromFramework(ctx, geoLocation.Country),
+				SubdivisionCode: fwflex.StringFromFramework(ctx, geoLocation.Subdivision),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func flattenRecordsExclusiveRecordSets(ctx context.Context, apiObjects []awstypes.ResourceRecordSet) (fwtypes.SetNestedObjectValueOf[recordsExclusiveRecordSetModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := make([]*recordsExclusiveRecordSetModel, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		v := &recordsExclusiveRecordSetModel{
+			Alias:            fwtypes.NewListNestedObjectValueOfNull[recordsExclusiveAliasModel](ctx),
+			Failover:         fwflex.StringValueToFramework(ctx, apiObject.Failover),
+			GeoLocation:      fwtypes.NewListNestedObjectValueOfNull[recordsExclusiveGeoLocationModel](ctx),
+			HealthCheckID:    fwflex.StringToFramework(ctx, apiObject.HealthCheckId),
+			MultiValueAnswer: fwflex.BoolToFramework(ctx, apiObject.MultiValueAnswer),
+			Name:             types.StringValue(flattenRecordsExclusiveName(aws.ToString(apiObject.Name))),
+			Records:          fwflex.FlattenFrameworkStringValueSet(ctx, flattenResourceRecords(apiObject.ResourceRecords, apiObject.Type)),
+			Region:           fwflex.StringValueToFramework(ctx, apiObject.Region),
+			SetIdentifier:    fwflex.StringToFramework(ctx, apiObject.SetIdentifier),
+			TTL:              fwflex.Int64ToFramework(ctx, apiObject.TTL),
+			Type:             fwflex.StringValueToFramework(ctx, apiObject.Type),
+			Weight:           fwflex.Int64ToFramework(ctx, apiObject.Weight),
+		}
+
+		if alias := apiObject.AliasTarget; alias != nil {
+			v.Alias = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &recordsExclusiveAliasModel{
+				EvaluateTargetHealth: types.BoolValue(alias.EvaluateTargetHealth),
+				Name:                 types.StringValue(normalizeAliasDomainName(alias.DNSName)),
+				ZoneID:               fwflex.StringToFramework(ctx, alias.HostedZoneId),
+			})
+		}
+
+		if geoLocation := apiObject.GeoLocation; geoLocation != nil {
+			v.GeoLocation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &recordsExclusiveGeoLocationModel{
+				Continent:   fwflex.StringToFramework(ctx, geoLocation.ContinentCode),
+				Country:     fwflex.StringToFramework(ctx, geoLocation.CountryCode),
+				Subdivision: fwflex.StringToFramework(ctx, geoLocation.SubdivisionCode),
+			})
+		}
+
+		data = append(data, v)
+	}
+
+	output, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, data)
+	diags.Append(d...)
+
+	return output, diags
+}
+
+// flattenRecordsExclusiveName returns a record name from the API in the form configured for the aws_route53_records_exclusive resource.
+func flattenRecordsExclusiveName(name string) string {
+	name = normalizeDomainName(name)
+
+	// \052 is the octal representation of '*'.
+	if v, ok := strings.CutPrefix(name, `\052.`); ok {
+		name = `*.` + v
+	}
+
+	return name
+}
+
+type recordsExclusiveResourceModel struct {
+	NameSuffix         types.String                                                   `tfsdk:"name_suffix"`
+	ResourceRecordSets fwtypes.SetNestedObjectValueOf[recordsExclusiveRecordSetModel] `tfsdk:"resource_record_set"`
+	ZoneID             types.String                                                   `tfsdk:"zone_id"`
+}
+
+type recordsExclusiveRecordSetModel struct {
+	Alias            fwtypes.ListNestedObjectValueOf[recordsExclusiveAliasModel]       `tfsdk:"alias"`
+	Failover         types.String                                                      `tfsdk:"failover"`
+	GeoLocation      fwtypes.ListNestedObjectValueOf[recordsExclusiveGeoLocationModel] `tfsdk:"geolocation"`
+	HealthCheckID    types.String                                                      `tfsdk:"health_check_id"`
+	MultiValueAnswer types.Bool                                                        `tfsdk:"multivalue_answer"`
+	Name             types.String                                                      `tfsdk:"name"`
+	Records          types.Set                                                         `tfsdk:"records"`
+	Region           types.String                                                      `tfsdk:"region"`
+	SetIdentifier    types.String                                                      `tfsdk:"set_identifier"`
+	TTL              types.Int64                                                       `tfsdk:"ttl"`
+	Type             types.String                                                      `tfsdk:"type"`
+	Weight           types.Int64                                                       `tfsdk:"weight"`
+}
+
+type recordsExclusiveAliasModel struct {
+	EvaluateTargetHealth types.Bool   `tfsdk:"evaluate_target_health"`
+	Name                 types.String `tfsdk:"name"`
+	ZoneID               types.String `tfsdk:"zone_id"`
+}
+
+type recordsExclusiveGeoLocationModel struct {
+	Continent   types.String `tfsdk:"continent"`
+	Country     types.String `tfsdk:"country"`
+	Subdivision types.String `tfsdk:"subdivision"`
+}
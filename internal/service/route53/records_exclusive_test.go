@@ -0,0 +1,488 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDiffResourceRecordSets(t *testing.T) {
+	t.Parallel()
+
+	cname := awstypes.ResourceRecordSet{
+		Name:            aws.String("www.example.com."),
+		Type:            awstypes.RRTypeCname,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("example.net")}},
+	}
+	a := awstypes.ResourceRecordSet{
+		Name:            aws.String("www.example.com."),
+		Type:            awstypes.RRTypeA,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+	}
+	aUpdated := a
+	aUpdated.TTL = aws.Int64(60)
+	txt := awstypes.ResourceRecordSet{
+		Name:            aws.String("example.com."),
+		Type:            awstypes.RRTypeTxt,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(`"v=spf1 -all"`)}},
+	}
+
+	testCases := map[string]struct {
+		have     []awstypes.ResourceRecordSet
+		want     []awstypes.ResourceRecordSet
+		expected []string
+	}{
+		"empty": {},
+		"no changes": {
+			have: []awstypes.ResourceRecordSet{a, txt},
+			want: []awstypes.ResourceRecordSet{txt, a},
+		},
+		"create": {
+			have:     []awstypes.ResourceRecordSet{txt},
+			want:     []awstypes.ResourceRecordSet{txt, a},
+			expected: []string{"UPSERT www.example.com. A"},
+		},
+		"update": {
+			have:     []awstypes.ResourceRecordSet{a},
+			want:     []awstypes.ResourceRecordSet{aUpdated},
+			expected: []string{"UPSERT www.example.com. A"},
+		},
+		"delete": {
+			have:     []awstypes.ResourceRecordSet{a, txt},
+			want:     []awstypes.ResourceRecordSet{a},
+			expected: []string{"DELETE example.com. TXT"},
+		},
+		"replace": {
+			have:     []awstypes.ResourceRecordSet{cname, txt},
+			want:     []awstypes.ResourceRecordSet{a},
+			expected: []string{"DELETE www.example.com. CNAME", "UPSERT www.example.com. A", "DELETE example.com. TXT"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range tfroute53.DiffResourceRecordSets(testCase.have, testCase.want) {
+				got = append(got, fmt.Sprintf("%s %s %s", v.Action, aws.ToString(v.ResourceRecordSet.Name), v.ResourceRecordSet.Type))
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestChunkChanges(t *testing.T) {
+	t.Parallel()
+
+	// Each change counts as 1 resource record, or 2 if it's an upsert.
+	change := func(action awstypes.ChangeAction, name string) awstypes.Change {
+		return awstypes.Change{
+			Action: action,
+			ResourceRecordSet: &awstypes.ResourceRecordSet{
+				Name:            aws.String(name),
+				Type:            awstypes.RRTypeA,
+				ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("")}},
+			},
+		}
+	}
+	deletes := func(n int) []awstypes.Change {
+		var changes []awstypes.Change
+		for i := range n {
+			changes = append(changes, change(awstypes.ChangeActionDelete, fmt.Sprintf("r%d.example.com.", i)))
+		}
+		return changes
+	}
+
+	testCases := map[string]struct {
+		changes  []awstypes.Change
+		expected []int
+	}{
+		"empty": {},
+		"at limit": {
+			changes:  deletes(1000),
+			expected: []int{1000},
+		},
+		"over limit": {
+			changes:  deletes(1001),
+			expected: []int{1000, 1},
+		},
+		"replacement at limit": {
+			// The replacement of the last record would be split across batches.
+			changes:  append(deletes(998), change(awstypes.ChangeActionDelete, "www.example.com."), change(awstypes.ChangeActionUpsert, "www.example.com.")),
+			expected: []int{998, 2},
+		},
+		"value characters over limit": {
+			changes: []awstypes.Change{
+				{
+					Action: awstypes.ChangeActionDelete,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name:            aws.String("r0.example.com."),
+						Type:            awstypes.RRTypeTxt,
+						ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(strings.Repeat("x", 32000))}},
+					},
+				},
+				{
+					Action: awstypes.ChangeActionDelete,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name:            aws.String("r1.example.com."),
+						Type:            awstypes.RRTypeTxt,
+						ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("x")}},
+					},
+				},
+			},
+			expected: []int{1, 1},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []int
+			for _, v := range tfroute53.ChunkChanges(testCase.changes) {
+				got = append(got, len(v))
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("got batch sizes %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccRoute53RecordsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckNoResourceAttr(resourceName, "name_suffix"),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "www." + zoneName,
+						names.AttrType: "A",
+						"ttl":          "300",
+						"records.#":    "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: zoneName,
+						names.AttrType: "TXT",
+						"records.#":    "1",
+						"records.0":    "v=spf1 -all",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "*." + zoneName,
+						names.AttrType: "CNAME",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "zone_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_updated(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "www." + zoneName,
+						names.AttrType: "CNAME",
+						"records.#":    "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: zoneName,
+						names.AttrType: "TXT",
+						"ttl":          "60",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_empty(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsExclusive_nameSuffix(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_nameSuffix(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name_suffix", "dev."+zoneName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_record_set.*", map[string]string{
+						names.AttrName: "api.dev." + zoneName,
+						names.AttrType: "A",
+					}),
+					// The record outside the subtree is left untouched.
+					resource.TestCheckResourceAttr("aws_route53_record.test", names.AttrName, "www."+zoneName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccRecordsExclusiveImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone_id",
+			},
+		},
+	})
+}
+
+// A record added out of band should be removed.
+func TestAccRoute53RecordsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records_exclusive.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					testAccCheckRecordsExclusiveCreateRecord(ctx, resourceName, "oob."+zoneName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRecordsExclusiveConfig_basic(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_record_set.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		_, output, err := tfroute53.FindExclusiveResourceRecordSets(ctx, conn, rs.Primary.Attributes["zone_id"], rs.Primary.Attributes["name_suffix"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := rs.Primary.Attributes["resource_record_set.#"], strconv.Itoa(len(output)); got != want {
+			return fmt.Errorf("resource_record_set.# = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExclusiveCreateRecord(ctx context.Context, n, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		_, err := conn.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: []awstypes.Change{{
+					Action: awstypes.ChangeActionCreate,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name:            aws.String(name),
+						ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.10")}},
+						TTL:             aws.Int64(60),
+						Type:            awstypes.RRTypeA,
+					},
+				}},
+			},
+			HostedZoneId: aws.String(rs.Primary.Attributes["zone_id"]),
+		})
+
+		return err
+	}
+}
+
+func testAccRecordsExclusiveImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["zone_id"] + "," + rs.Primary.Attributes["name_suffix"], nil
+	}
+}
+
+func testAccRecordsExclusiveConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  resource_record_set {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+
+  resource_record_set {
+    name    = "*.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  resource_record_set {
+    name    = "www.%[1]s"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["example.com"]
+  }
+
+  resource_record_set {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 60
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_empty(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+}
+`, zoneName)
+}
+
+func testAccRecordsExclusiveConfig_nameSuffix(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_records_exclusive" "test" {
+  zone_id     = aws_route53_zone.test.zone_id
+  name_suffix = "dev.%[1]s"
+
+  resource_record_set {
+    name    = "api.dev.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.3"]
+  }
+}
+`, zoneName)
+}
@@ -19,6 +19,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_route53_records",
 			Name:     "Records",
 		},
+		{
+			Factory:  newZoneFileDataSource,
+			TypeName: "aws_route53_zone_file",
+			Name:     "Zone File",
+		},
 		{
 			Factory:  newZonesDataSource,
 			TypeName: "aws_route53_zones",
@@ -39,6 +44,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_route53_cidr_location",
 			Name:     "CIDR Location",
 		},
+		{
+			Factory:  newRecordsExclusiveResource,
+			TypeName: "aws_route53_records_exclusive",
+			Name:     "Records Exclusive",
+		},
 	}
 }
 
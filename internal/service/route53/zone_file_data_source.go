@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/zonefile"
+)
+
+const (
+	zoneFileAliasRecordsComment   = "comment"
+	zoneFileAliasRecordsExtension = "extension"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file", name="Zone File")
+func newZoneFileDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileDataSource{}, nil
+}
+
+type zoneFileDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*zoneFileDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_zone_file"
+}
+
+func (d *zoneFileDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alias_records": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(zoneFileAliasRecordsComment, zoneFileAliasRecordsExtension),
+				},
+			},
+			"content": schema.StringAttribute{
+				Computed: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *zoneFileDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	hostedZoneID := cleanZoneID(fwflex.StringValueFromFramework(ctx, data.ZoneID))
+	zone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+	output, err := findResourceRecordSets(ctx, conn, &input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 Records (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	origin := zoneFileDomainName(aws.ToString(zone.HostedZone.Name))
+	records := zoneFileRecords(output, data.AliasRecords.ValueString() == zoneFileAliasRecordsExtension)
+	data.Content = types.StringValue(zonefile.Format(origin, records))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// zoneFileRecords returns the zone file records for Route 53 resource record sets.
+// Records that can't be represented in a standard zone file are commented out: alias records, unless aliasExtension is set,
+// and records with a routing policy.
+func zoneFileRecords(apiObjects []awstypes.ResourceRecordSet, aliasExtension bool) []zonefile.Record {
+	var records []zonefile.Record
+
+	for _, apiObject := range apiObjects {
+		name := zoneFileDomainName(aws.ToString(apiObject.Name))
+
+		var comments []string
+		if v := apiObject.SetIdentifier; v != nil {
+			comments = append(comments, fmt.Sprintf("set identifier %s", aws.ToString(v)))
+		}
+
+		if v := apiObject.AliasTarget; v != nil {
+			comments = append(comments, fmt.Sprintf("alias %s record, hosted zone %s, evaluate target health %t", apiObject.Type, aws.ToString(v.HostedZoneId), v.EvaluateTargetHealth))
+			records = append(records, zonefile.Record{
+				Name:      name,
+				TTL:       -1,
+				Type:      "ALIAS",
+				Data:      zoneFileDomainName(aws.ToString(v.DNSName)),
+				Comment:   strings.Join(comments, "; "),
+				Commented: !aliasExtension || apiObject.SetIdentifier != nil,
+			})
+
+			continue
+		}
+
+		for _, v := range apiObject.ResourceRecords {
+			records = append(records, zonefile.Record{
+				Name:      name,
+				TTL:       aws.ToInt64(apiObject.TTL),
+				Type:      string(apiObject.Type),
+				Data:      aws.ToString(v.Value),
+				Comment:   strings.Join(comments, "; "),
+				Commented: apiObject.SetIdentifier != nil,
+			})
+		}
+	}
+
+	return records
+}
+
+type zoneFileDataSourceModel struct {
+	AliasRecords types.String `tfsdk:"alias_records"`
+	Content      types.String `tfsdk:"content"`
+	ZoneID       types.String `tfsdk:"zone_id"`
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic(zoneName, "comment"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %s\.$`, regexp.QuoteMeta(zoneName)))),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^@ +\d+ +IN SOA `)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^www +300 +IN A +192\.0\.2\.1$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^txt +300 +IN TXT +"v=spf1 -all"$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^; alias +IN ALIAS +www\.`)),
+				),
+			},
+			{
+				Config: testAccZoneFileDataSourceConfig_basic(zoneName, "extension"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^alias +IN ALIAS +www\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileDataSourceConfig_basic(zoneName, aliasRecords string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www.%[1]s"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "txt.%[1]s"
+  type    = "TXT"
+  ttl     = 300
+  records = ["v=spf1 -all"]
+}
+
+resource "aws_route53_record" "alias" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "alias.%[1]s"
+  type    = "A"
+
+  alias {
+    name                   = aws_route53_record.www.fqdn
+    zone_id                = aws_route53_zone.test.zone_id
+    evaluate_target_health = false
+  }
+}
+
+data "aws_route53_zone_file" "test" {
+  zone_id       = aws_route53_zone.test.zone_id
+  alias_records = %[2]q
+
+  depends_on = [aws_route53_record.www, aws_route53_record.txt, aws_route53_record.alias]
+}
+`, zoneName, aliasRecords)
+}
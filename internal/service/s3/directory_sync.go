@@ -0,0 +1,747 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// directorySyncConcurrency is the number of files uploaded concurrently.
+	directorySyncConcurrency = 8
+	// deleteObjectsMaxKeys is the maximum number of keys in a DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+)
+
+var directorySyncFileAttrTypes = map[string]attr.Type{
+	"cache_control":       types.StringType,
+	"checksum_sha256":     types.StringType,
+	names.AttrContentType: types.StringType,
+	"etag":                types.StringType,
+	"metadata":            types.MapType{ElemType: types.StringType},
+}
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*directorySyncResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_sync"
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	metadataValidators := []validator.Map{
+		mapvalidator.KeysAre(stringvalidator.RegexMatches(regexache.MustCompile(`^[^A-Z]+$`), "must be lowercase")),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_removed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"exclude": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"files": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cache_control": schema.StringAttribute{
+							Computed: true,
+						},
+						"checksum_sha256": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Computed: true,
+						},
+						"etag": schema.StringAttribute{
+							Computed: true,
+						},
+						"metadata": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[directorySyncRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cache_control": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Optional: true,
+						},
+						"metadata": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators:  metadataValidators,
+						},
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.sync(ctx, &data, nil)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	conn := r.conn(ctx, bucket)
+
+	remote, err := findObjectETagsByPrefix(ctx, conn, bucket, data.KeyPrefix.ValueString())
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", bucket), err.Error())
+
+		return
+	}
+
+	files, diags := data.files(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Objects deleted or modified outside Terraform are uploaded again.
+	// An object whose ETag has changed is checked for a change in content.
+	for key, file := range files {
+		etag, ok := remote[key]
+		if !ok {
+			delete(files, key)
+			continue
+		}
+		if etag == file.ETag.ValueString() {
+			continue
+		}
+
+		source := filepath.Join(data.SourceDir.ValueString(), filepath.FromSlash(strings.TrimPrefix(key, data.KeyPrefix.ValueString())))
+		unchanged, err := directorySyncObjectMatchesSource(ctx, conn, bucket, key, file.ChecksumSHA256.ValueString(), source)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s) object (%s)", bucket, key), err.Error())
+
+			return
+		}
+
+		file.ETag = types.StringValue(etag)
+		if !unchanged {
+			file.ChecksumSHA256 = types.StringNull()
+		}
+		files[key] = file
+	}
+
+	// Objects added outside Terraform are deleted if removed files are deleted.
+	if data.DeleteRemoved.ValueBool() {
+		for key, etag := range remote {
+			if _, ok := files[key]; !ok {
+				files[key] = directorySyncFileModel{
+					CacheControl:   types.StringNull(),
+					ChecksumSHA256: types.StringNull(),
+					ContentType:    types.StringNull(),
+					ETag:           types.StringValue(etag),
+					Metadata:       types.MapNull(types.StringType),
+				}
+			}
+		}
+	}
+
+	response.Diagnostics.Append(data.setFiles(ctx, files)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	oldFiles, diags := old.files(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.sync(ctx, &new, oldFiles)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	files, diags := data.files(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	conn := r.conn(ctx, bucket)
+
+	err := deleteObjectsByKey(ctx, conn, bucket, slices.Collect(maps.Keys(files)))
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", bucket), err.Error())
+
+		return
+	}
+}
+
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceDir.IsUnknown() || plan.KeyPrefix.IsUnknown() || plan.Exclude.IsUnknown() || plan.Rules.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(types.ObjectType{AttrTypes: directorySyncFileAttrTypes}))...)
+
+		return
+	}
+
+	sources, diags := plan.sourceFiles(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	oldFiles := make(map[string]directorySyncFileModel)
+	if !request.State.Raw.IsNull() {
+		var state directorySyncResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldFiles, diags = state.files(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	files := make(map[string]directorySyncFileModel, len(sources))
+	for key, source := range sources {
+		file, diags := newDirectorySyncFileModel(ctx, source)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The ETag of an object is only known once it's uploaded.
+		if old, ok := oldFiles[key]; ok && old.equal(file) {
+			file.ETag = old.ETag
+		}
+		files[key] = file
+	}
+
+	response.Diagnostics.Append(plan.setFiles(ctx, files)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), plan.Files)...)
+}
+
+func (r *directorySyncResource) conn(ctx context.Context, bucket string) *s3.Client {
+	if isDirectoryBucket(bucket) {
+		return r.Meta().S3ExpressClient(ctx)
+	}
+
+	return r.Meta().S3Client(ctx)
+}
+
+// sync uploads the files in the source directory that are new or have changed since oldFiles and,
+// if configured, deletes objects under the key prefix that don't correspond to a file.
+func (r *directorySyncResource) sync(ctx context.Context, data *directorySyncResourceModel, oldFiles map[string]directorySyncFileModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := data.Bucket.ValueString()
+	conn := r.conn(ctx, bucket)
+
+	files, d := data.files(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	sources, d := data.sourceFiles(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var uploads []string
+	for key, file := range files {
+		source, ok := sources[key]
+		if !ok {
+			diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), fmt.Sprintf("source file for object (%s) no longer exists", key))
+
+			return diags
+		}
+		if source.checksumSHA256 != file.ChecksumSHA256.ValueString() {
+			diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), fmt.Sprintf("source file for object (%s) changed after plan", key))
+
+			return diags
+		}
+		if old, ok := oldFiles[key]; !ok || !old.equal(file) {
+			uploads = append(uploads, key)
+		}
+	}
+	slices.Sort(uploads)
+
+	tflog.Info(ctx, "Uploading S3 objects", map[string]any{
+		"bucket":  bucket,
+		"objects": len(uploads),
+	})
+
+	etags, err := uploadDirectorySyncFiles(ctx, conn, bucket, uploads, sources)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), err.Error())
+
+		return diags
+	}
+
+	for key, file := range files {
+		if etag, ok := etags[key]; ok {
+			file.ETag = types.StringValue(etag)
+		} else {
+			file.ETag = oldFiles[key].ETag
+		}
+		files[key] = file
+	}
+
+	diags.Append(data.setFiles(ctx, files)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.DeleteRemoved.ValueBool() {
+		remote, err := findObjectETagsByPrefix(ctx, conn, bucket, data.KeyPrefix.ValueString())
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), err.Error())
+
+			return diags
+		}
+
+		var deletes []string
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				deletes = append(deletes, key)
+			}
+		}
+
+		tflog.Info(ctx, "Deleting S3 objects", map[string]any{
+			"bucket":  bucket,
+			"objects": len(deletes),
+		})
+
+		if err := deleteObjectsByKey(ctx, conn, bucket, deletes); err != nil {
+			diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// uploadDirectorySyncFiles uploads the specified source files concurrently and returns the uploaded objects' ETags, keyed by object key.
+// Each file is uploaded in a single request with its SHA-256 checksum, which S3 verifies and stores with the object.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.Client, bucket string, keys []string, sources map[string]directorySyncSourceFile) (map[string]string, error) {
+	var optFns []func(*s3.Options)
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == endpoints.AwsGlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	upload := func(key string) (string, error) {
+		source := sources[key]
+
+		file, err := os.Open(source.path)
+		if err != nil {
+			return "", err
+		}
+		defer file.Close()
+
+		input := &s3.PutObjectInput{
+			Body:              file,
+			Bucket:            aws.String(bucket),
+			ChecksumAlgorithm: awstypes.ChecksumAlgorithmSha256,
+			ChecksumSHA256:    aws.String(source.checksumSHA256),
+			ContentLength:     aws.Int64(source.size),
+			ContentType:       aws.String(source.contentType),
+			Key:               aws.String(key),
+			Metadata:          source.metadata,
+		}
+		if source.cacheControl != "" {
+			input.CacheControl = aws.String(source.cacheControl)
+		}
+
+		output, err := conn.PutObject(ctx, input, optFns...)
+
+		if err != nil {
+			return "", fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+		}
+
+		return aws.ToString(output.ETag), nil
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	etags := make(map[string]string, len(keys))
+	var wg sync.WaitGroup
+	keysC := make(chan string)
+
+	for range directorySyncConcurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keysC {
+				etag, err := upload(key)
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, err)
+				} else {
+					etags[key] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		keysC <- key
+	}
+	close(keysC)
+	wg.Wait()
+
+	return etags, errors.Join(errs...)
+}
+
+// directorySyncObjectMatchesSource returns whether an object's content is that of its source file.
+// The object's SHA-256 checksum is compared if it has one. Otherwise its ETag is compared with the file's MD5 digest
+// if the ETag is a plain MD5 digest, which is only so for objects uploaded in a single part without SSE-KMS or SSE-C encryption.
+func directorySyncObjectMatchesSource(ctx context.Context, conn *s3.Client, bucket, key, checksumSHA256, sourcePath string) (bool, error) {
+	output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", string(awstypes.ChecksumAlgorithmSha256))
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if v := aws.ToString(output.ChecksumSHA256); v != "" {
+		return v == checksumSHA256, nil
+	}
+
+	etag := strings.Trim(aws.ToString(output.ETag), `"`)
+	if !isPlainMD5ETag(etag) || output.ServerSideEncryption == awstypes.ServerSideEncryptionAwsKms || output.ServerSideEncryption == awstypes.ServerSideEncryptionAwsKmsDsse || output.SSECustomerAlgorithm != nil {
+		return false, nil
+	}
+
+	hashes, err := directorySyncFileHashesOf(sourcePath)
+	if err != nil {
+		// The source file is checked during plan.
+		return false, nil
+	}
+
+	return hashes.md5 == etag && hashes.sha256 == checksumSHA256, nil
+}
+
+// isPlainMD5ETag returns whether an unquoted ETag has the form of an MD5 digest, i.e. isn't that of a multipart upload.
+func isPlainMD5ETag(etag string) bool {
+	if len(etag) != 32 {
+		return false
+	}
+
+	_, err := hex.DecodeString(etag)
+
+	return err == nil
+}
+
+// findObjectETagsByPrefix returns the ETags of the objects in a bucket with the specified key prefix, keyed by object key.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:       aws.String(bucket),
+		EncodingType: awstypes.EncodingTypeUrl,
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			// Reverse URL-encoding from requested EncodingType: "url".
+			key, err := url.QueryUnescape(aws.ToString(v.Key))
+			if err != nil {
+				return nil, fmt.Errorf("unescaping object key: %w", err)
+			}
+
+			// Keys ending in a slash are folder placeholders.
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+
+			output[key] = aws.ToString(v.ETag)
+		}
+	}
+
+	return output, nil
+}
+
+// deleteObjectsByKey deletes the specified objects, in batches.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	slices.Sort(keys)
+
+	for chunk := range slices.Chunk(keys, deleteObjectsMaxKeys) {
+		toDelete := make([]awstypes.ObjectIdentifier, 0, len(chunk))
+		for _, key := range chunk {
+			toDelete = append(toDelete, awstypes.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type directorySyncResourceModel struct {
+	Bucket        types.String                                            `tfsdk:"bucket"`
+	DeleteRemoved types.Bool                                              `tfsdk:"delete_removed"`
+	Exclude       types.List                                              `tfsdk:"exclude"`
+	Files         types.Map                                               `tfsdk:"files"`
+	KeyPrefix     types.String                                            `tfsdk:"key_prefix"`
+	Rules         fwtypes.ListNestedObjectValueOf[directorySyncRuleModel] `tfsdk:"rule"`
+	SourceDir     types.String                                            `tfsdk:"source_dir"`
+}
+
+// sourceFiles returns the files in the source directory.
+func (data *directorySyncResourceModel) sourceFiles(ctx context.Context) (map[string]directorySyncSourceFile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules, d := data.Rules.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var syncRules []directorySyncRule
+	for _, v := range rules {
+		syncRules = append(syncRules, directorySyncRule{
+			pattern:      v.Pattern.ValueString(),
+			cacheControl: v.CacheControl.ValueString(),
+			contentType:  v.ContentType.ValueString(),
+			metadata:     fwflex.ExpandFrameworkStringValueMap(ctx, v.Metadata),
+		})
+	}
+
+	sourceDir := data.SourceDir.ValueString()
+	files, err := walkDirectorySyncSource(sourceDir, data.KeyPrefix.ValueString(), fwflex.ExpandFrameworkStringValueList(ctx, data.Exclude), syncRules)
+
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_dir"), "Invalid Source Directory", err.Error())
+
+		return nil, diags
+	}
+
+	return files, diags
+}
+
+func (data *directorySyncResourceModel) files(ctx context.Context) (map[string]directorySyncFileModel, diag.Diagnostics) {
+	files := make(map[string]directorySyncFileModel)
+
+	if data.Files.IsNull() || data.Files.IsUnknown() {
+		return files, nil
+	}
+
+	diags := data.Files.ElementsAs(ctx, &files, false)
+
+	return files, diags
+}
+
+func (data *directorySyncResourceModel) setFiles(ctx context.Context, files map[string]directorySyncFileModel) diag.Diagnostics {
+	v, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: directorySyncFileAttrTypes}, files)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Files = v
+
+	return diags
+}
+
+type directorySyncRuleModel struct {
+	CacheControl types.String `tfsdk:"cache_control"`
+	ContentType  types.String `tfsdk:"content_type"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+	Pattern      types.String `tfsdk:"pattern"`
+}
+
+type directorySyncFileModel struct {
+	CacheControl   types.String `tfsdk:"cache_control"`
+	ChecksumSHA256 types.String `tfsdk:"checksum_sha256"`
+	ContentType    types.String `tfsdk:"content_type"`
+	ETag           types.String `tfsdk:"etag"`
+	Metadata       types.Map    `tfsdk:"metadata"`
+}
+
+func newDirectorySyncFileModel(ctx context.Context, source directorySyncSourceFile) (directorySyncFileModel, diag.Diagnostics) {
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, source.metadata)
+
+	return directorySyncFileModel{
+		CacheControl:   fwflex.StringValueToFrameworkLegacy(ctx, source.cacheControl),
+		ChecksumSHA256: types.StringValue(source.checksumSHA256),
+		ContentType:    types.StringValue(source.contentType),
+		ETag:           types.StringUnknown(),
+		Metadata:       metadata,
+	}, diags
+}
+
+// equal returns whether the files' content and object properties are the same. ETags aren't compared.
+func (m directorySyncFileModel) equal(o directorySyncFileModel) bool {
+	return m.CacheControl.Equal(o.CacheControl) && m.ChecksumSHA256.Equal(o.ChecksumSHA256) && m.ContentType.Equal(o.ContentType) && m.Metadata.Equal(o.Metadata)
+}
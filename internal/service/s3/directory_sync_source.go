@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// directorySyncMaxObjectSize is the maximum size of an object uploaded in a single PutObject request.
+	// Files are uploaded in a single request so that S3 stores the SHA-256 checksum of their full content.
+	directorySyncMaxObjectSize = 5 * 1024 * 1024 * 1024
+
+	// directorySyncHashCacheMaxEntries is the maximum number of files whose hashes are cached.
+	directorySyncHashCacheMaxEntries = 100_000
+
+	directorySyncDefaultContentType = "application/octet-stream"
+)
+
+// directorySyncSourceFile is a local file to be synchronized to an S3 object.
+type directorySyncSourceFile struct {
+	path           string
+	size           int64
+	checksumSHA256 string
+	contentType    string
+	cacheControl   string
+	metadata       map[string]string
+}
+
+// directorySyncRule sets the object properties of files matching a glob pattern.
+type directorySyncRule struct {
+	pattern      string
+	cacheControl string
+	contentType  string
+	metadata     map[string]string
+}
+
+// walkDirectorySyncSource returns the files in a local directory, keyed by S3 object key.
+// Files matching any of the exclude patterns are skipped. Rules are applied in order, so later rules take precedence.
+func walkDirectorySyncSource(dir, keyPrefix string, exclude []string, rules []directorySyncRule) (map[string]directorySyncSourceFile, error) {
+	files := make(map[string]directorySyncSourceFile)
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range exclude {
+			if matchDirectorySyncPattern(pattern, rel) {
+				return nil
+			}
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		// Larger files would have to be uploaded in multiple parts, and S3 wouldn't store the checksum of their full content.
+		if info.Size() > directorySyncMaxObjectSize {
+			return fmt.Errorf("file (%s) is larger than the maximum object size of %d bytes", rel, directorySyncMaxObjectSize)
+		}
+
+		hashes, err := directorySyncFileHashesOf(p)
+		if err != nil {
+			return err
+		}
+
+		file := directorySyncSourceFile{
+			path:           p,
+			size:           hashes.size,
+			checksumSHA256: hashes.sha256,
+			contentType:    contentTypeByExtension(rel),
+		}
+		for _, rule := range rules {
+			if !matchDirectorySyncPattern(rule.pattern, rel) {
+				continue
+			}
+			if rule.cacheControl != "" {
+				file.cacheControl = rule.cacheControl
+			}
+			if rule.contentType != "" {
+				file.contentType = rule.contentType
+			}
+			for k, v := range rule.metadata {
+				if file.metadata == nil {
+					file.metadata = make(map[string]string)
+				}
+				file.metadata[k] = v
+			}
+		}
+
+		files[keyPrefix+rel] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// matchDirectorySyncPattern returns whether a slash-separated relative path matches a glob pattern.
+// Patterns without a slash match against the file name in any directory, other patterns match against the whole path.
+// A trailing "/**" matches everything within a directory.
+func matchDirectorySyncPattern(pattern, name string) bool {
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok {
+		for v := path.Dir(name); v != "."; v = path.Dir(v) {
+			if ok, _ := path.Match(dir, v); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(name)
+	}
+
+	ok, _ := path.Match(pattern, name)
+
+	return ok
+}
+
+// directorySyncFileHashes are the size and content hashes of a file.
+type directorySyncFileHashes struct {
+	size    int64
+	modTime time.Time
+	// sha256 is the base64-encoded SHA-256 digest of the content, as in an S3 object's SHA-256 checksum.
+	sha256 string
+	// md5 is the hex-encoded MD5 digest of the content, as in the ETag of an unencrypted object uploaded in a single part.
+	md5 string
+}
+
+// directorySyncHashCache caches file hashes by path, so that a file is only hashed again if its size or modification time changes.
+// The number of entries is bounded. Once full, an arbitrary entry is evicted for each file added.
+type directorySyncHashCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]directorySyncFileHashes
+}
+
+func newDirectorySyncHashCache(maxEntries int) *directorySyncHashCache {
+	return &directorySyncHashCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]directorySyncFileHashes),
+	}
+}
+
+func (c *directorySyncHashCache) get(name string) (directorySyncFileHashes, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.entries[name]
+
+	return v, ok
+}
+
+func (c *directorySyncHashCache) put(name string, v directorySyncFileHashes) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[name]; !ok {
+		for k := range c.entries {
+			if len(c.entries) < c.maxEntries {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[name] = v
+}
+
+var directorySyncHashes = newDirectorySyncHashCache(directorySyncHashCacheMaxEntries)
+
+// directorySyncFileHashesOf returns the size and content hashes of a file.
+func directorySyncFileHashesOf(name string) (directorySyncFileHashes, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return directorySyncFileHashes{}, err
+	}
+
+	v, ok := directorySyncHashes.get(name)
+
+	if ok && v.size == info.Size() && v.modTime.Equal(info.ModTime()) {
+		return v, nil
+	}
+
+	v, err = fileHashes(name)
+	if err != nil {
+		return directorySyncFileHashes{}, err
+	}
+	v.modTime = info.ModTime()
+
+	directorySyncHashes.put(name, v)
+
+	return v, nil
+}
+
+// fileHashes returns the size and the SHA-256 and MD5 digests of a file's content.
+func fileHashes(name string) (directorySyncFileHashes, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return directorySyncFileHashes{}, err
+	}
+	defer f.Close()
+
+	sha256Hash, md5Hash := sha256.New(), md5.New()
+	n, err := io.Copy(io.MultiWriter(sha256Hash, md5Hash), f)
+	if err != nil {
+		return directorySyncFileHashes{}, err
+	}
+
+	return directorySyncFileHashes{
+		size:   n,
+		sha256: base64.StdEncoding.EncodeToString(sha256Hash.Sum(nil)),
+		md5:    hex.EncodeToString(md5Hash.Sum(nil)),
+	}, nil
+}
+
+// contentTypes are the content types of common file extensions.
+// The host's MIME type database isn't used so that plans don't depend on where Terraform runs.
+var contentTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".gz":    "application/gzip",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/vnd.microsoft.icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp3":   "audio/mpeg",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "application/xml",
+	".yaml":  "application/yaml",
+	".yml":   "application/yaml",
+	".zip":   "application/zip",
+}
+
+// contentTypeByExtension returns the content type of a file based on its extension.
+func contentTypeByExtension(name string) string {
+	if v, ok := contentTypes[strings.ToLower(path.Ext(name))]; ok {
+		return v
+	}
+
+	return directorySyncDefaultContentType
+}
@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMatchDirectorySyncPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", true},
+		{"*.html", "index.htm", false},
+		{"docs/*.html", "docs/index.html", true},
+		{"docs/*.html", "index.html", false},
+		{"docs/*.html", "docs/api/index.html", false},
+		{"assets/**", "assets/app.js", true},
+		{"assets/**", "assets/img/logo.png", true},
+		{"assets/**", "app.js", false},
+		{"assets/**", "other/assets.js", false},
+		{"*/img/**", "assets/img/logo.png", true},
+		{".*", ".DS_Store", true},
+		{".*", "img/.DS_Store", true},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := matchDirectorySyncPattern(testCase.pattern, testCase.name), testCase.expected; got != want {
+			t.Errorf("matchDirectorySyncPattern(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, want)
+		}
+	}
+}
+
+func TestFileHashes(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "test")
+	if err := os.WriteFile(name, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := fileHashes(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := directorySyncFileHashes{
+		size:   10,
+		sha256: "hNiYd/DUBB77a/kaFvAkjy/Vc+avBcGflr7bn4gveII=",
+		md5:    "781e5e245d69b566979b86e28d23f2c7",
+	}
+
+	if diff := cmp.Diff(got, expected, cmp.AllowUnexported(directorySyncFileHashes{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestDirectorySyncFileHashesOf(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "test")
+	if err := os.WriteFile(name, []byte("0123456789"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := directorySyncFileHashesOf(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A changed file with the same size and modification time is served from the cache.
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(name, []byte("9876543210"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(name, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := directorySyncFileHashesOf(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.sha256 != first.sha256 {
+		t.Errorf("got %s, expected cached %s", got.sha256, first.sha256)
+	}
+
+	// A change in modification time invalidates the cache.
+	if err := os.Chtimes(name, info.ModTime(), info.ModTime().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = directorySyncFileHashesOf(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.sha256 == first.sha256 {
+		t.Errorf("got cached %s, expected new hash", got.sha256)
+	}
+}
+
+func TestWalkDirectorySyncSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"index.html", "assets/app.js", "assets/logo.PNG", "data.bin", ".DS_Store"} {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rules := []directorySyncRule{
+		{
+			pattern:      "*",
+			cacheControl: "no-cache",
+		},
+		{
+			pattern:      "assets/**",
+			cacheControl: "max-age=31536000",
+			metadata:     map[string]string{"immutable": "true"},
+		},
+		{
+			pattern:     "*.bin",
+			contentType: "application/x-test",
+		},
+	}
+
+	files, err := walkDirectorySyncSource(dir, "site/", []string{".*"}, rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	const emptySHA256 = "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+	expected := map[string]directorySyncSourceFile{
+		"site/assets/app.js": {
+			path:           filepath.Join(dir, "assets", "app.js"),
+			checksumSHA256: emptySHA256,
+			contentType:    "text/javascript; charset=utf-8",
+			cacheControl:   "max-age=31536000",
+			metadata:       map[string]string{"immutable": "true"},
+		},
+		"site/assets/logo.PNG": {
+			path:           filepath.Join(dir, "assets", "logo.PNG"),
+			checksumSHA256: emptySHA256,
+			contentType:    "image/png",
+			cacheControl:   "max-age=31536000",
+			metadata:       map[string]string{"immutable": "true"},
+		},
+		"site/data.bin": {
+			path:           filepath.Join(dir, "data.bin"),
+			checksumSHA256: emptySHA256,
+			contentType:    "application/x-test",
+			cacheControl:   "no-cache",
+		},
+		"site/index.html": {
+			path:           filepath.Join(dir, "index.html"),
+			checksumSHA256: emptySHA256,
+			contentType:    "text/html; charset=utf-8",
+			cacheControl:   "no-cache",
+		},
+	}
+
+	if diff := cmp.Diff(files, expected, cmp.AllowUnexported(directorySyncSourceFile{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWalkDirectorySyncSourceMaxObjectSize(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	name := filepath.Join(dir, "large.bin")
+	if err := os.WriteFile(name, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	// A sparse file, so that nothing is written to disk.
+	if err := os.Truncate(name, directorySyncMaxObjectSize+1); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := walkDirectorySyncSource(dir, "", nil, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestDirectorySyncHashCache(t *testing.T) {
+	t.Parallel()
+
+	c := newDirectorySyncHashCache(2)
+	c.put("a", directorySyncFileHashes{size: 1})
+	c.put("b", directorySyncFileHashes{size: 2})
+	c.put("b", directorySyncFileHashes{size: 3})
+
+	if got, want := len(c.entries), 2; got != want {
+		t.Errorf("got %d entries, expected %d", got, want)
+	}
+
+	c.put("c", directorySyncFileHashes{size: 4})
+
+	if got, want := len(c.entries), 2; got != want {
+		t.Errorf("got %d entries, expected %d", got, want)
+	}
+	if v, ok := c.get("c"); !ok || v.size != 4 {
+		t.Errorf("got %v, %t, expected the latest entry", v, ok)
+	}
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySync_fake(t *testing.T) {
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"assets/app.js": "console.log('hello')",
+	})
+
+	fake.Test(t, fake.NewS3(), []resource.TestStep{
+		{
+			Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+				resource.TestCheckResourceAttr(resourceName, "files.site/index.html.checksum_sha256", "tjOlh8ZS0COGxPFvjG9qq3NS2X8WNnw8QFdiFDct1ig="),
+				resource.TestCheckResourceAttr(resourceName, "files.site/index.html.content_type", "text/html; charset=utf-8"),
+				resource.TestCheckResourceAttr(resourceName, "files.site/index.html.etag", `"c83301425b2ad1d496473a5ff3d9ecca"`),
+				resource.TestCheckResourceAttr(resourceName, "files.site/assets/app.js.content_type", "text/javascript; charset=utf-8"),
+			),
+		},
+		{
+			PreConfig: func() {
+				testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+					"index.html": "<html><body></body></html>",
+					"about.html": "<html></html>",
+				})
+				if err := os.Remove(filepath.Join(dir, "assets", "app.js")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+				resource.TestCheckResourceAttrSet(resourceName, "files.site/about.html.etag"),
+				resource.TestCheckResourceAttr(resourceName, "files.site/index.html.etag", `"b256d97fbb697428b7a1286ea33539c0"`),
+				resource.TestCheckNoResourceAttr(resourceName, "files.site/assets/app.js.etag"),
+			),
+		},
+	})
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":       "<html></html>",
+		"assets/style.css": "body {}",
+		"data.unknown":     "data",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.assets/style.css.content_type", "text/css; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "files.data.unknown.content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.checksum_sha256", "tjOlh8ZS0COGxPFvjG9qq3NS2X8WNnw8QFdiFDct1ig="),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.content_type", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_kmsEncryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The ETags of SSE-KMS encrypted objects aren't MD5 digests, so the plan after apply must be empty.
+				Config: testAccDirectorySyncConfig_kmsEncryption(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.checksum_sha256", "tjOlh8ZS0COGxPFvjG9qq3NS2X8WNnw8QFdiFDct1ig="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+		"error.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "error.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html.etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_rule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"assets/app.js": "console.log('hello')",
+		".gitignore":    "*",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_rule(rName, dir, "max-age=31536000"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.cache_control", "no-cache"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.index.html.metadata.%"),
+					resource.TestCheckResourceAttr(resourceName, "files.assets/app.js.cache_control", "max-age=31536000"),
+					resource.TestCheckResourceAttr(resourceName, "files.assets/app.js.metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.assets/app.js.metadata.immutable", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_rule(rName, dir, "max-age=86400"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "files.assets/app.js.cache_control", "max-age=86400"),
+				),
+			},
+		},
+	})
+}
+
+// testAccDirectorySyncSourceDir returns a temporary directory containing the specified files.
+func testAccDirectorySyncSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectorySyncObjectCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(bucket) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		objects, err := tfs3.FindObjectETagsByPrefix(ctx, conn, bucket, rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(objects); got != want {
+			return fmt.Errorf("S3 Directory Sync (%s) objects: got %d, want %d", bucket, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+}
+`, rName, dir)
+}
+
+func testAccDirectorySyncConfig_kmsEncryption(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
+  }
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket_server_side_encryption_configuration.test.bucket
+  source_dir = %[2]q
+}
+`, rName, dir)
+}
+
+func testAccDirectorySyncConfig_deleteRemoved(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source_dir     = %[2]q
+  delete_removed = true
+}
+`, rName, dir)
+}
+
+func testAccDirectorySyncConfig_rule(rName, dir, cacheControl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[2]q
+  exclude    = [".*"]
+
+  rule {
+    pattern       = "*"
+    cache_control = "no-cache"
+  }
+
+  rule {
+    pattern       = "assets/**"
+    cache_control = %[3]q
+
+    metadata = {
+      immutable = "true"
+    }
+  }
+}
+`, rName, dir, cacheControl)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fake"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -92,6 +93,35 @@ func TestQueueNameFromURL(t *testing.T) {
 	}
 }
 
+func TestQueue_fake(t *testing.T) {
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	fake.Test(t, fake.NewSQS(), []resource.TestStep{
+		{
+			Config: testAccQueueConfig_name(rName),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, names.AttrARN, fmt.Sprintf("arn:%s:sqs:%s:%s:%s", fake.Partition, fake.Region, fake.AccountID, rName)),
+				resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", strconv.Itoa(tfsqs.DefaultQueueVisibilityTimeout)),
+			),
+		},
+		{
+			Config:            testAccQueueConfig_name(rName),
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		{
+			Config: testAccQueueConfig_updated(rName),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "delay_seconds", "90"),
+				resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "60"),
+			),
+		},
+	})
+}
+
 func TestAccSQSQueue_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var queueAttributes map[types.QueueAttributeName]string
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/cedar"
+)
+
+// cedarPolicyValidator validates that a string Attribute's value is a syntactically valid Cedar policy.
+type cedarPolicyValidator struct{}
+
+func (v cedarPolicyValidator) Description(_ context.Context) string {
+	return "value must be a valid Cedar policy"
+}
+
+func (v cedarPolicyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarPolicyValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := tfcedar.ValidatePolicySyntax(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar Policy", err.Error())
+	}
+}
+
+// cedarSchemaValidator validates that a string Attribute's value is a valid Cedar schema in JSON format.
+type cedarSchemaValidator struct{}
+
+func (v cedarSchemaValidator) Description(_ context.Context) string {
+	return "value must be a valid Cedar schema in JSON format"
+}
+
+func (v cedarSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarSchemaValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := tfcedar.ParseSchema(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Cedar Schema", err.Error())
+	}
+}
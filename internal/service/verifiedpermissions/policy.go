@@ -5,6 +5,7 @@ package verifiedpermissions
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfcedar "github.com/hashicorp/terraform-provider-aws/internal/cedar"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -70,6 +72,12 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"validation_schema": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					cedarSchemaValidator{},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"definition": schema.ListNestedBlock{
@@ -100,6 +108,9 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 												statementReplaceIf, "Replace cedar statement diff", "Replace cedar statement diff",
 											),
 										},
+										Validators: []validator.String{
+											cedarPolicyValidator{},
+										},
 									},
 								},
 							},
@@ -508,6 +519,13 @@ func (r *resourcePolicy) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *resourcePolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		r.validateStatement(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		var plan, state resourcePolicyData
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -539,6 +557,48 @@ func (r *resourcePolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 	}
 }
 
+// validateStatement checks the scope of a static policy against the Cedar schema in validation_schema,
+// so that scopes that a STRICT policy store would reject are reported during plan.
+// Policy conditions aren't type-checked, so a STRICT policy store may still reject a policy that passes.
+// The check is skipped while the schema is unknown, e.g. before the schema resource that it references is created.
+func (r *resourcePolicy) validateStatement(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan resourcePolicyData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ValidationSchema.IsUnknown() || plan.ValidationSchema.IsNull() {
+		return
+	}
+
+	def, diags := plan.Definition.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || def == nil {
+		return
+	}
+
+	static, diags := def.Static.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || static == nil || static.Statement.IsUnknown() || static.Statement.IsNull() {
+		return
+	}
+
+	// The schema itself is checked by the attribute's validator.
+	cedarSchema, err := tfcedar.ParseSchema(plan.ValidationSchema.ValueString())
+	if err != nil {
+		return
+	}
+
+	if err := cedarSchema.ValidatePolicyScopes(static.Statement.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition").AtListIndex(0).AtName("static").AtListIndex(0).AtName("statement"),
+			"Invalid Cedar Policy",
+			fmt.Sprintf("policy scope doesn't conform to validation_schema: %s", err),
+		)
+	}
+}
+
 func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, id, policyStoreId string) (*verifiedpermissions.GetPolicyOutput, error) {
 	in := &verifiedpermissions.GetPolicyInput{
 		PolicyId:      aws.String(id),
@@ -564,11 +624,12 @@ func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, id, p
 }
 
 type resourcePolicyData struct {
-	CreatedDate   timetypes.RFC3339                                 `tfsdk:"created_date"`
-	Definition    fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
-	ID            types.String                                      `tfsdk:"id"`
-	PolicyID      types.String                                      `tfsdk:"policy_id"`
-	PolicyStoreID types.String                                      `tfsdk:"policy_store_id"`
+	CreatedDate      timetypes.RFC3339                                 `tfsdk:"created_date"`
+	Definition       fwtypes.ListNestedObjectValueOf[policyDefinition] `tfsdk:"definition"`
+	ID               types.String                                      `tfsdk:"id"`
+	PolicyID         types.String                                      `tfsdk:"policy_id"`
+	PolicyStoreID    types.String                                      `tfsdk:"policy_store_id"`
+	ValidationSchema types.String                                      `tfsdk:"validation_schema"`
 }
 
 type policyDefinition struct {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -156,6 +157,74 @@ func TestAccVerifiedPermissionsPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsPolicy_invalidStatement(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_basic(rName, "permit (principal, action resource);"),
+				ExpectError: regexache.MustCompile(`Invalid Cedar Policy`),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicy_strictSchemaValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_strict(rName, "permit (principal == PhotoApp::User::\"alice\", action == PhotoApp::Action::\"deletePhoto\", resource);"),
+				ExpectError: regexache.MustCompile(`undeclared action`),
+			},
+			{
+				Config:      testAccPolicyConfig_strict(rName, "permit (principal == PhotoApp::Photo::\"vacation.jpg\", action == PhotoApp::Action::\"viewPhoto\", resource);"),
+				ExpectError: regexache.MustCompile(`isn't valid for any of the policy's actions`),
+			},
+			{
+				Config: testAccPolicyConfig_strict(rName, "permit (principal == PhotoApp::User::\"alice\", action == PhotoApp::Action::\"viewPhoto\", resource);"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validation_schema"},
+			},
+		},
+	})
+}
+
 func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -318,3 +387,54 @@ resource "aws_verifiedpermissions_policy" "test" {
 }
 `, rName))
 }
+
+func testAccPolicyConfig_strictBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = jsonencode({
+      PhotoApp = {
+        entityTypes = {
+          User  = {}
+          Photo = {}
+        }
+        actions = {
+          viewPhoto = {
+            appliesTo = {
+              principalTypes = ["User"]
+              resourceTypes  = ["Photo"]
+            }
+          }
+        }
+      }
+    })
+  }
+}
+`, rName)
+}
+
+func testAccPolicyConfig_strict(rName, policyStatement string) string {
+	return acctest.ConfigCompose(
+		testAccPolicyConfig_strictBase(rName),
+		fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id   = aws_verifiedpermissions_policy_store.test.id
+  validation_schema = aws_verifiedpermissions_schema.test.definition[0].value
+
+  definition {
+    static {
+      statement = %[1]q
+    }
+  }
+}
+`, policyStatement))
+}
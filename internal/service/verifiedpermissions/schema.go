@@ -70,6 +70,9 @@ func (r *resourceSchema) Schema(ctx context.Context, request resource.SchemaRequ
 					names.AttrValue: schema.StringAttribute{
 						CustomType: jsontypes.NormalizedType{},
 						Required:   true,
+						Validators: []validator.String{
+							cedarSchemaValidator{},
+						},
 					},
 				},
 			},
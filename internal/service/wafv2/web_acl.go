@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -89,6 +90,10 @@ func resourceWebACL() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: validation.StringLenBetween(1, 256),
 				},
+				"ignore_external_rules": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				"lock_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -119,37 +124,14 @@ func resourceWebACL() *schema.Resource {
 					ConflictsWith: []string{"rule_json"},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
-							names.AttrAction: {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"allow":     allowConfigSchema(),
-										"block":     blockConfigSchema(),
-										"captcha":   captchaConfigSchema(),
-										"challenge": challengeConfigSchema(),
-										"count":     countConfigSchema(),
-									},
-								},
-							},
+							names.AttrAction: webACLRuleActionSchema(),
 							"captcha_config": outerCaptchaConfigSchema(),
 							names.AttrName: {
 								Type:         schema.TypeString,
 								Required:     true,
 								ValidateFunc: validation.StringLenBetween(1, 128),
 							},
-							"override_action": {
-								Type:     schema.TypeList,
-								Optional: true,
-								MaxItems: 1,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"count": emptySchema(),
-										"none":  emptySchema(),
-									},
-								},
-							},
+							"override_action": overrideActionSchema(),
 							names.AttrPriority: {
 								Type:     schema.TypeInt,
 								Required: true,
@@ -287,6 +269,9 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	if _, ok := d.GetOk(names.AttrRule); ok {
 		rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
+		if d.Get("ignore_external_rules").(bool) {
+			rules, _ = partitionWebACLRules(rules, webACLConfiguredRuleNames(d))
+		}
 		if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 		}
@@ -310,12 +295,13 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		aclName := d.Get(names.AttrName).(string)
 		aclScope := d.Get(names.AttrScope).(string)
 		aclLockToken := d.Get("lock_token").(string)
+		ignoreExternalRules := d.Get("ignore_external_rules").(bool)
 		// Find the AWS managed ShieldMitigationRuleGroup group rule if existent and add it into the set of rules to update
 		// so that the provider will not remove the Shield rule when changes are applied to the WebACL.
 		var rules []awstypes.Rule
 
 		rules = expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
-		if sr := findShieldRule(rules); len(sr) == 0 {
+		if sr := findShieldRule(rules); len(sr) == 0 && !ignoreExternalRules {
 			output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
 
 			if err != nil {
@@ -330,7 +316,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "expanding WAFv2 WebACL JSON rule (%s): %s", d.Id(), err)
 			}
-			if sr := findShieldRule(rules); len(sr) == 0 {
+			if sr := findShieldRule(rules); len(sr) == 0 && !ignoreExternalRules {
 				output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
 
 				if err != nil {
@@ -370,6 +356,33 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		const (
 			timeout = 5 * time.Minute
 		)
+
+		// Keep the rules not configured in this resource, including the Shield rule, as they are.
+		// The web ACL is read and updated using the lock token from the read, as the lock token in state
+		// is stale once the external rules have been changed. If the web ACL has been changed in the
+		// meantime, this starts over with the changed web ACL.
+		if ignoreExternalRules {
+			_, err := tfresource.RetryWhenIsOneOf2[*awstypes.WAFOptimisticLockException, *awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
+				output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
+
+				if err != nil {
+					return nil, err
+				}
+
+				_, externalRules := partitionWebACLRules(output.WebACL.Rules, webACLConfiguredRuleNames(d))
+				input.LockToken = output.LockToken
+				input.Rules = append(slices.Clone(rules), externalRules...)
+
+				return conn.UpdateWebACL(ctx, input)
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating WAFv2 WebACL (%s): %s", d.Id(), err)
+			}
+
+			return append(diags, resourceWebACLRead(ctx, d, meta)...)
+		}
+
 		_, err := tfresource.RetryWhenIsA[*awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
 			return conn.UpdateWebACL(ctx, input)
 		})
@@ -526,3 +539,47 @@ func findShieldRule(rules []awstypes.Rule) []awstypes.Rule {
 	}
 	return sr
 }
+
+// webACLConfiguredRuleNames returns the names of the rules configured in the rule or rule_json
+// arguments, before or after any change.
+func webACLConfiguredRuleNames(d *schema.ResourceData) map[string]bool {
+	ruleNames := make(map[string]bool)
+
+	for _, key := range []string{names.AttrRule, "rule_json"} {
+		o, n := d.GetChange(key)
+
+		for _, v := range []interface{}{o, n} {
+			var rules []awstypes.Rule
+
+			switch v := v.(type) {
+			case *schema.Set:
+				rules = expandWebACLRules(v.List())
+			case string:
+				if v != "" {
+					rules, _ = expandWebACLRulesJSON(v)
+				}
+			}
+
+			for _, rule := range rules {
+				ruleNames[aws.ToString(rule.Name)] = true
+			}
+		}
+	}
+
+	return ruleNames
+}
+
+// partitionWebACLRules splits rules into those with the specified names and the others.
+func partitionWebACLRules(rules []awstypes.Rule, ruleNames map[string]bool) ([]awstypes.Rule, []awstypes.Rule) {
+	var named, others []awstypes.Rule
+
+	for _, rule := range rules {
+		if ruleNames[aws.ToString(rule.Name)] {
+			named = append(named, rule)
+		} else {
+			others = append(others, rule)
+		}
+	}
+
+	return named, others
+}
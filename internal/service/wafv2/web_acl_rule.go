@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	webACLRuleResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_wafv2_web_acl_rule", name="Web ACL Rule")
+func resourceWebACLRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebACLRuleCreate,
+		ReadWithoutTimeout:   resourceWebACLRuleRead,
+		UpdateWithoutTimeout: resourceWebACLRuleUpdate,
+		DeleteWithoutTimeout: resourceWebACLRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrAction: webACLRuleActionSchema(),
+				"captcha_config": outerCaptchaConfigSchema(),
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"override_action": overrideActionSchema(),
+				names.AttrPriority: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"rule_label":        ruleLabelsSchema(),
+				"statement":         webACLRootStatementSchema(webACLRootStatementSchemaLevel),
+				"visibility_config": visibilityConfigSchema(),
+				"web_acl_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			}
+		},
+	}
+}
+
+func resourceWebACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACLARN := d.Get("web_acl_arn").(string)
+	name := d.Get(names.AttrName).(string)
+	id, err := flex.FlattenResourceId([]string{webACLARN, name}, webACLRuleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule := expandWebACLRuleFromResourceData(d)
+	err = updateWebACLRules(ctx, conn, webACLARN, d.Timeout(schema.TimeoutCreate), func(rules []awstypes.Rule) ([]awstypes.Rule, error) {
+		if slices.ContainsFunc(rules, webACLRuleNameEquals(name)) {
+			return nil, fmt.Errorf("rule (%s) already exists", name)
+		}
+
+		if err := checkWebACLRulePriority(rules, rule); err != nil {
+			return nil, err
+		}
+
+		return append(rules, rule), nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating WAFv2 WebACL Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWebACLRuleRead(ctx, d, meta)...)
+}
+
+func resourceWebACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), webACLRuleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	webACLARN, name := parts[0], parts[1]
+	rule, err := findWebACLRuleByTwoPartKey(ctx, conn, webACLARN, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WAFv2 WebACL Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set(names.AttrAction, flattenRuleAction(rule.Action)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting action: %s", err)
+	}
+	if err := d.Set("captcha_config", flattenCaptchaConfig(rule.CaptchaConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting captcha_config: %s", err)
+	}
+	d.Set(names.AttrName, rule.Name)
+	if err := d.Set("override_action", flattenOverrideAction(rule.OverrideAction)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting override_action: %s", err)
+	}
+	d.Set(names.AttrPriority, rule.Priority)
+	if err := d.Set("rule_label", flattenRuleLabels(rule.RuleLabels)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule_label: %s", err)
+	}
+	if err := d.Set("statement", flattenWebACLRootStatement(rule.Statement)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting statement: %s", err)
+	}
+	if err := d.Set("visibility_config", flattenVisibilityConfig(rule.VisibilityConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting visibility_config: %s", err)
+	}
+	d.Set("web_acl_arn", webACLARN)
+
+	return diags
+}
+
+func resourceWebACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	rule := expandWebACLRuleFromResourceData(d)
+	err := updateWebACLRules(ctx, conn, d.Get("web_acl_arn").(string), d.Timeout(schema.TimeoutUpdate), func(rules []awstypes.Rule) ([]awstypes.Rule, error) {
+		i := slices.IndexFunc(rules, webACLRuleNameEquals(aws.ToString(rule.Name)))
+		if i == -1 {
+			return nil, &retry.NotFoundError{}
+		}
+
+		rules = slices.Delete(slices.Clone(rules), i, i+1)
+
+		if err := checkWebACLRulePriority(rules, rule); err != nil {
+			return nil, err
+		}
+
+		return append(rules, rule), nil
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceWebACLRuleRead(ctx, d, meta)...)
+}
+
+func resourceWebACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	log.Printf("[INFO] Deleting WAFv2 WebACL Rule: %s", d.Id())
+	name := d.Get(names.AttrName).(string)
+	err := updateWebACLRules(ctx, conn, d.Get("web_acl_arn").(string), d.Timeout(schema.TimeoutDelete), func(rules []awstypes.Rule) ([]awstypes.Rule, error) {
+		i := slices.IndexFunc(rules, webACLRuleNameEquals(name))
+		if i == -1 {
+			return nil, &retry.NotFoundError{}
+		}
+
+		return slices.Delete(slices.Clone(rules), i, i+1), nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting WAFv2 WebACL Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWebACLRuleByTwoPartKey(ctx context.Context, conn *wafv2.Client, webACLARN, name string) (*awstypes.Rule, error) {
+	id, webACLName, scope, err := parseWebACLARN(webACLARN)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := findWebACLByThreePartKey(ctx, conn, id, webACLName, scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(output.WebACL.Rules, webACLRuleNameEquals(name))
+	if i == -1 {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return &output.WebACL.Rules[i], nil
+}
+
+// updateWebACLRules changes the rules of a web ACL, leaving its other settings as they are.
+// The web ACL is read, f applied to its rules and the web ACL updated using the lock token from the read.
+// If the web ACL has been changed in the meantime, this starts over with the changed web ACL.
+func updateWebACLRules(ctx context.Context, conn *wafv2.Client, webACLARN string, timeout time.Duration, f func([]awstypes.Rule) ([]awstypes.Rule, error)) error {
+	id, name, scope, err := parseWebACLARN(webACLARN)
+	if err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryWhenIsOneOf2[*awstypes.WAFOptimisticLockException, *awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
+		output, err := findWebACLByThreePartKey(ctx, conn, id, name, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		webACL := output.WebACL
+		rules, err := f(webACL.Rules)
+
+		if err != nil {
+			return nil, err
+		}
+
+		input := &wafv2.UpdateWebACLInput{
+			AssociationConfig:    webACL.AssociationConfig,
+			CaptchaConfig:        webACL.CaptchaConfig,
+			ChallengeConfig:      webACL.ChallengeConfig,
+			CustomResponseBodies: webACL.CustomResponseBodies,
+			DefaultAction:        webACL.DefaultAction,
+			Description:          webACL.Description,
+			Id:                   aws.String(id),
+			LockToken:            output.LockToken,
+			Name:                 aws.String(name),
+			Rules:                rules,
+			Scope:                awstypes.Scope(scope),
+			TokenDomains:         webACL.TokenDomains,
+			VisibilityConfig:     webACL.VisibilityConfig,
+		}
+
+		return conn.UpdateWebACL(ctx, input)
+	})
+
+	return err
+}
+
+// parseWebACLARN returns the ID, name and scope of a web ACL from its ARN.
+// The ARN's resource is "regional/webacl/<name>/<id>", or "global/webacl/<name>/<id>" for CloudFront.
+func parseWebACLARN(s string) (string, string, string, error) {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+	if len(parts) != 4 || parts[1] != "webacl" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", fmt.Errorf("unexpected format for WAFv2 WebACL ARN (%s)", s)
+	}
+
+	var scope awstypes.Scope
+	switch parts[0] {
+	case "global":
+		scope = awstypes.ScopeCloudfront
+	case "regional":
+		scope = awstypes.ScopeRegional
+	default:
+		return "", "", "", fmt.Errorf("unexpected format for WAFv2 WebACL ARN (%s)", s)
+	}
+
+	return parts[3], parts[2], string(scope), nil
+}
+
+func webACLRuleNameEquals(name string) func(awstypes.Rule) bool {
+	return func(rule awstypes.Rule) bool {
+		return aws.ToString(rule.Name) == name
+	}
+}
+
+// checkWebACLRulePriority returns an error if another of the rules has the rule's priority.
+func checkWebACLRulePriority(rules []awstypes.Rule, rule awstypes.Rule) error {
+	for _, v := range rules {
+		if v.Priority == rule.Priority && aws.ToString(v.Name) != aws.ToString(rule.Name) {
+			return fmt.Errorf("priority (%d) is already used by rule (%s)", rule.Priority, aws.ToString(v.Name))
+		}
+	}
+
+	return nil
+}
+
+func expandWebACLRuleFromResourceData(d *schema.ResourceData) awstypes.Rule {
+	return expandWebACLRule(map[string]interface{}{
+		names.AttrAction:    d.Get(names.AttrAction),
+		"captcha_config":    d.Get("captcha_config"),
+		names.AttrName:      d.Get(names.AttrName),
+		"override_action":   d.Get("override_action"),
+		names.AttrPriority:  d.Get(names.AttrPriority),
+		"rule_label":        d.Get("rule_label"),
+		"statement":         d.Get("statement"),
+		"visibility_config": d.Get("visibility_config"),
+	})
+}
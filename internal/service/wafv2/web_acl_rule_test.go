@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwafv2 "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestParseWebACLARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn                             string
+		expectedID, expectedName, scope string
+		expectError                     bool
+	}{
+		"regional": {
+			arn:          "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", //lintignore:AWSAT003,AWSAT005
+			expectedID:   "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			expectedName: "example",
+			scope:        string(awstypes.ScopeRegional),
+		},
+		"global": {
+			arn:          "arn:aws:wafv2:us-east-1:123456789012:global/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", //lintignore:AWSAT003,AWSAT005
+			expectedID:   "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			expectedName: "example",
+			scope:        string(awstypes.ScopeCloudfront),
+		},
+		"rule group": {
+			arn:         "arn:aws:wafv2:us-west-2:123456789012:regional/rulegroup/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"unknown scope": {
+			arn:         "arn:aws:wafv2:us-west-2:123456789012:local/webacl/example/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"not an ARN": {
+			arn:         "example",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, webACLName, scope, err := tfwafv2.ParseWebACLARN(testCase.arn)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if id != testCase.expectedID || webACLName != testCase.expectedName || scope != testCase.scope {
+				t.Errorf("got (%s, %s, %s), want (%s, %s, %s)", id, webACLName, scope, testCase.expectedID, testCase.expectedName, testCase.scope)
+			}
+		})
+	}
+}
+
+func TestAccWAFV2WebACLRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test"
+	webACLResourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, rName, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.block.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-external"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "20"),
+					resource.TestCheckResourceAttr(resourceName, "statement.0.geo_match_statement.0.country_codes.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", webACLResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(webACLResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(webACLResourceName, "rule.0.name", rName+"-inline"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "30"),
+					resource.TestCheckResourceAttr(webACLResourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config:   testAccWebACLRuleConfig_basic(rName, rName, 30),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLRule_webACLUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test"
+	webACLResourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, "description 1", 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				// The web ACL is updated while keeping the rule managed by aws_wafv2_web_acl_rule.
+				Config: testAccWebACLRuleConfig_basic(rName, "description 2", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "30"),
+					resource.TestCheckResourceAttr(webACLResourceName, names.AttrDescription, "description 2"),
+					resource.TestCheckResourceAttr(webACLResourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLRuleConfig_basic(rName, rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfwafv2.ResourceWebACLRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLRule_priorityConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWebACLRuleConfig_basic(rName, rName, 10),
+				ExpectError: regexache.MustCompile(`priority \(10\) is already used by rule`),
+			},
+		},
+	})
+}
+
+func testAccCheckWebACLRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wafv2_web_acl_rule" {
+				continue
+			}
+
+			_, err := tfwafv2.FindWebACLRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["web_acl_arn"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WAFv2 WebACL Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWebACLRuleExists(ctx context.Context, n string, v *awstypes.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Client(ctx)
+
+		output, err := tfwafv2.FindWebACLRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["web_acl_arn"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWebACLRuleConfig_basic(rName, description string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name                  = %[1]q
+  description           = %[3]q
+  scope                 = "REGIONAL"
+  ignore_external_rules = true
+
+  default_action {
+    allow {}
+  }
+
+  rule {
+    name     = "%[1]s-inline"
+    priority = 10
+
+    action {
+      count {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "%[1]s-inline"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = %[1]q
+    sampled_requests_enabled   = false
+  }
+}
+
+resource "aws_wafv2_web_acl_rule" "test" {
+  web_acl_arn = aws_wafv2_web_acl.test.arn
+  name        = "%[1]s-external"
+  priority    = %[2]d
+
+  action {
+    block {}
+  }
+
+  statement {
+    geo_match_statement {
+      country_codes = ["CA", "MX"]
+    }
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "%[1]s-external"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName, priority, description)
+}
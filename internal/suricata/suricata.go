@@ -0,0 +1,591 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package suricata parses and validates Suricata compatible rules as accepted by AWS Network Firewall stateful rule groups.
+package suricata
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Rule is a single parsed rule.
+type Rule struct {
+	// Line is the line number on which the rule starts.
+	Line int
+
+	Action          string
+	Protocol        string
+	Source          string
+	SourcePort      string
+	Direction       string
+	Destination     string
+	DestinationPort string
+	Options         []Option
+
+	// SID is the value of the rule's sid option.
+	SID int64
+}
+
+// Option is a single keyword option of a rule, e.g. `msg:"example"` or `nocase`.
+type Option struct {
+	Keyword string
+	// Value is the option's value with surrounding whitespace removed, or an empty string if the option has no value.
+	Value string
+}
+
+// Error is an error in a single rule.
+type Error struct {
+	Line    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Variables are the variables that rules can reference.
+type Variables struct {
+	// IPSets are the names of IP set variables, referenced in source and destination addresses as $NAME.
+	IPSets []string
+	// PortSets are the names of port set variables, referenced in source and destination ports as $NAME.
+	PortSets []string
+	// IPSetReferences are the names of IP set references, referenced in source and destination addresses as @NAME.
+	// If nil, IP set references are not checked.
+	IPSetReferences []string
+}
+
+// defaultIPSets are the IP set variables that Network Firewall defines if a rule group doesn't.
+var defaultIPSets = []string{"EXTERNAL_NET", "HOME_NET"}
+
+// Parse parses rules, one per line.
+// Blank lines and lines starting with # are ignored, and a line ending with a backslash is continued on the next line.
+// The errors in all rules are returned, joined.
+func Parse(s string) ([]Rule, error) {
+	var (
+		rules []Rule
+		errs  []error
+		text  strings.Builder
+		start int
+	)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(strings.TrimSuffix(line, "\r"))
+
+		if text.Len() == 0 {
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			start = i + 1
+		}
+
+		if v, ok := strings.CutSuffix(line, `\`); ok && i < len(lines)-1 {
+			text.WriteString(v)
+			text.WriteString(" ")
+			continue
+		}
+
+		text.WriteString(line)
+		rule, err := parseRule(text.String())
+		text.Reset()
+
+		if err != nil {
+			errs = append(errs, &Error{Line: start, Message: err.Error()})
+			continue
+		}
+
+		rule.Line = start
+		rules = append(rules, *rule)
+	}
+
+	return rules, errors.Join(errs...)
+}
+
+// Validate checks that rules reference only the specified variables and that their signature IDs are unique.
+// The errors in all rules are returned, joined.
+func Validate(rules []Rule, vars Variables) error {
+	ipSets := setOf(defaultIPSets...)
+	for _, v := range vars.IPSets {
+		ipSets[v] = true
+	}
+	portSets := setOf(vars.PortSets...)
+	var ipSetReferences map[string]bool
+	if vars.IPSetReferences != nil {
+		ipSetReferences = setOf(vars.IPSetReferences...)
+	}
+
+	checkAddress := func(v string) error {
+		if name, ok := strings.CutPrefix(v, "$"); ok {
+			if portSets[name] && !ipSets[name] {
+				return fmt.Errorf("port set variable $%s used as an address", name)
+			}
+			if !ipSets[name] {
+				return fmt.Errorf("IP set variable $%s is not defined", name)
+			}
+		}
+		if name, ok := strings.CutPrefix(v, "@"); ok && ipSetReferences != nil && !ipSetReferences[name] {
+			return fmt.Errorf("IP set reference @%s is not defined", name)
+		}
+		return nil
+	}
+	checkPort := func(v string) error {
+		if name, ok := strings.CutPrefix(v, "$"); ok {
+			if ipSets[name] && !portSets[name] {
+				return fmt.Errorf("IP set variable $%s used as a port", name)
+			}
+			if !portSets[name] {
+				return fmt.Errorf("port set variable $%s is not defined", name)
+			}
+		}
+		return nil
+	}
+
+	var errs []error
+	sids := make(map[int64]int)
+
+	for _, rule := range rules {
+		for _, v := range []string{rule.Source, rule.Destination} {
+			if err := walkList(v, checkAddress); err != nil {
+				errs = append(errs, &Error{Line: rule.Line, Message: err.Error()})
+			}
+		}
+		for _, v := range []string{rule.SourcePort, rule.DestinationPort} {
+			if err := walkList(v, checkPort); err != nil {
+				errs = append(errs, &Error{Line: rule.Line, Message: err.Error()})
+			}
+		}
+
+		if line, ok := sids[rule.SID]; ok {
+			errs = append(errs, &Error{Line: rule.Line, Message: fmt.Sprintf("sid %d is already used by the rule on line %d", rule.SID, line)})
+		} else {
+			sids[rule.SID] = rule.Line
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// EstimateCapacity returns the capacity that rules consume in a stateful rule group.
+// Network Firewall counts each Suricata compatible rule as one unit of capacity.
+func EstimateCapacity(rules []Rule) int {
+	return len(rules)
+}
+
+// Warnings returns possible problems in rules that don't prevent them from being parsed:
+// keywords that aren't known, which may be supported by Network Firewall's version of Suricata, and unknown flow options.
+func Warnings(rules []Rule) []*Error {
+	var warnings []*Error
+
+	for _, rule := range rules {
+		for _, option := range rule.Options {
+			if !isKeyword(option.Keyword) {
+				warnings = append(warnings, &Error{Line: rule.Line, Message: fmt.Sprintf("unknown keyword %q", option.Keyword)})
+				continue
+			}
+
+			if option.Keyword == "flow" {
+				for _, v := range strings.Split(option.Value, ",") {
+					if v := strings.TrimSpace(v); !flowOptions[v] {
+						warnings = append(warnings, &Error{Line: rule.Line, Message: fmt.Sprintf("unknown flow option %q", v)})
+					}
+				}
+			}
+		}
+	}
+
+	return warnings
+}
+
+func parseRule(s string) (*Rule, error) {
+	i := strings.Index(s, "(")
+	if i < 0 {
+		return nil, errors.New("rule has no options")
+	}
+
+	header, err := splitHeader(s[:i])
+	if err != nil {
+		return nil, err
+	}
+	if len(header) != 7 {
+		return nil, fmt.Errorf("rule header %q must have the form: action protocol source source_port direction destination destination_port", strings.TrimSpace(s[:i]))
+	}
+
+	rule := &Rule{
+		Action:          header[0],
+		Protocol:        header[1],
+		Source:          header[2],
+		SourcePort:      header[3],
+		Direction:       header[4],
+		Destination:     header[5],
+		DestinationPort: header[6],
+	}
+
+	if !actions[rule.Action] {
+		return nil, fmt.Errorf("unsupported action %q", rule.Action)
+	}
+	if !protocols[strings.ToLower(rule.Protocol)] {
+		return nil, fmt.Errorf("unsupported protocol %q", rule.Protocol)
+	}
+	if rule.Direction != "->" && rule.Direction != "<>" {
+		return nil, fmt.Errorf("invalid direction %q, must be -> or <>", rule.Direction)
+	}
+	for _, v := range []string{rule.Source, rule.Destination} {
+		if err := walkList(v, validateAddress); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range []string{rule.SourcePort, rule.DestinationPort} {
+		if err := walkList(v, validatePort); err != nil {
+			return nil, err
+		}
+	}
+
+	body, ok := strings.CutSuffix(s[i+1:], ")")
+	if !ok {
+		return nil, errors.New("rule options must end with )")
+	}
+
+	rule.Options, err = parseOptions(body)
+	if err != nil {
+		return nil, err
+	}
+
+	hasSID := false
+	for _, option := range rule.Options {
+		if option.Keyword == "sid" {
+			if hasSID {
+				return nil, errors.New("rule has more than one sid")
+			}
+			hasSID = true
+			rule.SID, _ = strconv.ParseInt(option.Value, 10, 64)
+		}
+	}
+	if !hasSID {
+		return nil, errors.New("rule has no sid")
+	}
+
+	return rule, nil
+}
+
+// splitHeader splits a rule header into fields separated by whitespace outside of lists.
+func splitHeader(s string) ([]string, error) {
+	var (
+		fields []string
+		depth  int
+		field  strings.Builder
+	)
+
+	for _, c := range s {
+		switch {
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced ] in rule header")
+			}
+		case (c == ' ' || c == '\t') && depth == 0:
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+			continue
+		}
+		field.WriteRune(c)
+	}
+
+	if depth != 0 {
+		return nil, errors.New("unbalanced [ in rule header")
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+
+	return fields, nil
+}
+
+// walkList calls f for each value in an address or port specification, which may be negated with ! or be a list in square brackets.
+func walkList(s string, f func(string) error) error {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "!")
+
+	if s == "" {
+		return errors.New("empty address or port")
+	}
+
+	if strings.HasPrefix(s, "[") {
+		if !strings.HasSuffix(s, "]") {
+			return fmt.Errorf("invalid list %q", s)
+		}
+
+		var (
+			depth, start int
+			items        []string
+		)
+		inner := s[1 : len(s)-1]
+		for i, c := range inner {
+			switch c {
+			case '[':
+				depth++
+			case ']':
+				depth--
+				if depth < 0 {
+					return fmt.Errorf("invalid list %q", s)
+				}
+			case ',':
+				if depth == 0 {
+					items = append(items, inner[start:i])
+					start = i + 1
+				}
+			}
+		}
+		items = append(items, inner[start:])
+
+		for _, item := range items {
+			if err := walkList(item, f); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if strings.ContainsAny(s, "[], \t") {
+		return fmt.Errorf("invalid address or port %q", s)
+	}
+
+	return f(s)
+}
+
+var variableNameRegexp = regexp.MustCompile(`^[A-Za-z][0-9A-Za-z_]*$`)
+
+func validateVariableName(s string) error {
+	if !variableNameRegexp.MatchString(s[1:]) {
+		return fmt.Errorf("invalid variable name %q", s)
+	}
+	return nil
+}
+
+func validateAddress(s string) error {
+	switch {
+	case s == "any":
+		return nil
+	case strings.HasPrefix(s, "$"), strings.HasPrefix(s, "@"):
+		return validateVariableName(s)
+	}
+
+	if from, to, ok := strings.Cut(s, "-"); ok {
+		if _, err := netip.ParseAddr(from); err == nil {
+			if _, err := netip.ParseAddr(to); err == nil {
+				return nil
+			}
+		}
+	} else if strings.Contains(s, "/") {
+		if _, err := netip.ParsePrefix(s); err == nil {
+			return nil
+		}
+	} else if _, err := netip.ParseAddr(s); err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("invalid address %q", s)
+}
+
+func validatePort(s string) error {
+	switch {
+	case s == "any":
+		return nil
+	case strings.HasPrefix(s, "$"):
+		return validateVariableName(s)
+	}
+
+	from, to, isRange := strings.Cut(s, ":")
+	if !isRange {
+		to = from
+	}
+	for _, v := range []string{from, to} {
+		if v == "" && isRange {
+			continue
+		}
+		if n, err := strconv.Atoi(v); err != nil || n < 0 || n > 65535 {
+			return fmt.Errorf("invalid port %q", s)
+		}
+	}
+	if from == "" && to == "" {
+		return fmt.Errorf("invalid port %q", s)
+	}
+
+	return nil
+}
+
+// parseOptions parses the semicolon terminated keyword options of a rule.
+func parseOptions(s string) ([]Option, error) {
+	var (
+		options []Option
+		option  strings.Builder
+		quoted  bool
+		escaped bool
+	)
+
+	for _, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case c == ';' && !quoted:
+			v, err := parseOption(option.String())
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, v)
+			option.Reset()
+			continue
+		}
+		option.WriteRune(c)
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string in option %q", strings.TrimSpace(option.String()))
+	}
+	if v := strings.TrimSpace(option.String()); v != "" {
+		return nil, fmt.Errorf("option %q is not terminated by a semicolon", v)
+	}
+
+	return options, nil
+}
+
+func parseOption(s string) (Option, error) {
+	keyword, value, _ := strings.Cut(s, ":")
+	option := Option{
+		Keyword: strings.TrimSpace(keyword),
+		Value:   strings.TrimSpace(value),
+	}
+
+	if option.Keyword == "" {
+		return option, errors.New("empty option")
+	}
+	if !keywordRegexp.MatchString(option.Keyword) {
+		return option, fmt.Errorf("invalid keyword %q", option.Keyword)
+	}
+	if err := validateOptionValue(option); err != nil {
+		return option, fmt.Errorf("invalid %s option: %w", option.Keyword, err)
+	}
+
+	return option, nil
+}
+
+func validateOptionValue(option Option) error {
+	value := option.Value
+
+	switch option.Keyword {
+	case "classtype", "flowbits", "metadata", "msg", "reference", "threshold":
+		if value == "" {
+			return errors.New("value is required")
+		}
+	case "content", "pcre":
+		if !strings.HasPrefix(strings.TrimPrefix(value, "!"), `"`) || !strings.HasSuffix(value, `"`) || len(strings.TrimPrefix(value, "!")) < 2 {
+			return errors.New("value must be a quoted string")
+		}
+	case "flow":
+		if value == "" {
+			return errors.New("value is required")
+		}
+	case "gid", "rev":
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return errors.New("value must be a non-negative integer")
+		}
+	case "priority":
+		if n, err := strconv.Atoi(value); err != nil || n < 1 || n > 255 {
+			return errors.New("value must be an integer between 1 and 255")
+		}
+	case "sid":
+		if n, err := strconv.ParseUint(value, 10, 32); err != nil || n == 0 {
+			return errors.New("value must be a positive integer")
+		}
+	}
+
+	return nil
+}
+
+var keywordRegexp = regexp.MustCompile(`^[A-Za-z][0-9A-Za-z_.\-]*$`)
+
+// isKeyword returns whether s is a known rule keyword.
+// Keywords with the prefix of an application layer protocol, e.g. `tls.sni`, are assumed to be valid.
+func isKeyword(s string) bool {
+	if keywords[s] {
+		return true
+	}
+
+	if prefix, _, ok := strings.Cut(s, "."); ok {
+		return keywordPrefixes[prefix]
+	}
+
+	return false
+}
+
+func setOf(values ...string) map[string]bool {
+	m := make(map[string]bool, len(values))
+	for _, v := range values {
+		m[v] = true
+	}
+	return m
+}
+
+var actions = setOf("alert", "drop", "pass", "reject", "rejectboth", "rejectdst", "rejectsrc")
+
+var protocols = setOf(
+	"bittorrent-dht", "dcerpc", "dhcp", "dnp3", "dns", "enip", "ftp", "ftp-data", "http", "http1", "http2", "icmp", "icmpv4", "icmpv6",
+	"ike", "ikev2", "imap", "ip", "ipv4", "ipv6", "krb5", "ldap", "modbus", "mqtt", "nfs", "ntp", "pgsql", "pkthdr", "pop3", "quic",
+	"rdp", "rfb", "sctp", "sip", "smb", "smtp", "snmp", "ssh", "tcp", "tcp-pkt", "tcp-stream", "telnet", "tftp", "tls", "udp", "websocket",
+)
+
+var flowOptions = setOf(
+	"established", "from_client", "from_server", "no_frag", "no_stream", "not_established", "only_frag", "only_stream", "stateless",
+	"to_client", "to_server",
+)
+
+var keywords = setOf(
+	// Meta keywords.
+	"classtype", "gid", "metadata", "msg", "priority", "reference", "requires", "rev", "sid", "target",
+	// IP, TCP, UDP and ICMP keywords.
+	"ack", "dsize", "flags", "fragbits", "fragoffset", "icmp_id", "icmp_seq", "icode", "id", "ip_proto", "ipopts", "itype", "sameip",
+	"seq", "tos", "ttl", "window",
+	// Flow keywords.
+	"flow", "flowbits", "flowint", "flowvar", "hostbits", "pktvar", "stream_size", "xbits",
+	// Payload keywords and modifiers.
+	"base64_data", "base64_decode", "bsize", "byte_extract", "byte_jump", "byte_math", "byte_test", "content", "depth", "distance",
+	"endswith", "fast_pattern", "isdataat", "nocase", "offset", "pcre", "pkt_data", "prefilter", "rawbytes", "replace", "rpc",
+	"startswith", "uricontent", "within",
+	// Transformations.
+	"compress_whitespace", "dotprefix", "header_lowercase", "pcrexform", "strip_pseudo_headers", "strip_whitespace", "to_lowercase",
+	"to_md5", "to_sha1", "to_sha256", "to_uppercase", "url_decode", "xor",
+	// Legacy content modifiers and sticky buffers.
+	"file_data", "http_accept", "http_accept_enc", "http_accept_lang", "http_client_body", "http_connection", "http_content_len",
+	"http_content_type", "http_cookie", "http_header", "http_header_names", "http_host", "http_method", "http_protocol", "http_raw_header",
+	"http_raw_host", "http_raw_uri", "http_referer", "http_request_line", "http_response_line", "http_server_body", "http_start",
+	"http_stat_code", "http_stat_msg", "http_uri", "http_user_agent", "urilen",
+	"dns_query", "ssh_proto", "ssh_software", "tls_cert_fingerprint", "tls_cert_issuer", "tls_cert_notafter", "tls_cert_notbefore",
+	"tls_cert_serial", "tls_cert_subject", "tls_cert_valid", "tls_cert_expired", "tls_sni",
+	// Application layer keywords.
+	"app-layer-event", "app-layer-protocol", "asn1", "cip_service", "dce_iface", "dce_opnum", "dce_stub_data", "dnp3_data", "dnp3_func",
+	"dnp3_ind", "dnp3_obj", "enip_command", "ftpbounce", "ftpdata_command", "krb5_cname", "krb5_err_code", "krb5_msg_type",
+	"krb5_sname", "modbus", "nfs_procedure", "ssl_state", "ssl_version", "template",
+	// File keywords.
+	"filemagic", "filemd5", "filename", "fileext", "filesha1", "filesha256", "filesize", "filestore",
+	// Other keywords.
+	"bypass", "config", "datarep", "dataset", "decode-event", "detection_filter", "engine-event", "geoip", "iprep", "lua", "luajit",
+	"noalert", "stream-event", "tag", "threshold",
+)
+
+var keywordPrefixes = setOf(
+	"dcerpc", "dhcp", "dnp3", "dns", "email", "enip", "file", "flow", "frame", "ftp", "http", "http2", "icmpv4", "icmpv6", "ike", "ip",
+	"ipv4", "ipv6", "ja3", "ja3s", "ja4", "krb5", "ldap", "modbus", "mqtt", "nfs", "pgsql", "quic", "rfb", "sip", "smb", "smtp", "snmp",
+	"ssh", "tcp", "tls", "udp", "websocket",
+)
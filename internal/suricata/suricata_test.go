@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suricata
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     string
+		want      []Rule
+		wantError string
+	}{
+		"empty": {
+			input: "# nothing here\n\n",
+		},
+		"full": {
+			input: `# Allow TLS to an allowed domain.
+pass tls $HOME_NET any -> $EXTERNAL_NET 443 (tls.sni; content:"example.com"; nocase; endswith; msg:"allowed; domain"; sid:1; rev:2;)
+
+drop tcp [10.0.0.0/8, !10.1.0.0/16] [1024:, !8080] <> any any \
+  (flow:established,to_server; msg:"escaped \"quote\" and \; semicolon"; sid:2;)
+`,
+			want: []Rule{
+				{
+					Line:            2,
+					Action:          "pass",
+					Protocol:        "tls",
+					Source:          "$HOME_NET",
+					SourcePort:      "any",
+					Direction:       "->",
+					Destination:     "$EXTERNAL_NET",
+					DestinationPort: "443",
+					Options: []Option{
+						{Keyword: "tls.sni"},
+						{Keyword: "content", Value: `"example.com"`},
+						{Keyword: "nocase"},
+						{Keyword: "endswith"},
+						{Keyword: "msg", Value: `"allowed; domain"`},
+						{Keyword: "sid", Value: "1"},
+						{Keyword: "rev", Value: "2"},
+					},
+					SID: 1,
+				},
+				{
+					Line:            4,
+					Action:          "drop",
+					Protocol:        "tcp",
+					Source:          "[10.0.0.0/8, !10.1.0.0/16]",
+					SourcePort:      "[1024:, !8080]",
+					Direction:       "<>",
+					Destination:     "any",
+					DestinationPort: "any",
+					Options: []Option{
+						{Keyword: "flow", Value: "established,to_server"},
+						{Keyword: "msg", Value: `"escaped \"quote\" and \; semicolon"`},
+						{Keyword: "sid", Value: "2"},
+					},
+					SID: 2,
+				},
+			},
+		},
+		"address forms": {
+			input: `alert ip [192.0.2.1-192.0.2.9,2001:db8::/32,[@REF,!$HOME_NET]] any -> 192.0.2.10 :1023 (sid:1;)`,
+			want: []Rule{
+				{
+					Line:            1,
+					Action:          "alert",
+					Protocol:        "ip",
+					Source:          "[192.0.2.1-192.0.2.9,2001:db8::/32,[@REF,!$HOME_NET]]",
+					SourcePort:      "any",
+					Direction:       "->",
+					Destination:     "192.0.2.10",
+					DestinationPort: ":1023",
+					Options:         []Option{{Keyword: "sid", Value: "1"}},
+					SID:             1,
+				},
+			},
+		},
+		"unsupported action": {
+			input:     `allow tcp any any -> any any (sid:1;)`,
+			wantError: `line 1: unsupported action "allow"`,
+		},
+		"unsupported protocol": {
+			input:     `alert tpc any any -> any any (sid:1;)`,
+			wantError: `line 1: unsupported protocol "tpc"`,
+		},
+		"invalid direction": {
+			input:     `alert tcp any any <- any any (sid:1;)`,
+			wantError: `line 1: invalid direction "<-", must be -> or <>`,
+		},
+		"missing header field": {
+			input:     `alert tcp any -> any any (sid:1;)`,
+			wantError: `line 1: rule header "alert tcp any -> any any" must have the form: action protocol source source_port direction destination destination_port`,
+		},
+		"invalid address": {
+			input:     `alert tcp 10.0.0.0/33 any -> any any (sid:1;)`,
+			wantError: `line 1: invalid address "10.0.0.0/33"`,
+		},
+		"invalid port": {
+			input:     `alert tcp any any -> any 65536 (sid:1;)`,
+			wantError: `line 1: invalid port "65536"`,
+		},
+		"unbalanced list": {
+			input:     `alert tcp [10.0.0.0/8 any -> any any (sid:1;)`,
+			wantError: `line 1: unbalanced [ in rule header`,
+		},
+		"no options": {
+			input:     `alert tcp any any -> any any`,
+			wantError: `line 1: rule has no options`,
+		},
+		"unterminated option": {
+			input:     `alert tcp any any -> any any (msg:"example"; sid:1)`,
+			wantError: `line 1: option "sid:1" is not terminated by a semicolon`,
+		},
+		"unterminated string": {
+			input:     `alert tcp any any -> any any (msg:"example; sid:1;)`,
+			wantError: `line 1: unterminated quoted string in option "msg:\"example; sid:1;"`,
+		},
+		"unknown keyword": {
+			input: `alert tcp any any -> any any (pkt_data; asn1:oversize_length 100; mgs:"example"; sid:1;)`,
+			want: []Rule{
+				{
+					Line:            1,
+					Action:          "alert",
+					Protocol:        "tcp",
+					Source:          "any",
+					SourcePort:      "any",
+					Direction:       "->",
+					Destination:     "any",
+					DestinationPort: "any",
+					Options: []Option{
+						{Keyword: "pkt_data"},
+						{Keyword: "asn1", Value: "oversize_length 100"},
+						{Keyword: "mgs", Value: `"example"`},
+						{Keyword: "sid", Value: "1"},
+					},
+					SID: 1,
+				},
+			},
+		},
+		"invalid keyword": {
+			input:     `alert tcp any any -> any any (msg "example"; sid:1;)`,
+			wantError: `line 1: invalid keyword "msg \"example\""`,
+		},
+		"unquoted content": {
+			input:     `alert tcp any any -> any any (content:example; sid:1;)`,
+			wantError: `line 1: invalid content option: value must be a quoted string`,
+		},
+		"no sid": {
+			input:     `alert tcp any any -> any any (msg:"example";)`,
+			wantError: `line 1: rule has no sid`,
+		},
+		"invalid sid": {
+			input:     `alert tcp any any -> any any (sid:0;)`,
+			wantError: `line 1: invalid sid option: value must be a positive integer`,
+		},
+		"multiple errors": {
+			input:     "alert tcp any any -> any any (sid:1;)\nalert tcp any any -> any any (msg:\"a\";)\n\nallow tcp any any -> any any (sid:3;)\n",
+			wantError: "line 2: rule has no sid\nline 4: unsupported action \"allow\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(testCase.input)
+
+			if testCase.wantError != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got, want := err.Error(), testCase.wantError; got != want {
+					t.Errorf("error = %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	vars := Variables{
+		IPSets:          []string{"WEB_SERVERS"},
+		PortSets:        []string{"WEB_PORTS"},
+		IPSetReferences: []string{"BLOCKED"},
+	}
+
+	testCases := map[string]struct {
+		input     string
+		vars      Variables
+		wantError string
+	}{
+		"valid": {
+			input: `pass tcp $HOME_NET any -> [$WEB_SERVERS,!@BLOCKED] $WEB_PORTS (sid:1;)
+drop tcp $EXTERNAL_NET any -> $WEB_SERVERS ![$WEB_PORTS,22] (sid:2;)`,
+			vars: vars,
+		},
+		"undefined IP set": {
+			input:     `pass tcp $HOME_NET any -> $DB_SERVERS any (sid:1;)`,
+			vars:      vars,
+			wantError: `line 1: IP set variable $DB_SERVERS is not defined`,
+		},
+		"undefined port set": {
+			input:     `pass tcp $HOME_NET any -> any $DB_PORTS (sid:1;)`,
+			vars:      vars,
+			wantError: `line 1: port set variable $DB_PORTS is not defined`,
+		},
+		"port set as address": {
+			input:     `pass tcp $WEB_PORTS any -> any any (sid:1;)`,
+			vars:      vars,
+			wantError: `line 1: port set variable $WEB_PORTS used as an address`,
+		},
+		"IP set as port": {
+			input:     `pass tcp any $HOME_NET -> any any (sid:1;)`,
+			vars:      vars,
+			wantError: `line 1: IP set variable $HOME_NET used as a port`,
+		},
+		"undefined reference": {
+			input:     `drop ip @ALLOWED any -> any any (sid:1;)`,
+			vars:      vars,
+			wantError: `line 1: IP set reference @ALLOWED is not defined`,
+		},
+		"unchecked references": {
+			input: `drop ip @ALLOWED any -> any any (sid:1;)`,
+		},
+		"duplicate sid": {
+			input: `pass tcp any any -> any 80 (sid:1;)
+# comment
+pass tcp any any -> any 443 (sid:2;)
+drop tcp any any -> any any (sid:1;)`,
+			wantError: `line 4: sid 1 is already used by the rule on line 1`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rules, err := Parse(testCase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = Validate(rules, testCase.vars)
+
+			if testCase.wantError != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got, want := err.Error(), testCase.wantError; got != want {
+					t.Errorf("error = %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestEstimateCapacity(t *testing.T) {
+	t.Parallel()
+
+	rules, err := Parse(`pass tcp any any -> any 80 (sid:1;)
+pass tcp any any -> any 443 (sid:2;)
+# drop tcp any any -> any any (sid:3;)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := EstimateCapacity(rules), 2; got != want {
+		t.Errorf("EstimateCapacity() = %d, want %d", got, want)
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	t.Parallel()
+
+	rules, err := Parse(`pass tcp any any -> any 80 (uricontent:"/index.html"; sid:1;)
+# drop tcp any any -> any any (contnet:"example"; sid:2;)
+drop tcp any any -> any any (flow:established,to_sever; contnet:"example"; sid:3;)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+	for _, warning := range Warnings(rules) {
+		got = append(got, warning.Error())
+	}
+	want := []string{
+		`line 3: unknown flow option "to_sever"`,
+		`line 3: unknown keyword "contnet"`,
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
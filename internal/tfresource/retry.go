@@ -92,6 +92,18 @@ func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T,
 	return output, nil
 }
 
+// RetryGWhenIAMPropagating retries the function `f` when the error it returns satisfies `retryable`,
+// i.e. when an IAM principal referenced by `f` may still be propagating.
+// `timeout` is usually obtained from `conns.AWSClient.IAMPropagationTimeout`.
+// If `timeout` is not positive `f` is called exactly once.
+func RetryGWhenIAMPropagating[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
+	if timeout <= 0 {
+		return f()
+	}
+
+	return RetryGWhen(ctx, timeout, f, retryable)
+}
+
 // RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:ci.aws-in-func-name
 	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
@@ -174,6 +174,88 @@ func TestRetryWhenNewResourceNotFound(t *testing.T) { //nolint:tparallel
 	}
 }
 
+func TestRetryGWhenIAMPropagating(t *testing.T) { //nolint:tparallel
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	var callCount int32
+
+	retryable := func(err error) (bool, error) {
+		if err != nil && err.Error() == "cannot be assumed" {
+			return true, err
+		}
+
+		return false, err
+	}
+
+	testCases := []struct {
+		Name          string
+		F             func() (int32, error)
+		Timeout       time.Duration
+		ExpectError   bool
+		ExpectedCalls int32
+	}{
+		{
+			Name: "no error",
+			F: func() (int32, error) {
+				return atomic.AddInt32(&callCount, 1), nil
+			},
+			Timeout:       5 * time.Second,
+			ExpectedCalls: 1,
+		},
+		{
+			Name: "retryable error not propagating",
+			F: func() (int32, error) {
+				atomic.AddInt32(&callCount, 1)
+				return 0, errors.New("cannot be assumed")
+			},
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+		{
+			Name: "retryable error success propagating",
+			F: func() (int32, error) {
+				n := atomic.AddInt32(&callCount, 1)
+				if n == 1 {
+					return 0, errors.New("cannot be assumed")
+				}
+
+				return n, nil
+			},
+			Timeout:       5 * time.Second,
+			ExpectedCalls: 2,
+		},
+		{
+			Name: "non-retryable error propagating",
+			F: func() (int32, error) {
+				atomic.AddInt32(&callCount, 1)
+				return 0, errors.New("TestCode")
+			},
+			Timeout:       5 * time.Second,
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		t.Run(testCase.Name, func(t *testing.T) {
+			callCount = 0
+
+			_, err := tfresource.RetryGWhenIAMPropagating(ctx, testCase.Timeout, testCase.F, retryable)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := atomic.LoadInt32(&callCount), testCase.ExpectedCalls; got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
 func TestRetryWhenNotFound(t *testing.T) { //nolint:tparallel
 	ctx := acctest.Context(t)
 	t.Parallel()
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// fileExporter is an OpenTelemetry span exporter that appends spans to a file as JSON lines.
+type fileExporter struct {
+	encoder *json.Encoder
+	file    *os.File
+	lock    sync.Mutex
+}
+
+var _ sdktrace.SpanExporter = (*fileExporter)(nil)
+
+func newFileExporter(path string) (*fileExporter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening traces file: %w", err)
+	}
+
+	return &fileExporter{
+		encoder: json.NewEncoder(file),
+		file:    file,
+	}, nil
+}
+
+// fileSpan is the JSON representation of a span written to the traces file.
+type fileSpan struct {
+	Name         string          `json:"name"`
+	TraceID      string          `json:"trace_id"`
+	SpanID       string          `json:"span_id"`
+	ParentSpanID string          `json:"parent_span_id,omitempty"`
+	Kind         string          `json:"kind"`
+	StartTime    time.Time       `json:"start_time"`
+	EndTime      time.Time       `json:"end_time"`
+	DurationMS   float64         `json:"duration_ms"`
+	Attributes   map[string]any  `json:"attributes,omitempty"`
+	Events       []fileSpanEvent `json:"events,omitempty"`
+	Status       fileSpanStatus  `json:"status"`
+	Resource     map[string]any  `json:"resource,omitempty"`
+}
+
+type fileSpanEvent struct {
+	Name       string         `json:"name"`
+	Time       time.Time      `json:"time"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+type fileSpanStatus struct {
+	Code        string `json:"code"`
+	Description string `json:"description,omitempty"`
+}
+
+func (e *fileExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.file == nil {
+		return nil
+	}
+
+	for _, span := range spans {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := e.encoder.Encode(newFileSpan(span)); err != nil {
+			return fmt.Errorf("writing span: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *fileExporter) Shutdown(context.Context) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.file == nil {
+		return nil
+	}
+
+	err := e.file.Close()
+	e.file = nil
+
+	return err
+}
+
+func newFileSpan(span sdktrace.ReadOnlySpan) fileSpan {
+	v := fileSpan{
+		Name:       span.Name(),
+		TraceID:    span.SpanContext().TraceID().String(),
+		SpanID:     span.SpanContext().SpanID().String(),
+		Kind:       span.SpanKind().String(),
+		StartTime:  span.StartTime(),
+		EndTime:    span.EndTime(),
+		DurationMS: float64(span.EndTime().Sub(span.StartTime())) / float64(time.Millisecond),
+		Attributes: attributesMap(span.Attributes()),
+		Status: fileSpanStatus{
+			Code:        span.Status().Code.String(),
+			Description: span.Status().Description,
+		},
+	}
+
+	if parent := span.Parent(); parent.IsValid() {
+		v.ParentSpanID = parent.SpanID().String()
+	}
+
+	for _, event := range span.Events() {
+		v.Events = append(v.Events, fileSpanEvent{
+			Name:       event.Name,
+			Time:       event.Time,
+			Attributes: attributesMap(event.Attributes),
+		})
+	}
+
+	if resource := span.Resource(); resource != nil {
+		v.Resource = attributesMap(resource.Attributes())
+	}
+
+	return v
+}
+
+func attributesMap(attributes []attribute.KeyValue) map[string]any {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	m := make(map[string]any, len(attributes))
+
+	for _, attribute := range attributes {
+		m[string(attribute.Key)] = attribute.Value.AsInterface()
+	}
+
+	return m
+}
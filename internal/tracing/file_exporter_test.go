@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestFileExporter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	exporter, err := newFileExporter(path)
+	if err != nil {
+		t.Fatalf("creating exporter: %s", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracer := tracerProvider.Tracer(instrumentationName)
+
+	ctx, parent := tracer.Start(ctx, spanName("aws_sqs_queue", "Create"))
+	_, child := tracer.Start(ctx, spanName("SQS", "CreateQueue"))
+	child.SetAttributes(AttrAWSRetryCount.Int(2), AttrAWSThrottleCount.Int(1))
+	EndSpan(child, errors.New("ThrottlingException"))
+	EndSpan(parent, nil)
+
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		t.Fatalf("shutting down tracer provider: %s", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening traces file: %s", err)
+	}
+	defer file.Close()
+
+	var spans []fileSpan
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var v fileSpan
+		if err := json.Unmarshal(scanner.Bytes(), &v); err != nil {
+			t.Fatalf("unmarshaling span: %s", err)
+		}
+		spans = append(spans, v)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("reading traces file: %s", err)
+	}
+
+	if got, want := len(spans), 2; got != want {
+		t.Fatalf("spans = %d, want %d", got, want)
+	}
+
+	// Child ends first.
+	childSpan, parentSpan := spans[0], spans[1]
+
+	if got, want := childSpan.Name, "SQS.CreateQueue"; got != want {
+		t.Errorf("child name = %q, want %q", got, want)
+	}
+	if got, want := parentSpan.Name, "aws_sqs_queue.Create"; got != want {
+		t.Errorf("parent name = %q, want %q", got, want)
+	}
+	if childSpan.TraceID != parentSpan.TraceID {
+		t.Errorf("child trace ID = %q, want %q", childSpan.TraceID, parentSpan.TraceID)
+	}
+	if childSpan.ParentSpanID != parentSpan.SpanID {
+		t.Errorf("child parent span ID = %q, want %q", childSpan.ParentSpanID, parentSpan.SpanID)
+	}
+	if parentSpan.ParentSpanID != "" {
+		t.Errorf("parent parent span ID = %q, want empty", parentSpan.ParentSpanID)
+	}
+	if got, want := childSpan.Status.Code, "Error"; got != want {
+		t.Errorf("child status = %q, want %q", got, want)
+	}
+	if got, want := parentSpan.Status.Code, "Unset"; got != want {
+		t.Errorf("parent status = %q, want %q", got, want)
+	}
+	// JSON numbers are unmarshaled as float64.
+	if got, want := childSpan.Attributes[string(AttrAWSRetryCount)], float64(2); got != want {
+		t.Errorf("child retry count = %v, want %v", got, want)
+	}
+	if got, want := len(childSpan.Events), 1; got != want {
+		t.Errorf("child events = %d, want %d", got, want)
+	}
+}
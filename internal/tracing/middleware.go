@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	middlewareID    = "TerraformProviderAWSTracing"
+	rpcSystemAWSAPI = "aws-api"
+)
+
+// AppendMiddlewares adds the API call tracing middleware to the specified AWS SDK for Go v2 API options.
+// Each API call, including all of its retry attempts, is traced as a single span.
+func AppendMiddlewares(apiOptions *[]func(*middleware.Stack) error) {
+	*apiOptions = append(*apiOptions, func(stack *middleware.Stack) error {
+		// Add after the service metadata is registered so that the service ID and operation name are available,
+		// and in the Initialize step so that the span covers the retry loop.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(middlewareID, handleInitialize), middleware.After)
+	})
+}
+
+func handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	serviceID, operationName := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+
+	ctx, span := Tracer().Start(ctx, spanName(serviceID, operationName),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttrRPCMethod.String(operationName),
+			AttrCloudRegion.String(awsmiddleware.GetRegion(ctx)),
+			AttrRPCService.String(serviceID),
+			AttrRPCSystem.String(rpcSystemAWSAPI),
+		),
+	)
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	span.SetAttributes(apiCallAttributes(metadata)...)
+	EndSpan(span, err)
+
+	return out, metadata, err
+}
+
+// apiCallAttributes returns span attributes describing a completed API call.
+func apiCallAttributes(metadata middleware.Metadata) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+
+	if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		attributes = append(attributes, AttrAWSRequestID.String(v))
+	}
+
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+		var throttleCount int
+		throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+		for _, result := range v.Results {
+			if err := result.Err; err != nil && throttles.IsErrorThrottle(err).Bool() {
+				throttleCount++
+			}
+		}
+
+		attributes = append(attributes,
+			AttrAWSRetryCount.Int(len(v.Results)-1),
+			AttrAWSThrottleCount.Int(throttleCount),
+		)
+	}
+
+	return attributes
+}
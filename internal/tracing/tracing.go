@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tracing implements OpenTelemetry tracing of provider operations and AWS API calls.
+//
+// Tracing is disabled unless the TF_AWS_OTEL_TRACES_EXPORTER environment variable is set when the provider starts.
+// Each resource or data source CRUD operation gets a span, started in the interceptor layer,
+// and each AWS API call made while handling the operation gets a child span.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvVarTracesExporter selects the span exporter.
+	// Valid values are "otlp" and "file". Tracing is disabled if unset or empty.
+	EnvVarTracesExporter = "TF_AWS_OTEL_TRACES_EXPORTER"
+	// EnvVarTracesFile is the path of the file spans are appended to when the "file" exporter is selected.
+	EnvVarTracesFile = "TF_AWS_OTEL_TRACES_FILE"
+
+	// ExporterFile writes spans as JSON lines to the file named by TF_AWS_OTEL_TRACES_FILE.
+	ExporterFile = "file"
+	// ExporterOTLP sends spans using OTLP over HTTP.
+	// The standard OTEL_EXPORTER_OTLP_* environment variables configure the exporter.
+	// By default spans are sent to a collector listening on localhost:4318.
+	ExporterOTLP = "otlp"
+)
+
+const (
+	instrumentationName = "github.com/hashicorp/terraform-provider-aws"
+	serviceName         = "terraform-provider-aws"
+)
+
+// Span attribute keys.
+const (
+	AttrAWSRequestID     = attribute.Key("aws.request_id")
+	AttrAWSRetryCount    = attribute.Key("aws.retry_count")
+	AttrAWSThrottleCount = attribute.Key("aws.throttle_count")
+	AttrCloudRegion      = attribute.Key("cloud.region")
+	AttrRPCMethod        = attribute.Key("rpc.method")
+	AttrRPCService       = attribute.Key("rpc.service")
+	AttrRPCSystem        = attribute.Key("rpc.system")
+	AttrServiceName      = attribute.Key("service.name")
+	AttrServiceVersion   = attribute.Key("service.version")
+	AttrTFDataSource     = attribute.Key("tf_aws.data_source")
+	AttrTFOperation      = attribute.Key("tf_aws.operation")
+	AttrTFResourceType   = attribute.Key("tf_aws.resource_type")
+	AttrTFServicePackage = attribute.Key("tf_aws.service_package")
+)
+
+var enabled atomic.Bool
+
+// Enabled returns whether tracing has been configured for this process.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Configure installs the global OpenTelemetry tracer provider according to the process environment.
+// The returned function flushes any buffered spans and stops the tracer provider; it must be called before the process exits.
+func Configure(ctx context.Context, providerVersion string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch v := os.Getenv(EnvVarTracesExporter); v {
+	case "":
+		return noop, nil
+	case ExporterFile:
+		path := os.Getenv(EnvVarTracesFile)
+		if path == "" {
+			return noop, fmt.Errorf("%s must be set when %s is %q", EnvVarTracesFile, EnvVarTracesExporter, v)
+		}
+
+		exporter, err = newFileExporter(path)
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return noop, fmt.Errorf("unsupported %s value: %q", EnvVarTracesExporter, v)
+	}
+
+	if err != nil {
+		return noop, fmt.Errorf("creating OpenTelemetry span exporter: %w", err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			AttrServiceName.String(serviceName),
+			AttrServiceVersion.String(providerVersion),
+		)),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+	enabled.Store(true)
+
+	return func(ctx context.Context) error {
+		enabled.Store(false)
+
+		return tracerProvider.Shutdown(ctx)
+	}, nil
+}
+
+// Tracer returns the provider's tracer.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartResourceSpan starts a span for a CRUD operation on a resource or data source.
+// The span must be ended by calling EndSpan.
+func StartResourceSpan(ctx context.Context, servicePackageName, typeName, operation string, isDataSource bool) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, spanName(typeName, operation),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			AttrTFDataSource.Bool(isDataSource),
+			AttrTFOperation.String(operation),
+			AttrTFResourceType.String(typeName),
+			AttrTFServicePackage.String(servicePackageName),
+		),
+	)
+}
+
+// EndSpan ends the specified span, recording any error.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// spanName returns a span name built from the specified elements, e.g. "aws_s3_bucket.Create".
+func spanName(elements ...string) string {
+	for i, v := range elements {
+		if v == "" {
+			elements[i] = "unknown"
+		}
+	}
+
+	return strings.Join(elements, ".")
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestSpanName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Elements []string
+		Expected string
+	}{
+		{
+			Name:     "resource operation",
+			Elements: []string{"aws_s3_bucket", "Create"},
+			Expected: "aws_s3_bucket.Create",
+		},
+		{
+			Name:     "API call",
+			Elements: []string{"S3", "CreateBucket"},
+			Expected: "S3.CreateBucket",
+		},
+		{
+			Name:     "unknown element",
+			Elements: []string{"", "CreateBucket"},
+			Expected: "unknown.CreateBucket",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := spanName(testCase.Elements...), testCase.Expected; got != want {
+				t.Errorf("spanName = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) { //nolint:paralleltest // Uses t.Setenv.
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name            string
+		Exporter        string
+		File            string
+		ExpectError     bool
+		ExpectedEnabled bool
+	}{
+		{
+			Name: "disabled",
+		},
+		{
+			Name:        "invalid exporter",
+			Exporter:    "zipkin",
+			ExpectError: true,
+		},
+		{
+			Name:        "file exporter no file",
+			Exporter:    ExporterFile,
+			ExpectError: true,
+		},
+		{
+			Name:            "file exporter",
+			Exporter:        ExporterFile,
+			File:            filepath.Join(t.TempDir(), "traces.json"),
+			ExpectedEnabled: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Setenv(EnvVarTracesExporter, testCase.Exporter)
+			t.Setenv(EnvVarTracesFile, testCase.File)
+
+			shutdown, err := Configure(ctx, "test")
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := Enabled(), testCase.ExpectedEnabled; got != want {
+				t.Errorf("Enabled = %t, want %t", got, want)
+			}
+
+			if err := shutdown(ctx); err != nil {
+				t.Errorf("shutdown: %s", err)
+			}
+
+			if Enabled() {
+				t.Error("Enabled after shutdown")
+			}
+		})
+	}
+}
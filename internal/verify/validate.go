@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/schedule"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
@@ -502,6 +503,27 @@ func StringHasPrefix(prefix string) schema.SchemaValidateFunc {
 	}
 }
 
+// ValidScheduleExpression validates a cron(), rate() or at() schedule expression in the specified service's dialect.
+func ValidScheduleExpression(dialect schedule.Dialect) schema.SchemaValidateFunc {
+	return func(v any, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return ws, errors
+		}
+
+		if value == "" {
+			return ws, errors
+		}
+
+		if _, err := schedule.Parse(dialect, value); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %w", k, err))
+		}
+
+		return ws, errors
+	}
+}
+
 func ValidServicePrincipal(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
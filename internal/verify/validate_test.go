@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/schedule"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -739,6 +740,34 @@ func TestFloatGreaterThan(t *testing.T) {
 	}
 }
 
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"",
+		"cron(0 10 * * ? *)",
+		"rate(1 day)",
+	}
+	for _, v := range validExpressions {
+		_, errors := ValidScheduleExpression(schedule.DialectEventBridgeRule)(v, "schedule_expression")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid schedule expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"cron(0 10 * * MON *)",
+		"rate(1 days)",
+		"at(2030-01-15T09:30:00)",
+	}
+	for _, v := range invalidExpressions {
+		_, errors := ValidScheduleExpression(schedule.DialectEventBridgeRule)(v, "schedule_expression")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid schedule expression", v)
+		}
+	}
+}
+
 func TestValidServicePrincipal(t *testing.T) {
 	t.Parallel()
 
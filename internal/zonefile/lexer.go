@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+)
+
+type token struct {
+	text   string // Quoted strings exclude the quotes.
+	quoted bool
+	line   int
+}
+
+// logicalLine is an entry in a zone file.
+// Parentheses allow an entry to span physical lines.
+type logicalLine struct {
+	tokens []token
+	// blankOwner indicates that the entry starts with whitespace, so the owner name is omitted.
+	blankOwner bool
+}
+
+// tokenize splits a zone file into logical lines of tokens, removing comments.
+func tokenize(s string) ([]logicalLine, error) {
+	var lines []logicalLine
+	var current logicalLine
+	lineNo, depth, parenLine := 1, 0, 0
+	startOfLine := true
+
+	endLine := func() {
+		if len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+		current = logicalLine{}
+	}
+
+	for i := 0; i < len(s); {
+		ch := s[i]
+
+		switch {
+		case ch == '\n':
+			lineNo++
+			i++
+			if depth == 0 {
+				endLine()
+				startOfLine = true
+			}
+			continue
+		case ch == ' ' || ch == '\t' || ch == '\r':
+			if startOfLine && depth == 0 && ch != '\r' {
+				current.blankOwner = true
+			}
+			i++
+		case ch == ';':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case ch == '(':
+			if depth == 0 {
+				parenLine = lineNo
+			}
+			depth++
+			i++
+		case ch == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNo)
+			}
+			depth--
+			i++
+		case ch == '"':
+			start := lineNo
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				switch s[j] {
+				case '\\':
+					j++
+				case '\n':
+					return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+			}
+			current.tokens = append(current.tokens, token{text: s[i+1 : j], quoted: true, line: lineNo})
+			i = j + 1
+		default:
+			j := i
+		loop:
+			for ; j < len(s); j++ {
+				switch s[j] {
+				case ' ', '\t', '\r', '\n', ';', '(', ')', '"':
+					break loop
+				case '\\':
+					j++
+				}
+			}
+			if j > len(s) {
+				j = len(s)
+			}
+			current.tokens = append(current.tokens, token{text: s[i:j], line: lineNo})
+			i = j
+		}
+
+		startOfLine = false
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", parenLine)
+	}
+	endLine()
+
+	return lines, nil
+}
@@ -0,0 +1,337 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package zonefile parses and formats DNS zone files in the master file format described in RFC 1035 section 5.
+package zonefile
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Record is a single resource record.
+type Record struct {
+	// Name is the fully qualified owner name, including the trailing period, in presentation format.
+	Name string
+	// TTL is the time to live in seconds. A negative TTL is omitted when formatting.
+	TTL int64
+	// Type is the upper case record type, e.g. "A" or "MX".
+	Type string
+	// Data is the RDATA in presentation format. Domain names are fully qualified.
+	Data string
+
+	// Comment is an optional comment appended to the record when formatting.
+	Comment string
+	// Commented indicates that the record is formatted as a comment.
+	Commented bool
+}
+
+const (
+	// maxTTL is the maximum TTL, see RFC 2181 section 8.
+	maxTTL = 1<<31 - 1
+)
+
+// Parse parses the records of a zone file.
+// Relative names are qualified with origin, which need not end with a period, until a $ORIGIN directive is encountered. $INCLUDE directives are not supported.
+func Parse(s, origin string) ([]Record, error) {
+	lines, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{}
+	if origin != "" {
+		// The origin argument is always fully qualified.
+		if !strings.HasSuffix(origin, ".") {
+			origin += "."
+		}
+		p.origin = strings.ToLower(origin)
+	}
+
+	var records []Record
+	for _, line := range lines {
+		record, err := p.parseLine(line)
+		if err != nil {
+			return nil, err
+		}
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+
+	return records, nil
+}
+
+type parser struct {
+	origin     string
+	defaultTTL int64
+	hasTTL     bool
+	lastName   string
+	lastTTL    int64
+	hasLastTTL bool
+}
+
+func (p *parser) parseLine(line logicalLine) (*Record, error) {
+	tokens := line.tokens
+	lineNo := tokens[0].line
+
+	if first := tokens[0]; !first.quoted && !line.blankOwner && strings.HasPrefix(first.text, "$") {
+		switch directive := strings.ToUpper(first.text); directive {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", lineNo)
+			}
+			origin, err := p.absoluteName(tokens[1].text, lineNo)
+			if err != nil {
+				return nil, err
+			}
+			p.origin = origin
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL requires a TTL", lineNo)
+			}
+			ttl, err := parseTTL(tokens[1].text)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			p.defaultTTL, p.hasTTL = ttl, true
+		default:
+			return nil, fmt.Errorf("line %d: unsupported directive %s", lineNo, first.text)
+		}
+
+		return nil, nil
+	}
+
+	record := &Record{}
+
+	if line.blankOwner {
+		if p.lastName == "" {
+			return nil, fmt.Errorf("line %d: no owner name", lineNo)
+		}
+		record.Name = p.lastName
+	} else {
+		name, err := p.absoluteName(tokens[0].text, lineNo)
+		if err != nil {
+			return nil, err
+		}
+		record.Name = name
+		tokens = tokens[1:]
+	}
+
+	// [<TTL>] [<class>] <type> <RDATA> or [<class>] [<TTL>] <type> <RDATA>.
+	hasTTL, hasClass := false, false
+	for len(tokens) > 0 && !(hasTTL && hasClass) {
+		v := tokens[0].text
+		if !hasTTL && v != "" && v[0] >= '0' && v[0] <= '9' {
+			ttl, err := parseTTL(v)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			record.TTL, hasTTL = ttl, true
+		} else if !hasClass && isClass(v) {
+			if !strings.EqualFold(v, "IN") {
+				return nil, fmt.Errorf("line %d: unsupported class %s", lineNo, v)
+			}
+			hasClass = true
+		} else {
+			break
+		}
+		tokens = tokens[1:]
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("line %d: no record type", lineNo)
+	}
+	record.Type = strings.ToUpper(tokens[0].text)
+	if !isType(record.Type) {
+		return nil, fmt.Errorf("line %d: invalid record type %s", lineNo, tokens[0].text)
+	}
+	rdata := tokens[1:]
+	if len(rdata) == 0 {
+		return nil, fmt.Errorf("line %d: %s record has no data", lineNo, record.Type)
+	}
+
+	// Qualify domain names in the RDATA.
+	for _, i := range domainNameFields[record.Type] {
+		if i < len(rdata) && !rdata[i].quoted {
+			name, err := p.absoluteName(rdata[i].text, lineNo)
+			if err != nil {
+				return nil, err
+			}
+			rdata[i].text = name
+		}
+	}
+
+	data := make([]string, 0, len(rdata))
+	for _, v := range rdata {
+		if v.quoted {
+			data = append(data, `"`+v.text+`"`)
+		} else {
+			data = append(data, v.text)
+		}
+	}
+	record.Data = strings.Join(data, " ")
+
+	// RFC 2308 section 4: the default TTL is set by $TTL, otherwise the last explicit TTL is used.
+	if !hasTTL {
+		switch {
+		case p.hasTTL:
+			record.TTL = p.defaultTTL
+		case p.hasLastTTL:
+			record.TTL = p.lastTTL
+		case record.Type == "SOA" && len(rdata) == 7:
+			ttl, err := parseTTL(rdata[6].text)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			record.TTL = ttl
+		default:
+			return nil, fmt.Errorf("line %d: no TTL", lineNo)
+		}
+	}
+
+	p.lastName = record.Name
+	p.lastTTL, p.hasLastTTL = record.TTL, true
+
+	return record, nil
+}
+
+// absoluteName returns a fully qualified domain name.
+func (p *parser) absoluteName(name string, lineNo int) (string, error) {
+	switch {
+	case name == "@":
+		if p.origin == "" {
+			return "", fmt.Errorf("line %d: @ used without an origin", lineNo)
+		}
+		return p.origin, nil
+	case name == ".":
+		return name, nil
+	case strings.HasSuffix(name, ".") && !strings.HasSuffix(name, `\.`):
+		return strings.ToLower(name), nil
+	case p.origin == "":
+		return "", fmt.Errorf("line %d: relative name %s used without an origin", lineNo, name)
+	case p.origin == ".":
+		return strings.ToLower(name) + ".", nil
+	default:
+		return strings.ToLower(name) + "." + p.origin, nil
+	}
+}
+
+// domainNameFields are the indices of domain names in the RDATA of common record types.
+var domainNameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"MX":    {1},
+	"NS":    {0},
+	"PTR":   {0},
+	"SOA":   {0, 1},
+	"SRV":   {3},
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
+
+func isType(s string) bool {
+	if s == "" || s[0] < 'A' || s[0] > 'Z' {
+		return false
+	}
+	for _, ch := range s {
+		if !(ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTTL parses a TTL in seconds, or in BIND's unit format, e.g. "1h30m".
+func parseTTL(s string) (int64, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if v < 0 || v > maxTTL {
+			return 0, fmt.Errorf("TTL %s out of range", s)
+		}
+		return v, nil
+	}
+
+	var ttl, n int64
+	digits := false
+	for _, ch := range strings.ToLower(s) {
+		switch {
+		case ch >= '0' && ch <= '9':
+			n = n*10 + int64(ch-'0')
+			digits = true
+		case digits:
+			switch ch {
+			case 's':
+			case 'm':
+				n *= 60
+			case 'h':
+				n *= 60 * 60
+			case 'd':
+				n *= 24 * 60 * 60
+			case 'w':
+				n *= 7 * 24 * 60 * 60
+			default:
+				return 0, fmt.Errorf("invalid TTL %s", s)
+			}
+			ttl += n
+			n, digits = 0, false
+		default:
+			return 0, fmt.Errorf("invalid TTL %s", s)
+		}
+		if ttl > maxTTL || n > maxTTL {
+			return 0, fmt.Errorf("TTL %s out of range", s)
+		}
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %s", s)
+	}
+
+	return ttl, nil
+}
+
+// Format formats records as a zone file.
+// Owner names are written relative to origin where possible.
+func Format(origin string, records []Record) string {
+	var sb strings.Builder
+
+	if origin != "" {
+		fmt.Fprintf(&sb, "$ORIGIN %s\n", origin)
+	}
+
+	tw := tabwriter.NewWriter(&sb, 0, 0, 1, ' ', 0)
+	for _, record := range records {
+		name := record.Name
+		switch {
+		case origin == "":
+		case name == origin:
+			name = "@"
+		case strings.HasSuffix(name, "."+origin):
+			name = strings.TrimSuffix(name, "."+origin)
+		}
+
+		ttl := ""
+		if record.TTL >= 0 {
+			ttl = strconv.FormatInt(record.TTL, 10)
+		}
+
+		line := fmt.Sprintf("%s\t%s\tIN\t%s\t%s", name, ttl, record.Type, record.Data)
+		if record.Commented {
+			line = "; " + line
+		}
+		if record.Comment != "" {
+			line += " ; " + record.Comment
+		}
+
+		fmt.Fprintln(tw, line)
+	}
+	tw.Flush()
+
+	return sb.String()
+}
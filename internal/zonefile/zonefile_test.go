@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     string
+		origin    string
+		want      []Record
+		wantError bool
+	}{
+		"empty": {
+			input: "; nothing here\n\n",
+		},
+		"full": {
+			input: `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+			2024010101 ; serial
+			7200       ; refresh
+			3600 1209600 300 )
+	IN	NS	ns1
+	IN	NS	ns2.example.net.
+	IN	MX	10 mail
+www	300	IN	A	192.0.2.1
+	IN 300	A	192.0.2.2
+*.dev	CNAME	www
+txt	TXT	"v=spf1 -all" "second string"
+srv	1d	SRV	10 5 443 target
+`,
+			want: []Record{
+				{Name: "example.com.", TTL: 3600, Type: "SOA", Data: "ns1.example.com. hostmaster.example.com. 2024010101 7200 3600 1209600 300"},
+				{Name: "example.com.", TTL: 3600, Type: "NS", Data: "ns1.example.com."},
+				{Name: "example.com.", TTL: 3600, Type: "NS", Data: "ns2.example.net."},
+				{Name: "example.com.", TTL: 3600, Type: "MX", Data: "10 mail.example.com."},
+				{Name: "www.example.com.", TTL: 300, Type: "A", Data: "192.0.2.1"},
+				{Name: "www.example.com.", TTL: 300, Type: "A", Data: "192.0.2.2"},
+				{Name: "*.dev.example.com.", TTL: 3600, Type: "CNAME", Data: "www.example.com."},
+				{Name: "txt.example.com.", TTL: 3600, Type: "TXT", Data: `"v=spf1 -all" "second string"`},
+				{Name: "srv.example.com.", TTL: 86400, Type: "SRV", Data: "10 5 443 target.example.com."},
+			},
+		},
+		"origin argument": {
+			input:  "www 60 A 192.0.2.1\n",
+			origin: "Example.com",
+			want: []Record{
+				{Name: "www.example.com.", TTL: 60, Type: "A", Data: "192.0.2.1"},
+			},
+		},
+		"last explicit TTL": {
+			input: "a.example.com. 120 A 192.0.2.1\nb.example.com. A 192.0.2.2\n",
+			want: []Record{
+				{Name: "a.example.com.", TTL: 120, Type: "A", Data: "192.0.2.1"},
+				{Name: "b.example.com.", TTL: 120, Type: "A", Data: "192.0.2.2"},
+			},
+		},
+		"escaped quote": {
+			input: `t.example.com. 60 TXT "say \"hi\"; now"` + "\n",
+			want: []Record{
+				{Name: "t.example.com.", TTL: 60, Type: "TXT", Data: `"say \"hi\"; now"`},
+			},
+		},
+		"relative name without origin": {
+			input:     "www 60 A 192.0.2.1\n",
+			wantError: true,
+		},
+		"no TTL": {
+			input:     "www.example.com. A 192.0.2.1\n",
+			wantError: true,
+		},
+		"unsupported class": {
+			input:     "www.example.com. 60 CH A 192.0.2.1\n",
+			wantError: true,
+		},
+		"unsupported directive": {
+			input:     "$INCLUDE other.zone\n",
+			wantError: true,
+		},
+		"unbalanced parentheses": {
+			input:     "www.example.com. 60 TXT ( \"a\"\n",
+			wantError: true,
+		},
+		"unterminated string": {
+			input:     "www.example.com. 60 TXT \"a\n",
+			wantError: true,
+		},
+		"no data": {
+			input:     "www.example.com. 60 A\n",
+			wantError: true,
+		},
+		"invalid TTL": {
+			input:     "www.example.com. 1x A 192.0.2.1\n",
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(testCase.input, testCase.origin)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("Parse() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	records := []Record{
+		{Name: "example.com.", TTL: 300, Type: "MX", Data: "10 mail.example.com."},
+		{Name: "www.example.com.", TTL: 60, Type: "A", Data: "192.0.2.1"},
+		{Name: "alias.example.com.", TTL: -1, Type: "ALIAS", Data: "lb.example.net.", Comment: "A", Commented: true},
+		{Name: "other.example.net.", TTL: 60, Type: "TXT", Data: `"hi"`},
+	}
+
+	got := Format("example.com.", records)
+	want := `$ORIGIN example.com.
+@                  300 IN MX    10 mail.example.com.
+www                60  IN A     192.0.2.1
+; alias                IN ALIAS lb.example.net. ; A
+other.example.net. 60  IN TXT   "hi"
+`
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	// Formatted records parse back to the same records, other than commented records.
+	parsed, err := Parse(got, "")
+	if err != nil {
+		t.Fatalf("Parse() err: %s", err)
+	}
+	if diff := cmp.Diff(parsed, []Record{records[0], records[1], records[3]}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		want      int64
+		wantError bool
+	}{
+		"0":          {want: 0},
+		"3600":       {want: 3600},
+		"1h30m":      {want: 5400},
+		"1W":         {want: 604800},
+		"2d12h":      {want: 216000},
+		"10s":        {want: 10},
+		"1h30":       {wantError: true},
+		"h":          {wantError: true},
+		"-1":         {wantError: true},
+		"2147483648": {wantError: true},
+	}
+
+	for input, testCase := range testCases {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseTTL(input)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("parseTTL(%q) err %t, want %t: %v", input, got, want, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("parseTTL(%q) = %d, want %d", input, got, testCase.want)
+			}
+		})
+	}
+}
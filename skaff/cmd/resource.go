@@ -4,6 +4,8 @@
 package cmd
 
 import (
+	"errors"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
 	"github.com/spf13/cobra"
 )
@@ -15,12 +17,34 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+
+	createOp   string
+	describeOp string
+	updateOp   string
+	deleteOp   string
+	listOp     string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if createOp != "" {
+			if pluginSDKV2 {
+				return errors.New("generating from AWS SDK operations is only supported for Terraform Plugin Framework resources")
+			}
+
+			ops := resource.SDKOperations{
+				Create:   createOp,
+				Describe: describeOp,
+				Update:   updateOp,
+				Delete:   deleteOp,
+				List:     listOp,
+			}
+
+			return resource.CreateFromSDK(name, snakeName, ops, !clearComments, force, includeTags)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
 	},
 }
@@ -33,4 +57,9 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&createOp, "create", "", "AWS SDK operation that creates the resource (e.g., CreateBroker); generates the schema and CRUD handlers from the SDK operation model")
+	resourceCmd.Flags().StringVar(&describeOp, "describe", "", "AWS SDK operation that reads the resource (e.g., DescribeBroker)")
+	resourceCmd.Flags().StringVar(&updateOp, "update", "", "AWS SDK operation that updates the resource (e.g., UpdateBroker)")
+	resourceCmd.Flags().StringVar(&deleteOp, "delete", "", "AWS SDK operation that deletes the resource (e.g., DeleteBroker)")
+	resourceCmd.Flags().StringVar(&listOp, "list", "", "AWS SDK operation that lists resources (e.g., ListBrokers); generates a sweeper")
 }
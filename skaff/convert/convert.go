@@ -9,6 +9,7 @@ import (
 	"unicode"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/listpages/naming"
 )
 
 // ToHumanResName converts a camel cased string to a human readable name
@@ -51,3 +52,16 @@ func ToLowercasePrefix(s string) string {
 	}
 	return strings.ToLower(s[:splitIdx]) + s[splitIdx:]
 }
+
+// ToGoFieldName converts an AWS SDK for Go v2 member name to the name of
+// the corresponding field in a Terraform Plugin Framework model, spelling
+// well-known initialisms in upper case (e.g., KmsKeyArn becomes KMSKeyARN)
+func ToGoFieldName(sdkName string) string {
+	re := regexache.MustCompile(`[A-Z]+[a-z0-9]*`)
+	return re.ReplaceAllStringFunc(sdkName, func(word string) string {
+		if s, ok := naming.Initialism(word); ok {
+			return s
+		}
+		return word
+	})
+}
@@ -76,3 +76,26 @@ func TestToLowercasePrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestToGoFieldName(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"no initialisms", "Description", "Description"},
+		{"suffix", "WidgetArn", "WidgetARN"},
+		{"prefix and suffix", "KmsKeyArn", "KMSKeyARN"},
+		{"plural", "SubnetIds", "SubnetIDs"},
+		{"already upper", "VPCEndpointID", "VPCEndpointID"},
+		{"word containing initialism", "Identifier", "Identifier"},
+		{"empty", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToGoFieldName(tt.s); got != tt.want {
+				t.Errorf("ToGoFieldName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
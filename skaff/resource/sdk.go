@@ -0,0 +1,1047 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/listpages/naming"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkmodel"
+)
+
+//go:embed resourcesdk.gtpl
+var resourceSDKTmpl string
+
+//go:embed resourcesdktest.gtpl
+var resourceSDKTestTmpl string
+
+//go:embed websitedocsdk.gtpl
+var websiteSDKTmpl string
+
+//go:embed sweepsdk.gtpl
+var sweepSDKTmpl string
+
+// SDKOperations names the AWS SDK for Go v2 operations that implement a resource's lifecycle.
+// Create, Describe and Delete are required.
+type SDKOperations struct {
+	Create   string
+	Describe string
+	Update   string
+	Delete   string
+	List     string
+}
+
+// SDKTemplateData is the data used to generate a resource from the AWS SDK for Go v2 operation model.
+type SDKTemplateData struct {
+	TemplateData
+	Operations SDKOperations
+
+	Imports []string
+	Schema  string
+	Models  []string
+
+	ARN              bool
+	CreateIDExpr     string // Expression setting the resource's ID after creation, or "".
+	CreateOutputUsed bool
+	DeleteIDMember   string // Delete input member set from the resource's ID, or "".
+	FinderOutputExpr string // e.g. output.Widget
+	FinderOutputType string // e.g. *awstypes.Widget
+	IDMember         string // Describe input member identifying the resource, e.g. WidgetId.
+	NotFoundError    string
+	NoUpdate         bool
+	UpdatedFields    []string // Model fields compared in Update.
+
+	ExistsType           string // @Testing(existsType) annotation value.
+	TestExistsType       string
+	TestExistsTypeImport string
+
+	StatusExpr    string // e.g. string(output.Status)
+	CreatePending []string
+	CreateTarget  []string
+	UpdatePending []string
+	UpdateTarget  []string
+	DeletePending []string
+
+	ListPagesFunc  string
+	ListItemsField string
+	ListItemIDExpr string
+
+	Arguments     []docAttribute
+	Attributes    []docAttribute
+	Blocks        []docBlock
+	ConfigExample []string // Required top-level arguments in HCL, used in test configurations.
+}
+
+type docAttribute struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+type docBlock struct {
+	Name      string
+	Arguments []docAttribute
+}
+
+// CreateFromSDK generates a Terraform Plugin Framework resource, its tests, website documentation and sweeper
+// from the input and output shapes of the resource's AWS SDK for Go v2 operations.
+func CreateFromSDK(resName, snakeName string, ops SDKOperations, comments, force, tags bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if ops.Create == "" || ops.Describe == "" || ops.Delete == "" {
+		return fmt.Errorf("error checking: create, describe and delete operations are required")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	pkg, err := sdkmodel.Load(context.Background(), "github.com/aws/aws-sdk-go-v2/service/"+service.GoV2Package())
+	if err != nil {
+		return fmt.Errorf("error loading AWS SDK for Go v2 service package: %w", err)
+	}
+
+	templateData := SDKTemplateData{
+		TemplateData: TemplateData{
+			Resource:             resName,
+			ResourceLower:        strings.ToLower(resName),
+			ResourceSnake:        snakeName,
+			HumanFriendlyService: service.HumanFriendly(),
+			IncludeComments:      comments,
+			IncludeTags:          tags,
+			SDKPackage:           service.GoV2Package(),
+			ServicePackage:       servicePackage,
+			Service:              service.ProviderNameUpper(),
+			ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+			AWSServiceName:       service.FullHumanFriendly(),
+			PluginFramework:      true,
+			HumanResourceName:    convert.ToHumanResName(resName),
+			ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+		},
+		Operations: ops,
+	}
+
+	if err := newSDKGenerator(pkg, &templateData).generate(); err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeSDKTemplate("newres", f, resourceSDKTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeSDKTemplate("restest", tf, resourceSDKTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeSDKTemplate("webdoc", wf, websiteSDKTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	if err := addExports(templateData); err != nil {
+		return fmt.Errorf("adding test exports: %w", err)
+	}
+
+	if ops.List != "" {
+		if err := addListPages(ops.List); err != nil {
+			return fmt.Errorf("adding list_pages entry: %w", err)
+		}
+
+		if err := addSweeper(templateData); err != nil {
+			return fmt.Errorf("adding sweeper: %w", err)
+		}
+
+		fmt.Printf("Run 'go generate' to generate %s and, for a new sweep.go, add the service package to internal/sweep/register_gen_test.go.\n", templateData.ListPagesFunc+"Pages")
+	}
+
+	return nil
+}
+
+type sdkGenerator struct {
+	pkg     *sdkmodel.Package
+	td      *SDKTemplateData
+	imports map[string]struct{}
+	models  map[string]string // Structure name to model name.
+	visited map[string]bool
+}
+
+func newSDKGenerator(pkg *sdkmodel.Package, td *SDKTemplateData) *sdkGenerator {
+	return &sdkGenerator{
+		pkg:     pkg,
+		td:      td,
+		imports: make(map[string]struct{}),
+		models:  make(map[string]string),
+		visited: make(map[string]bool),
+	}
+}
+
+// attribute is a top-level resource attribute.
+type attribute struct {
+	member    *sdkmodel.Member
+	tfName    string
+	fieldName string
+	required  bool
+	optional  bool
+	computed  bool
+	forceNew  bool
+}
+
+const (
+	memberClientToken = "ClientToken"
+	memberTags        = "Tags"
+)
+
+func (g *sdkGenerator) generate() error {
+	td := g.td
+	resource := td.Resource
+
+	createOp, err := g.pkg.Operation(td.Operations.Create)
+	if err != nil {
+		return err
+	}
+	describeOp, err := g.pkg.Operation(td.Operations.Describe)
+	if err != nil {
+		return err
+	}
+	deleteOp, err := g.pkg.Operation(td.Operations.Delete)
+	if err != nil {
+		return err
+	}
+	var updateOp *sdkmodel.Operation
+	if td.Operations.Update != "" {
+		if updateOp, err = g.pkg.Operation(td.Operations.Update); err != nil {
+			return err
+		}
+	}
+	td.NoUpdate = updateOp == nil
+
+	// The Describe input's first required string member identifies the resource.
+	for _, m := range describeOp.Input.Members {
+		if m.Required && m.Type.Kind == sdkmodel.KindString {
+			td.IDMember = m.Name
+			break
+		}
+	}
+	if td.IDMember == "" {
+		return fmt.Errorf("operation %s has no required string input member identifying the resource", describeOp.Name)
+	}
+
+	// The Describe output either wraps a structure named for the resource or describes the resource itself.
+	described := describeOp.Output
+	td.FinderOutputExpr = "output"
+	td.FinderOutputType = fmt.Sprintf("*%s.%sOutput", td.SDKPackage, describeOp.Name)
+	td.ExistsType = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%[1]s;%[1]s.%[2]sOutput", td.SDKPackage, describeOp.Name)
+	td.TestExistsType = fmt.Sprintf("%s.%sOutput", td.SDKPackage, describeOp.Name)
+	td.TestExistsTypeImport = fmt.Sprintf(`"github.com/aws/aws-sdk-go-v2/service/%s"`, td.SDKPackage)
+	if m := describeOp.Output.Member(resource); m != nil && m.Type.Kind == sdkmodel.KindStructure {
+		if described, err = g.pkg.Structure(m.Type.Name); err != nil {
+			return err
+		}
+		td.FinderOutputExpr = "output." + m.Name
+		td.FinderOutputType = "*awstypes." + m.Type.Name
+		td.ExistsType = fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s/types;types.%s", td.SDKPackage, m.Type.Name)
+		td.TestExistsType = "awstypes." + m.Type.Name
+		td.TestExistsTypeImport = fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, td.SDKPackage)
+	}
+
+	td.NotFoundError = g.pkg.NotFoundError()
+	if td.NotFoundError == "" {
+		td.NotFoundError = "ResourceNotFoundException"
+	}
+
+	if deleteOp.Input.Member(td.IDMember) != nil {
+		td.DeleteIDMember = td.IDMember
+	}
+
+	attributes := g.attributes(createOp.Input, updateOp, described)
+
+	switch {
+	case createOp.Output.Member(td.IDMember) != nil:
+		td.CreateIDExpr = fmt.Sprintf("flex.StringToFramework(ctx, output.%s)", td.IDMember)
+	case createOp.Output.Member(resource) != nil:
+		if s, err := g.pkg.Structure(createOp.Output.Member(resource).Type.Name); err == nil && s.Member(td.IDMember) != nil {
+			td.CreateIDExpr = fmt.Sprintf("flex.StringToFramework(ctx, output.%s.%s)", resource, td.IDMember)
+		}
+	case createOp.Input.Member(td.IDMember) != nil:
+		td.CreateIDExpr = "data." + convert.ToGoFieldName(trimResourcePrefix(td.IDMember, resource))
+	}
+
+	td.CreateOutputUsed = strings.Contains(td.CreateIDExpr, "output.")
+
+	g.status(described)
+
+	if td.Operations.List != "" {
+		if err := g.list(td.Operations.List); err != nil {
+			return err
+		}
+	}
+
+	g.schema(attributes)
+
+	return nil
+}
+
+// attributes returns the resource's top-level attributes in the order they appear in the schema.
+func (g *sdkGenerator) attributes(createInput *sdkmodel.Shape, updateOp *sdkmodel.Operation, described *sdkmodel.Shape) []*attribute {
+	td := g.td
+	byName := make(map[string]*attribute)
+
+	add := func(m *sdkmodel.Member) *attribute {
+		name := trimResourcePrefix(m.Name, td.Resource)
+		fieldName := convert.ToGoFieldName(name)
+		tfName := names.ToSnakeCase(fieldName)
+
+		a, ok := byName[tfName]
+		if !ok {
+			a = &attribute{
+				member:    m,
+				tfName:    tfName,
+				fieldName: fieldName,
+			}
+			byName[tfName] = a
+		}
+
+		return a
+	}
+
+	for _, m := range createInput.Members {
+		switch m.Name {
+		case memberClientToken:
+			continue
+		case memberTags:
+			td.IncludeTags = true
+			continue
+		}
+
+		a := add(m)
+		a.required = m.Required
+		a.optional = !m.Required
+		a.forceNew = updateOp == nil || updateOp.Input.Member(m.Name) == nil
+	}
+
+	for _, m := range described.Members {
+		if m.Name == memberTags {
+			continue
+		}
+
+		if a := add(m); !a.required {
+			a.computed = true
+		}
+	}
+
+	// The resource's identifier is the framework "id" attribute.
+	delete(byName, names.AttrID)
+
+	if _, ok := byName[names.AttrARN]; ok {
+		td.ARN = true
+	}
+
+	attributes := make([]*attribute, 0, len(byName))
+	for _, a := range byName {
+		attributes = append(attributes, a)
+	}
+	slices.SortFunc(attributes, func(a, b *attribute) int {
+		return strings.Compare(a.tfName, b.tfName)
+	})
+
+	return attributes
+}
+
+func (g *sdkGenerator) schema(attributes []*attribute) {
+	td := g.td
+
+	var sbAttributes, sbBlocks, sbModel strings.Builder
+	modelName := fmt.Sprintf("resource%sModel", td.Resource)
+
+	fmt.Fprintf(&sbModel, "type %s struct {\n", modelName)
+	fmt.Fprintf(&sbAttributes, "%s: framework.IDAttribute(),\n", namesgen.ConstOrQuote(names.AttrID))
+
+	models := make(map[string]string)
+	for _, a := range attributes {
+		m := a.member
+		key := namesgen.ConstOrQuote(a.tfName)
+
+		if a.tfName == names.AttrARN && a.computed && !a.required && !a.optional {
+			fmt.Fprintf(&sbAttributes, "%s: framework.ARNAttributeComputedOnly(),\n", key)
+			models[a.fieldName] = fmt.Sprintf("%s types.String `tfsdk:%q`", a.fieldName, a.tfName)
+			td.Attributes = append(td.Attributes, docAttribute{Name: a.tfName, Description: "ARN of the " + td.HumanResourceName + "."})
+			continue
+		}
+
+		if isBlock(m.Type) {
+			block, fieldType, ok := g.block(m, a.required, a.computed && !a.required && !a.optional, a.forceNew)
+			if !ok {
+				fmt.Fprintf(&sbAttributes, "// TODO %s: unsupported type.\n", key)
+				continue
+			}
+			if a.required || a.optional {
+				fmt.Fprintf(&sbBlocks, "%s: %s,\n", key, block)
+			} else {
+				fmt.Fprintf(&sbAttributes, "%s: %s,\n", key, block)
+			}
+			models[a.fieldName] = fmt.Sprintf("%s %s `tfsdk:%q`", a.fieldName, fieldType, a.tfName)
+			g.document(a)
+
+			if a.required {
+				td.ConfigExample = append(td.ConfigExample, fmt.Sprintf("# TODO Add the required %s block.", a.tfName))
+			}
+			if (a.required || a.optional) && !a.forceNew {
+				td.UpdatedFields = append(td.UpdatedFields, a.fieldName)
+			}
+			continue
+		}
+
+		attr, fieldType, ok := g.attribute(m.Type, a.required, a.optional, a.computed, a.forceNew)
+		if !ok {
+			fmt.Fprintf(&sbAttributes, "// TODO %s: unsupported type.\n", key)
+			continue
+		}
+		fmt.Fprintf(&sbAttributes, "%s: %s,\n", key, attr)
+		models[a.fieldName] = fmt.Sprintf("%s %s `tfsdk:%q`", a.fieldName, fieldType, a.tfName)
+		g.document(a)
+
+		if a.required {
+			td.ConfigExample = append(td.ConfigExample, fmt.Sprintf("%s = %s", a.tfName, exampleValue(a.tfName, m.Type, g.pkg)))
+		}
+		if (a.required || a.optional) && !a.forceNew {
+			td.UpdatedFields = append(td.UpdatedFields, a.fieldName)
+		}
+	}
+
+	if td.IncludeTags {
+		fmt.Fprintf(&sbAttributes, "%s: tftags.TagsAttribute(),\n", namesgen.ConstOrQuote(names.AttrTags))
+		fmt.Fprintf(&sbAttributes, "%s: tftags.TagsAttributeComputedOnly(),\n", namesgen.ConstOrQuote(names.AttrTagsAll))
+		models["Tags"] = "Tags tftags.Map `tfsdk:\"tags\"`"
+		models["TagsAll"] = "TagsAll tftags.Map `tfsdk:\"tags_all\"`"
+		g.imports[`tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`] = struct{}{}
+	}
+
+	models["ID"] = "ID types.String `tfsdk:\"id\"`"
+	models["Timeouts"] = "Timeouts timeouts.Value `tfsdk:\"timeouts\"`"
+	for _, k := range slices.Sorted(maps.Keys(models)) {
+		fmt.Fprintf(&sbModel, "%s\n", models[k])
+	}
+	sbModel.WriteString("}")
+
+	fmt.Fprintf(&sbBlocks, "%s: timeouts.Block(ctx, timeouts.Opts{\nCreate: true,\n", namesgen.ConstOrQuote(names.AttrTimeouts))
+	if !td.NoUpdate {
+		sbBlocks.WriteString("Update: true,\n")
+	}
+	sbBlocks.WriteString("Delete: true,\n}),\n")
+
+	td.Schema = fmt.Sprintf("schema.Schema{\nAttributes: map[string]schema.Attribute{\n%s},\nBlocks: map[string]schema.Block{\n%s},\n}", sbAttributes.String(), sbBlocks.String())
+	td.Models = append([]string{sbModel.String()}, td.Models...)
+
+	g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`] = struct{}{}
+	g.imports[`"github.com/hashicorp/terraform-plugin-framework/types"`] = struct{}{}
+	td.Imports = slices.Sorted(maps.Keys(g.imports))
+}
+
+// attribute returns the schema attribute and model field type for a member of a scalar, list of scalars or map of scalars type.
+func (g *sdkGenerator) attribute(t *sdkmodel.Type, required, optional, computed, forceNew bool) (string, string, bool) {
+	var (
+		attrType, elemType, customType, fieldType, planModifierType string
+	)
+
+	switch t.Kind {
+	case sdkmodel.KindString:
+		attrType, fieldType, planModifierType = "String", "types.String", "String"
+	case sdkmodel.KindBool:
+		attrType, fieldType, planModifierType = "Bool", "types.Bool", "Bool"
+	case sdkmodel.KindInt32:
+		attrType, fieldType, planModifierType = "Int32", "types.Int32", "Int32"
+	case sdkmodel.KindInt64:
+		attrType, fieldType, planModifierType = "Int64", "types.Int64", "Int64"
+	case sdkmodel.KindFloat32:
+		attrType, fieldType, planModifierType = "Float32", "types.Float32", "Float32"
+	case sdkmodel.KindFloat64:
+		attrType, fieldType, planModifierType = "Float64", "types.Float64", "Float64"
+	case sdkmodel.KindTimestamp:
+		attrType, customType, fieldType, planModifierType = "String", "timetypes.RFC3339Type{}", "timetypes.RFC3339", "String"
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`] = struct{}{}
+	case sdkmodel.KindEnum:
+		attrType, planModifierType = "String", "String"
+		customType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", t.Name)
+		fieldType = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", t.Name)
+		g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = struct{}{}
+	case sdkmodel.KindList:
+		attrType, planModifierType = "List", "List"
+		switch t.Elem.Kind {
+		case sdkmodel.KindString:
+			customType, elemType, fieldType = "fwtypes.ListOfStringType", "types.StringType", "fwtypes.ListOfString"
+		case sdkmodel.KindEnum:
+			customType = fmt.Sprintf("fwtypes.ListOfStringEnumType[awstypes.%s]()", t.Elem.Name)
+			elemType = fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", t.Elem.Name)
+			fieldType = fmt.Sprintf("fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.%s]]", t.Elem.Name)
+		default:
+			return "", "", false
+		}
+		g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = struct{}{}
+	case sdkmodel.KindMap:
+		if t.Elem.Kind != sdkmodel.KindString {
+			return "", "", false
+		}
+		attrType, customType, elemType, fieldType, planModifierType = "Map", "fwtypes.MapOfStringType", "types.StringType", "fwtypes.MapOfString", "Map"
+		g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = struct{}{}
+	default:
+		return "", "", false
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "schema.%sAttribute{\n", attrType)
+	if customType != "" {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+	}
+	if elemType != "" {
+		fmt.Fprintf(&sb, "ElementType: %s,\n", elemType)
+	}
+	switch {
+	case required:
+		sb.WriteString("Required: true,\n")
+	case optional && computed:
+		sb.WriteString("Optional: true,\nComputed: true,\n")
+	case optional:
+		sb.WriteString("Optional: true,\n")
+	default:
+		sb.WriteString("Computed: true,\n")
+	}
+
+	var planModifiers []string
+	if forceNew {
+		planModifiers = append(planModifiers, "RequiresReplace()")
+	}
+	if computed {
+		planModifiers = append(planModifiers, "UseStateForUnknown()")
+	}
+	if len(planModifiers) > 0 {
+		pkg := strings.ToLower(planModifierType) + "planmodifier"
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n", planModifierType)
+		for _, v := range planModifiers {
+			fmt.Fprintf(&sb, "%s.%s,\n", pkg, v)
+		}
+		sb.WriteString("},\n")
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`] = struct{}{}
+		g.imports[fmt.Sprintf(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/%s"`, pkg)] = struct{}{}
+	}
+	sb.WriteString("}")
+
+	return sb.String(), fieldType, true
+}
+
+// block returns the schema block (or, for computed-only members, the schema attribute) and model field type for a member of a structure or list of structures type.
+func (g *sdkGenerator) block(m *sdkmodel.Member, required, computedOnly, forceNew bool) (string, string, bool) {
+	t := m.Type
+	single := t.Kind == sdkmodel.KindStructure
+	if !single {
+		t = t.Elem
+	}
+
+	modelName, ok := g.model(t.Name)
+	if !ok {
+		return "", "", false
+	}
+
+	g.imports[`fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`] = struct{}{}
+	fieldType := fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", modelName)
+
+	var sb strings.Builder
+	if computedOnly {
+		fmt.Fprintf(&sb, "schema.ListAttribute{\nCustomType: fwtypes.NewListNestedObjectTypeOf[%[1]s](ctx),\nComputed: true,\nElementType: fwtypes.NewObjectTypeOf[%[1]s](ctx),\n", modelName)
+		sb.WriteString("PlanModifiers: []planmodifier.List{\nlistplanmodifier.UseStateForUnknown(),\n},\n}")
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`] = struct{}{}
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"`] = struct{}{}
+
+		return sb.String(), fieldType, true
+	}
+
+	fmt.Fprintf(&sb, "schema.ListNestedBlock{\nCustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
+	if single || required {
+		sb.WriteString("Validators: []validator.List{\n")
+		if required {
+			sb.WriteString("listvalidator.IsRequired(),\n")
+		}
+		if single {
+			sb.WriteString("listvalidator.SizeAtMost(1),\n")
+		}
+		sb.WriteString("},\n")
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`] = struct{}{}
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`] = struct{}{}
+	}
+	if forceNew {
+		sb.WriteString("PlanModifiers: []planmodifier.List{\nlistplanmodifier.RequiresReplace(),\n},\n")
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`] = struct{}{}
+		g.imports[`"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"`] = struct{}{}
+	}
+
+	nested, err := g.pkg.Structure(t.Name)
+	if err != nil {
+		return "", "", false
+	}
+
+	var sbAttributes, sbBlocks strings.Builder
+	for _, m := range nested.Members {
+		key := namesgen.ConstOrQuote(names.ToSnakeCase(convert.ToGoFieldName(m.Name)))
+
+		if isBlock(m.Type) {
+			if block, _, ok := g.block(m, m.Required, false, false); ok {
+				fmt.Fprintf(&sbBlocks, "%s: %s,\n", key, block)
+				continue
+			}
+		} else if attr, _, ok := g.attribute(m.Type, m.Required, !m.Required, false, false); ok {
+			fmt.Fprintf(&sbAttributes, "%s: %s,\n", key, attr)
+			continue
+		}
+
+		fmt.Fprintf(&sbAttributes, "// TODO %s: unsupported type.\n", key)
+	}
+
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	if sbAttributes.Len() > 0 {
+		fmt.Fprintf(&sb, "Attributes: map[string]schema.Attribute{\n%s},\n", sbAttributes.String())
+	}
+	if sbBlocks.Len() > 0 {
+		fmt.Fprintf(&sb, "Blocks: map[string]schema.Block{\n%s},\n", sbBlocks.String())
+	}
+	sb.WriteString("},\n}")
+
+	return sb.String(), fieldType, true
+}
+
+// model emits the AutoFlex model for the specified structure and returns its name.
+func (g *sdkGenerator) model(name string) (string, bool) {
+	if v, ok := g.models[name]; ok {
+		return v, true
+	}
+
+	// Recursive structures can't be represented in a schema.
+	if g.visited[name] {
+		return "", false
+	}
+	g.visited[name] = true
+
+	shape, err := g.pkg.Structure(name)
+	if err != nil {
+		return "", false
+	}
+
+	modelName := convert.ToLowercasePrefix(convert.ToGoFieldName(name)) + "Model"
+	g.models[name] = modelName
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "type %s struct {\n", modelName)
+	for _, m := range shape.Members {
+		fieldName := convert.ToGoFieldName(m.Name)
+		tfName := names.ToSnakeCase(fieldName)
+
+		var fieldType string
+		if isBlock(m.Type) {
+			t := m.Type
+			if t.Kind == sdkmodel.KindList {
+				t = t.Elem
+			}
+			v, ok := g.model(t.Name)
+			if !ok {
+				fmt.Fprintf(&sb, "// TODO %s: unsupported type.\n", fieldName)
+				continue
+			}
+			fieldType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", v)
+		} else {
+			_, v, ok := g.attribute(m.Type, false, true, false, false)
+			if !ok {
+				fmt.Fprintf(&sb, "// TODO %s: unsupported type.\n", fieldName)
+				continue
+			}
+			fieldType = v
+		}
+
+		fmt.Fprintf(&sb, "%s %s `tfsdk:%q`\n", fieldName, fieldType, tfName)
+	}
+	sb.WriteString("}")
+
+	g.td.Models = append(g.td.Models, sb.String())
+
+	return modelName, true
+}
+
+// status finds the described resource's status member and derives the waiters' pending and target states from its values.
+func (g *sdkGenerator) status(described *sdkmodel.Shape) {
+	td := g.td
+
+	var status *sdkmodel.Member
+	for _, m := range described.Members {
+		if m.Type.Kind != sdkmodel.KindEnum {
+			continue
+		}
+		if m.Name == "Status" || m.Name == "State" {
+			status = m
+			break
+		}
+		if status == nil && (strings.HasSuffix(m.Name, "Status") || strings.HasSuffix(m.Name, "State")) {
+			status = m
+		}
+	}
+	if status == nil {
+		return
+	}
+
+	td.StatusExpr = fmt.Sprintf("string(output.%s)", status.Name)
+
+	contains := func(s string, substrs ...string) bool {
+		return slices.ContainsFunc(substrs, func(v string) bool {
+			return strings.Contains(s, v)
+		})
+	}
+
+	for _, v := range g.pkg.EnumValues(status.Type.Name) {
+		value := strings.ToUpper(v.Value)
+		name := "awstypes." + v.Name
+
+		switch {
+		case contains(value, "DELET"):
+			td.DeletePending = append(td.DeletePending, name)
+		case contains(value, "UPDAT", "MODIFY", "MODIFYING"):
+			td.UpdatePending = append(td.UpdatePending, name)
+		case contains(value, "CREAT", "PENDING", "PROVISIONING", "IN_PROGRESS", "INITIALIZING", "STARTING"):
+			td.CreatePending = append(td.CreatePending, name)
+		case contains(value, "ACTIVE", "AVAILABLE", "CREATED", "READY", "COMPLETE", "SUCCEEDED", "ENABLED", "RUNNING", "DEPLOYED", "HEALTHY"):
+			td.CreateTarget = append(td.CreateTarget, name)
+		}
+	}
+
+	td.UpdateTarget = td.CreateTarget
+	// Deletion may start from any target state.
+	td.DeletePending = append(td.DeletePending, td.CreateTarget...)
+}
+
+// list finds the list operation's result member and the member of each result identifying a resource.
+func (g *sdkGenerator) list(name string) error {
+	td := g.td
+
+	op, err := g.pkg.Operation(name)
+	if err != nil {
+		return err
+	}
+
+	td.ListPagesFunc = naming.FuncName(name, false)
+
+	for _, m := range op.Output.Members {
+		if m.Type.Kind != sdkmodel.KindList {
+			continue
+		}
+
+		td.ListItemsField = m.Name
+
+		switch m.Type.Elem.Kind {
+		case sdkmodel.KindString:
+			td.ListItemIDExpr = "v"
+		case sdkmodel.KindStructure:
+			if s, err := g.pkg.Structure(m.Type.Elem.Name); err == nil && s.Member(td.IDMember) != nil {
+				td.ListItemIDExpr = fmt.Sprintf("aws.ToString(v.%s)", td.IDMember)
+			}
+		}
+
+		break
+	}
+
+	if td.ListItemsField == "" {
+		return fmt.Errorf("operation %s has no list output member", name)
+	}
+
+	return nil
+}
+
+func (g *sdkGenerator) document(a *attribute) {
+	td := g.td
+	description := docSentence(a.member.Doc)
+
+	if !isBlock(a.member.Type) || !(a.required || a.optional) {
+		v := docAttribute{Name: a.tfName, Description: description, Required: a.required}
+		if a.required || a.optional {
+			td.Arguments = append(td.Arguments, v)
+		} else {
+			td.Attributes = append(td.Attributes, v)
+		}
+		return
+	}
+
+	t := a.member.Type
+	if t.Kind == sdkmodel.KindList {
+		t = t.Elem
+	}
+	td.Arguments = append(td.Arguments, docAttribute{Name: a.tfName, Description: fmt.Sprintf("%s See [`%s`](#%s) below.", description, a.tfName, a.tfName), Required: a.required})
+
+	block := docBlock{Name: a.tfName}
+	if s, err := g.pkg.Structure(t.Name); err == nil {
+		for _, m := range s.Members {
+			block.Arguments = append(block.Arguments, docAttribute{
+				Name:        names.ToSnakeCase(convert.ToGoFieldName(m.Name)),
+				Description: docSentence(m.Doc),
+				Required:    m.Required,
+			})
+		}
+	}
+	td.Blocks = append(td.Blocks, block)
+}
+
+func isBlock(t *sdkmodel.Type) bool {
+	return t.Kind == sdkmodel.KindStructure || (t.Kind == sdkmodel.KindList && t.Elem.Kind == sdkmodel.KindStructure)
+}
+
+// trimResourcePrefix trims a resource name prefix from a member name, e.g. WidgetArn becomes Arn.
+func trimResourcePrefix(name, resource string) string {
+	if v := strings.TrimPrefix(name, resource); v != name && v != "" && strings.ToUpper(v[:1]) == v[:1] {
+		return v
+	}
+
+	return name
+}
+
+// docSentence returns the first sentence of an AWS SDK for Go v2 member's documentation.
+func docSentence(doc string) string {
+	doc = strings.Join(strings.Fields(doc), " ")
+	if i := strings.Index(doc, ". "); i >= 0 {
+		doc = doc[:i+1]
+	}
+	if doc == "" {
+		return "TODO Concise description."
+	}
+
+	return doc
+}
+
+// exampleValue returns an example HCL value of the specified type for use in test configurations.
+func exampleValue(tfName string, t *sdkmodel.Type, pkg *sdkmodel.Package) string {
+	switch t.Kind {
+	case sdkmodel.KindString:
+		if tfName == names.AttrName || strings.HasSuffix(tfName, "_name") {
+			return "%[1]q"
+		}
+	case sdkmodel.KindBool:
+		return "false"
+	case sdkmodel.KindInt32, sdkmodel.KindInt64, sdkmodel.KindFloat32, sdkmodel.KindFloat64:
+		return "1"
+	case sdkmodel.KindEnum:
+		if values := pkg.EnumValues(t.Name); len(values) > 0 {
+			return fmt.Sprintf("%q", values[0].Value)
+		}
+	case sdkmodel.KindList:
+		return "[] # TODO"
+	case sdkmodel.KindMap:
+		return "{} # TODO"
+	}
+
+	return `"TODO"`
+}
+
+// addExports exports the resource's constructor and finder to acceptance tests.
+func addExports(td SDKTemplateData) error {
+	const filename = "exports_test.go"
+
+	exports := fmt.Sprintf("\tResource%[1]s = newResource%[1]s\n\tFind%[1]sByID = find%[1]sByID\n", td.Resource)
+
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		b = []byte(fmt.Sprintf("// Copyright (c) HashiCorp, Inc.\n// SPDX-License-Identifier: MPL-2.0\n\npackage %s\n\n// Exports for use in tests only.\nvar (\n%s)\n", td.ServicePackage, exports))
+	} else if err != nil {
+		return err
+	} else {
+		s := string(b)
+		i := strings.Index(s, "var (\n")
+		if i < 0 {
+			return fmt.Errorf("%s has no var block", filename)
+		}
+		i += len("var (\n")
+		b = []byte(s[:i] + exports + s[i:])
+	}
+
+	return writeGoFile(filename, b)
+}
+
+// addListPages adds the list operation to the service package's listpages go:generate directive.
+func addListPages(listOp string) error {
+	const (
+		filename  = "generate.go"
+		directive = "//go:generate go run ../../generate/listpages/main.go"
+	)
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(b), "\n")
+	i := slices.IndexFunc(lines, func(line string) bool {
+		return strings.HasPrefix(line, directive)
+	})
+
+	if i < 0 {
+		i = slices.IndexFunc(lines, func(line string) bool {
+			return strings.HasPrefix(line, "//go:generate ")
+		})
+		if i < 0 {
+			return fmt.Errorf("%s has no go:generate directives", filename)
+		}
+		lines = slices.Insert(lines, i, fmt.Sprintf("%s -ListOps=%s", directive, listOp))
+	} else {
+		fields := strings.Fields(lines[i])
+		for j, field := range fields {
+			if v, ok := strings.CutPrefix(field, "-ListOps="); ok {
+				ops := strings.Split(v, ",")
+				if slices.Contains(ops, listOp) {
+					return nil
+				}
+				ops = append(ops, listOp)
+				slices.Sort(ops)
+				fields[j] = "-ListOps=" + strings.Join(ops, ",")
+			}
+		}
+		lines[i] = strings.Join(fields, " ")
+	}
+
+	return os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0644)
+}
+
+// addSweeper registers and adds the resource's sweeper to the service package's sweep.go, creating it if necessary.
+func addSweeper(td SDKTemplateData) error {
+	const filename = "sweep.go"
+
+	var buffer bytes.Buffer
+	tplate, err := template.New("sweep").Parse(sweepSDKTmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+	if err := tplate.ExecuteTemplate(&buffer, "sweep", td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	b, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return writeGoFile(filename, buffer.Bytes())
+	} else if err != nil {
+		return err
+	}
+
+	var register, function bytes.Buffer
+	if err := tplate.ExecuteTemplate(&register, "register", td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+	if err := tplate.ExecuteTemplate(&function, "function", td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	s := string(b)
+	if strings.Contains(s, strings.TrimSpace(register.String())) {
+		return nil
+	}
+
+	const registerFunc = "func RegisterSweepers() {\n"
+	i := strings.Index(s, registerFunc)
+	if i < 0 {
+		return fmt.Errorf("%s has no RegisterSweepers function", filename)
+	}
+	i += len(registerFunc)
+	s = s[:i] + register.String() + "\n" + s[i:] + "\n" + function.String()
+
+	// Add any missing imports.
+	imports := []string{
+		`"context"`,
+		fmt.Sprintf(`"github.com/aws/aws-sdk-go-v2/service/%s"`, td.SDKPackage),
+		`"github.com/hashicorp/terraform-provider-aws/internal/conns"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/sweep"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"`,
+	}
+	if td.ListItemIDExpr != "" {
+		imports = append(imports,
+			`"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"`,
+			`"github.com/hashicorp/terraform-provider-aws/names"`,
+		)
+	}
+	if strings.HasPrefix(td.ListItemIDExpr, "aws.") {
+		imports = append(imports, `"github.com/aws/aws-sdk-go-v2/aws"`)
+	}
+	for _, v := range imports {
+		if !strings.Contains(s, v) {
+			s = strings.Replace(s, "import (\n", "import (\n\t"+v+"\n", 1)
+		}
+	}
+
+	return writeGoFile(filename, []byte(s))
+}
+
+func writeSDKTemplate(templateName, filename, tmpl string, force bool, td SDKTemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	tplate, err := template.New(templateName).Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if filepath.Ext(filename) == ".go" {
+		return writeGoFile(filename, buffer.Bytes())
+	}
+
+	return os.WriteFile(filename, buffer.Bytes(), 0644)
+}
+
+// writeGoFile formats and writes Go source, writing it unformatted if it isn't valid Go.
+func writeGoFile(filename string, src []byte) error {
+	if contents, err := format.Source(src); err == nil {
+		src = contents
+	} else {
+		fmt.Fprintf(os.Stderr, "warning: formatting generated file (%s): %s\n", filename, err)
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkmodel reads the operation input and output shapes of an AWS SDK for Go v2 service package from its source.
+package sdkmodel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of a member's type.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindBool
+	KindInt32
+	KindInt64
+	KindFloat32
+	KindFloat64
+	KindTimestamp
+	KindBlob
+	KindEnum
+	KindStructure
+	KindList
+	KindMap
+	KindUnion
+	KindDocument
+)
+
+// Type is a member's type.
+type Type struct {
+	Kind Kind
+	// Name is the name of the type in the service's types package for enums, structures and unions.
+	Name string
+	// Elem is the element type of lists and maps.
+	Elem *Type
+}
+
+// Member is a field of an operation input, operation output or structure.
+type Member struct {
+	Name     string
+	Doc      string
+	Required bool
+	Type     *Type
+}
+
+// Shape is an operation input, operation output or structure.
+type Shape struct {
+	Name    string
+	Members []*Member
+}
+
+// Member returns the shape's member with the specified name, or nil.
+func (s *Shape) Member(name string) *Member {
+	for _, m := range s.Members {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Name   string
+	Input  *Shape
+	Output *Shape
+}
+
+// EnumValue is one of an enum's values.
+type EnumValue struct {
+	// Name is the name of the constant, e.g. WidgetStatusActive.
+	Name  string
+	Value string
+}
+
+// Package is an AWS SDK for Go v2 service package.
+type Package struct {
+	Name string
+
+	structs    map[string]*ast.StructType // Operation inputs and outputs.
+	types      map[string]*ast.StructType // Structures in the types package.
+	enums      map[string][]EnumValue
+	unions     map[string]struct{}
+	errorTypes []string
+}
+
+// Load finds the source of the specified AWS SDK for Go v2 service package (e.g. github.com/aws/aws-sdk-go-v2/service/s3)
+// in the module cache of the current Go module and loads it.
+func Load(ctx context.Context, importPath string) (*Package, error) {
+	cmd := exec.CommandContext(ctx, "go", "list", "-m", "-f", "{{.Dir}}", importPath)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating module %s: %w: %s", importPath, err, stderr.String())
+	}
+
+	dir := strings.TrimSpace(string(output))
+	if dir == "" {
+		return nil, fmt.Errorf("locating module %s: module not downloaded", importPath)
+	}
+
+	return LoadDir(dir)
+}
+
+// LoadDir loads the AWS SDK for Go v2 service package whose source is in the specified directory.
+func LoadDir(dir string) (*Package, error) {
+	p := &Package{
+		structs: make(map[string]*ast.StructType),
+		types:   make(map[string]*ast.StructType),
+		enums:   make(map[string][]EnumValue),
+		unions:  make(map[string]struct{}),
+	}
+
+	name, err := parseDir(dir, func(filename string, file *ast.File) {
+		for _, spec := range typeSpecs(file) {
+			if v, ok := spec.Type.(*ast.StructType); ok {
+				p.structs[spec.Name.Name] = v
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.Name = name
+
+	if _, err := parseDir(filepath.Join(dir, "types"), p.addTypes); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *Package) addTypes(filename string, file *ast.File) {
+	for _, spec := range typeSpecs(file) {
+		switch v := spec.Type.(type) {
+		case *ast.StructType:
+			if filepath.Base(filename) == "errors.go" {
+				p.errorTypes = append(p.errorTypes, spec.Name.Name)
+			} else {
+				p.types[spec.Name.Name] = v
+			}
+		case *ast.InterfaceType:
+			p.unions[spec.Name.Name] = struct{}{}
+		case *ast.Ident:
+			if v.Name == "string" {
+				if _, ok := p.enums[spec.Name.Name]; !ok {
+					p.enums[spec.Name.Name] = nil
+				}
+			}
+		}
+	}
+
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			typ, ok := spec.Type.(*ast.Ident)
+			if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+				continue
+			}
+			lit, ok := spec.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+			value, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				continue
+			}
+
+			p.enums[typ.Name] = append(p.enums[typ.Name], EnumValue{
+				Name:  spec.Names[0].Name,
+				Value: value,
+			})
+		}
+	}
+}
+
+// Operation returns the specified operation's input and output shapes.
+func (p *Package) Operation(name string) (*Operation, error) {
+	input, ok := p.structs[name+"Input"]
+	if !ok {
+		return nil, fmt.Errorf("operation %s.%s not found", p.Name, name)
+	}
+	output, ok := p.structs[name+"Output"]
+	if !ok {
+		return nil, fmt.Errorf("operation %s.%s output not found", p.Name, name)
+	}
+
+	return &Operation{
+		Name:   name,
+		Input:  p.shape(name+"Input", input, false),
+		Output: p.shape(name+"Output", output, false),
+	}, nil
+}
+
+// Structure returns the specified structure from the service's types package.
+func (p *Package) Structure(name string) (*Shape, error) {
+	v, ok := p.types[name]
+	if !ok {
+		return nil, fmt.Errorf("structure %s/types.%s not found", p.Name, name)
+	}
+
+	return p.shape(name, v, true), nil
+}
+
+// EnumValues returns the specified enum's values, in declaration order.
+func (p *Package) EnumValues(name string) []EnumValue {
+	return p.enums[name]
+}
+
+// NotFoundError returns the name of the error type the service returns when a resource isn't found, or "".
+func (p *Package) NotFoundError() string {
+	if slices.Contains(p.errorTypes, "ResourceNotFoundException") {
+		return "ResourceNotFoundException"
+	}
+
+	for _, v := range p.errorTypes {
+		if strings.Contains(v, "NotFound") {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func (p *Package) shape(name string, v *ast.StructType, inTypes bool) *Shape {
+	shape := &Shape{
+		Name: name,
+	}
+
+	for _, field := range v.Fields.List {
+		// Skip embedded fields (e.g. noSmithyDocumentSerde).
+		if len(field.Names) == 0 {
+			continue
+		}
+
+		for _, ident := range field.Names {
+			if !ident.IsExported() || ident.Name == "ResultMetadata" {
+				continue
+			}
+
+			doc := strings.TrimSpace(field.Doc.Text())
+			shape.Members = append(shape.Members, &Member{
+				Name:     ident.Name,
+				Doc:      doc,
+				Required: strings.Contains(doc, "This member is required."),
+				Type:     p.resolve(field.Type, inTypes),
+			})
+		}
+	}
+
+	return shape
+}
+
+func (p *Package) resolve(expr ast.Expr, inTypes bool) *Type {
+	switch v := expr.(type) {
+	case *ast.StarExpr:
+		return p.resolve(v.X, inTypes)
+
+	case *ast.Ident:
+		switch v.Name {
+		case "string":
+			return &Type{Kind: KindString}
+		case "bool":
+			return &Type{Kind: KindBool}
+		case "int32":
+			return &Type{Kind: KindInt32}
+		case "int64":
+			return &Type{Kind: KindInt64}
+		case "float32":
+			return &Type{Kind: KindFloat32}
+		case "float64":
+			return &Type{Kind: KindFloat64}
+		}
+
+		if inTypes {
+			return p.named(v.Name)
+		}
+
+	case *ast.SelectorExpr:
+		switch types.ExprString(v) {
+		case "time.Time":
+			return &Type{Kind: KindTimestamp}
+		case "document.Interface":
+			return &Type{Kind: KindDocument}
+		}
+
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == "types" {
+			return p.named(v.Sel.Name)
+		}
+
+	case *ast.ArrayType:
+		if v.Len == nil {
+			if ident, ok := v.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+				return &Type{Kind: KindBlob}
+			}
+
+			return &Type{Kind: KindList, Elem: p.resolve(v.Elt, inTypes)}
+		}
+
+	case *ast.MapType:
+		return &Type{Kind: KindMap, Elem: p.resolve(v.Value, inTypes)}
+	}
+
+	return &Type{Kind: KindUnsupported}
+}
+
+func (p *Package) named(name string) *Type {
+	if _, ok := p.types[name]; ok {
+		return &Type{Kind: KindStructure, Name: name}
+	}
+	if _, ok := p.enums[name]; ok {
+		return &Type{Kind: KindEnum, Name: name}
+	}
+	if _, ok := p.unions[name]; ok {
+		return &Type{Kind: KindUnion, Name: name}
+	}
+
+	return &Type{Kind: KindUnsupported, Name: name}
+}
+
+func parseDir(dir string, f func(string, *ast.File)) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", dir, err)
+	}
+
+	var name string
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		filename := entry.Name()
+		if entry.IsDir() || filepath.Ext(filename) != ".go" || strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		path := filepath.Join(dir, filename)
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return "", fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		name = file.Name.Name
+		f(path, file)
+	}
+
+	return name, nil
+}
+
+func typeSpecs(file *ast.File) []*ast.TypeSpec {
+	var specs []*ast.TypeSpec
+
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			specs = append(specs, spec.(*ast.TypeSpec))
+		}
+	}
+
+	return specs
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkmodel
+
+import (
+	"testing"
+)
+
+func TestLoadDir(t *testing.T) {
+	t.Parallel()
+
+	p, err := LoadDir("testdata/widgets")
+	if err != nil {
+		t.Fatalf("LoadDir: %s", err)
+	}
+
+	if got, want := p.Name, "widgets"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+
+	if got, want := p.NotFoundError(), "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundError() = %q, want %q", got, want)
+	}
+
+	if _, err := p.Operation("RebootWidget"); err == nil {
+		t.Error("Operation(RebootWidget): expected error")
+	}
+
+	op, err := p.Operation("CreateWidget")
+	if err != nil {
+		t.Fatalf("Operation(CreateWidget): %s", err)
+	}
+
+	testCases := map[string]struct {
+		shape    *Shape
+		member   string
+		kind     Kind
+		name     string
+		required bool
+	}{
+		"required string": {
+			shape:    op.Input,
+			member:   "WidgetName",
+			kind:     KindString,
+			required: true,
+		},
+		"structure": {
+			shape:  op.Input,
+			member: "Configuration",
+			kind:   KindStructure,
+			name:   "WidgetConfiguration",
+		},
+		"int32": {
+			shape:  op.Input,
+			member: "Size",
+			kind:   KindInt32,
+		},
+		"map": {
+			shape:  op.Input,
+			member: "Tags",
+			kind:   KindMap,
+		},
+		"output": {
+			shape:    op.Output,
+			member:   "WidgetId",
+			kind:     KindString,
+			required: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := testCase.shape.Member(testCase.member)
+			if m == nil {
+				t.Fatalf("member %s not found", testCase.member)
+			}
+
+			if got, want := m.Type.Kind, testCase.kind; got != want {
+				t.Errorf("Kind = %d, want %d", got, want)
+			}
+			if got, want := m.Type.Name, testCase.name; got != want {
+				t.Errorf("Name = %q, want %q", got, want)
+			}
+			if got, want := m.Required, testCase.required; got != want {
+				t.Errorf("Required = %t, want %t", got, want)
+			}
+		})
+	}
+
+	if m := op.Output.Member("ResultMetadata"); m != nil {
+		t.Error("ResultMetadata not skipped")
+	}
+}
+
+func TestStructure(t *testing.T) {
+	t.Parallel()
+
+	p, err := LoadDir("testdata/widgets")
+	if err != nil {
+		t.Fatalf("LoadDir: %s", err)
+	}
+
+	if _, err := p.Structure("ThrottlingException"); err == nil {
+		t.Error("Structure(ThrottlingException): expected error")
+	}
+
+	s, err := p.Structure("Widget")
+	if err != nil {
+		t.Fatalf("Structure(Widget): %s", err)
+	}
+
+	testCases := map[string]struct {
+		member string
+		kind   Kind
+		name   string
+		elem   Kind
+	}{
+		"enum": {
+			member: "Status",
+			kind:   KindEnum,
+			name:   "WidgetStatus",
+		},
+		"structure": {
+			member: "Configuration",
+			kind:   KindStructure,
+			name:   "WidgetConfiguration",
+		},
+		"timestamp": {
+			member: "CreatedAt",
+			kind:   KindTimestamp,
+		},
+		"document": {
+			member: "Metadata",
+			kind:   KindDocument,
+		},
+		"map of string": {
+			member: "Tags",
+			kind:   KindMap,
+			elem:   KindString,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := s.Member(testCase.member)
+			if m == nil {
+				t.Fatalf("member %s not found", testCase.member)
+			}
+
+			if got, want := m.Type.Kind, testCase.kind; got != want {
+				t.Errorf("Kind = %d, want %d", got, want)
+			}
+			if got, want := m.Type.Name, testCase.name; got != want {
+				t.Errorf("Name = %q, want %q", got, want)
+			}
+			if testCase.elem != KindUnsupported {
+				if got, want := m.Type.Elem.Kind, testCase.elem; got != want {
+					t.Errorf("Elem.Kind = %d, want %d", got, want)
+				}
+			}
+		})
+	}
+
+	values := p.EnumValues("WidgetStatus")
+	if got, want := len(values), 5; got != want {
+		t.Fatalf("len(EnumValues) = %d, want %d", got, want)
+	}
+	if got, want := values[1], (EnumValue{Name: "WidgetStatusActive", Value: "ACTIVE"}); got != want {
+		t.Errorf("EnumValues[1] = %v, want %v", got, want)
+	}
+}
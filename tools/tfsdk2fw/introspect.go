@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+	"golang.org/x/exp/slices"
+)
+
+// goFunc is a function declared in a service package.
+type goFunc struct {
+	Name    string
+	Params  []goParam
+	Results []string
+}
+
+type goParam struct {
+	Name string
+	Type string
+}
+
+// packageFuncs returns the top-level functions declared in the non-test Go source files in the specified directory.
+func packageFuncs(dirname string) (map[string]*goFunc, error) {
+	fset := token.NewFileSet()
+	funcs := make(map[string]*goFunc)
+
+	filenames, err := filepath.Glob(filepath.Join(dirname, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filename, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filename, err)
+		}
+
+		for _, decl := range file.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Recv != nil {
+				continue
+			}
+
+			f := &goFunc{
+				Name: decl.Name.Name,
+			}
+			for _, field := range decl.Type.Params.List {
+				typ := types.ExprString(field.Type)
+				if len(field.Names) == 0 {
+					f.Params = append(f.Params, goParam{Type: typ})
+				}
+				for _, name := range field.Names {
+					f.Params = append(f.Params, goParam{Name: name.Name, Type: typ})
+				}
+			}
+			if results := decl.Type.Results; results != nil {
+				for _, field := range results.List {
+					for range max(len(field.Names), 1) {
+						f.Results = append(f.Results, types.ExprString(field.Type))
+					}
+				}
+			}
+
+			funcs[f.Name] = f
+		}
+	}
+
+	return funcs, nil
+}
+
+// finder returns the resource's existing finder function, if any.
+// Finders are named find<Name> or find<Name>By<Key>, with "ByID" preferred.
+func finder(funcs map[string]*goFunc, name string) *goFunc {
+	prefix := "find" + name
+	var names []string
+
+	for v := range funcs {
+		if v == prefix || strings.HasPrefix(v, prefix+"By") {
+			names = append(names, v)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	slices.Sort(names)
+	if slices.Contains(names, prefix+"ByID") {
+		return funcs[prefix+"ByID"]
+	}
+
+	return funcs[names[0]]
+}
+
+// waiter returns the resource's existing waiter function for the specified operation (e.g. "Created"), if any.
+func waiter(funcs map[string]*goFunc, name, operation string) *goFunc {
+	return funcs["wait"+name+operation]
+}
+
+// callArgs returns the arguments with which generated code calls the function.
+// Parameters are matched by type and name to values available in resource CRUD handlers.
+func (f *goFunc) callArgs(timeout string) string {
+	var args []string
+
+	for _, param := range f.Params {
+		var arg string
+
+		switch {
+		case param.Type == "context.Context":
+			arg = "ctx"
+		case strings.HasSuffix(param.Type, ".Client"):
+			arg = "conn"
+		case param.Type == "time.Duration" && timeout != "":
+			arg = timeout
+		case param.Type == "string" && strings.EqualFold(param.Name, "id"):
+			arg = "data.ID.ValueString()"
+		case param.Type == "string":
+			arg = fmt.Sprintf("data.%s.ValueString()", naming.ToCamelCase(param.Name))
+		default:
+			arg = fmt.Sprintf("%s /* TODO %s */", param.Name, param.Type)
+		}
+
+		args = append(args, arg)
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// funcName returns the fully qualified name of the specified function value, e.g.
+// "github.com/hashicorp/terraform-provider-aws/internal/verify.SuppressEquivalentPolicyDiffs".
+func funcName(f any) string {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+
+	return ""
+}
+
+// localFuncName returns the name of a function value declared at the top level of the specified service package.
+// Closures and functions declared in other packages are not local.
+func localFuncName(f any, packageName string) (string, bool) {
+	name := funcName(f)
+
+	pkg, name, ok := cutLast(name, ".")
+	if !ok || !strings.HasSuffix(pkg, "/internal/service/"+packageName) || strings.HasPrefix(name, "func") {
+		return "", false
+	}
+
+	return name, true
+}
+
+// shortFuncName trims the module path from a fully qualified function name.
+func shortFuncName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+
+	return name
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+
+	return s[:i], s[i+len(sep):], true
+}
+
+// serviceDirExists returns whether or not the specified service package source directory exists.
+func serviceDirExists(dirname string) bool {
+	info, err := os.Stat(dirname)
+
+	return err == nil && info.IsDir()
+}
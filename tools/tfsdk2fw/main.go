@@ -6,16 +6,20 @@ package main
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"path"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
 	"golang.org/x/exp/slices"
 )
@@ -23,11 +27,12 @@ import (
 var (
 	dataSourceType = flag.String("data-source", "", "Data Source type")
 	resourceType   = flag.String("resource", "", "Resource type")
+	stateFile      = flag.String("state", "", "Terraform state file containing an instance of the resource recorded by the Plugin SDK resource")
 )
 
 func usage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n")
-	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type>|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
+	fmt.Fprintf(os.Stderr, "\ttfsdk2fw [-resource <resource-type> [-state <state-file>]|-data-source <data-source-type>] <package-name> <name> <generated-file>\n\n")
 }
 
 func main() {
@@ -82,12 +87,13 @@ func main() {
 		}
 
 		migrator.Resource = resource
+		migrator.StateFile = *stateFile
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v
 	}
 
 	if err := migrator.migrate(outputFilename); err != nil {
-		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
+		g.Fatalf("error migrating Terraform %s: %s", migrator.TFTypeName, err)
 	}
 }
 
@@ -97,6 +103,7 @@ type migrator struct {
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	StateFile    string
 	Template     string
 	TFTypeName   string
 }
@@ -113,7 +120,7 @@ func (m *migrator) migrate(outputFilename string) error {
 		return fmt.Errorf("creating target directory %s: %w", dirname, err)
 	}
 
-	templateData, err := m.generateTemplateData()
+	templateData, err := m.generateTemplateData(dirname)
 
 	if err != nil {
 		return err
@@ -121,19 +128,108 @@ func (m *migrator) migrate(outputFilename string) error {
 
 	d := m.Generator.NewGoFileDestination(outputFilename)
 
-	if err := d.BufferTemplate("schema", m.Template, templateData); err != nil {
+	if err := d.BufferTemplate("schema", m.Template, templateData, templateFuncMap); err != nil {
+		return err
+	}
+
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	// State compatibility test.
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_state_test.go"
+	m.infof("generating state compatibility test into %[1]q", testFilename)
+
+	if err := m.generateRecordedState(templateData); err != nil {
+		return err
+	}
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.BufferTemplate("statetest", stateTestImpl, templateData, templateFuncMap); err != nil {
 		return err
 	}
 
 	return d.Write()
 }
 
-func (m *migrator) generateTemplateData() (*templateData, error) {
+// generateRecordedState sets the Plugin SDK resource state used by the state compatibility test.
+// If no state file is specified a minimal state is used.
+func (m *migrator) generateRecordedState(templateData *templateData) error {
+	templateData.RecordedSchemaVersion = int64(m.Resource.SchemaVersion)
+	attributes := map[string]any{
+		"id": "test-id",
+	}
+
+	if m.StateFile == "" {
+		m.Generator.Warnf("no state file specified, state compatibility test uses a minimal state")
+	} else {
+		b, err := os.ReadFile(m.StateFile)
+
+		if err != nil {
+			return fmt.Errorf("reading state file: %w", err)
+		}
+
+		var state struct {
+			Resources []struct {
+				Mode      string `json:"mode"`
+				Type      string `json:"type"`
+				Instances []struct {
+					SchemaVersion int64          `json:"schema_version"`
+					Attributes    map[string]any `json:"attributes"`
+				} `json:"instances"`
+			} `json:"resources"`
+		}
+
+		if err := json.Unmarshal(b, &state); err != nil {
+			return fmt.Errorf("decoding state file: %w", err)
+		}
+
+		found := false
+		for _, resource := range state.Resources {
+			if resource.Mode != "managed" || resource.Type != m.TFTypeName || len(resource.Instances) == 0 {
+				continue
+			}
+
+			instance := resource.Instances[0]
+			templateData.RecordedSchemaVersion = instance.SchemaVersion
+			attributes = instance.Attributes
+			found = true
+
+			break
+		}
+
+		if !found {
+			return fmt.Errorf("no %s resource instance found in state file %s", m.TFTypeName, m.StateFile)
+		}
+	}
+
+	b, err := json.MarshalIndent(attributes, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("encoding recorded state: %w", err)
+	}
+
+	if v := string(b); strings.Contains(v, "`") {
+		templateData.RecordedState = fmt.Sprintf("%q", v)
+	} else {
+		templateData.RecordedState = "`" + v + "`"
+	}
+
+	return nil
+}
+
+func (m *migrator) generateTemplateData(dirname string) (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelNames:   make(map[string]bool),
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
@@ -144,6 +240,13 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	connMethod := "TODOClient"
+	if v, err := names.ProviderNameUpper(m.PackageName); err == nil {
+		connMethod = v + "Client"
+	} else {
+		m.Generator.Warnf("service package %s not found: %s", m.PackageName, err)
+	}
+
 	templateData := &templateData{
 		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
 		DefaultReadTimeout:           emitter.DefaultReadTimeout,
@@ -153,8 +256,11 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
 		HasTimeouts:                  emitter.HasTimeouts,
+		ConnMethod:                   connMethod,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		ImportTags:                   emitter.ImportTags,
+		Models:                       emitter.Models,
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
@@ -162,6 +268,39 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		if v := m.Resource.CustomizeDiff; v != nil {
+			if name := funcName(v); !strings.HasSuffix(name, "/internal/verify.SetTagsDiff") {
+				templateData.CustomizeDiff = shortFuncName(name)
+				templateData.EmitResourceModifyPlan = true
+			}
+		}
+
+		m.generateStateUpgraders(templateData)
+	}
+
+	// Existing finders and waiters are used in the generated CRUD handlers.
+	if serviceDirExists(dirname) {
+		funcs, err := packageFuncs(dirname)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v := finder(funcs, m.Name); v != nil {
+			templateData.FinderCall = fmt.Sprintf("%s(%s)", v.Name, v.callArgs(""))
+		}
+		if v := waiter(funcs, m.Name, "Created"); v != nil {
+			templateData.WaitCreatedCall = fmt.Sprintf("%s(%s)", v.Name, v.callArgs("r.CreateTimeout(ctx, data.Timeouts)"))
+		}
+		if v := waiter(funcs, m.Name, "Updated"); v != nil {
+			templateData.WaitUpdatedCall = fmt.Sprintf("%s(%s)", v.Name, strings.ReplaceAll(v.callArgs("r.UpdateTimeout(ctx, new.Timeouts)"), "data.", "new."))
+		}
+		if v := waiter(funcs, m.Name, "Deleted"); v != nil {
+			templateData.WaitDeletedCall = fmt.Sprintf("%s(%s)", v.Name, v.callArgs("r.DeleteTimeout(ctx, data.Timeouts)"))
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -181,6 +320,32 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 	return templateData, nil
 }
 
+// generateStateUpgraders translates the Plugin SDK resource's state upgraders.
+// Plugin SDK state upgraders each upgrade state by a single version, whereas Plugin Framework state upgraders
+// upgrade state from a prior version directly to the current version, so each generated state upgrader
+// runs the chain of Plugin SDK state upgraders from its version.
+func (m *migrator) generateStateUpgraders(templateData *templateData) {
+	upgraders := m.Resource.StateUpgraders
+
+	for i, upgrader := range upgraders {
+		v := stateUpgrader{
+			Version: int64(upgrader.Version),
+		}
+
+		for _, upgrader := range upgraders[i:] {
+			name, ok := localFuncName(upgrader.Upgrade, m.PackageName)
+			if !ok {
+				m.Generator.Warnf("state upgrader from version %d (%s) cannot be called by name", upgrader.Version, shortFuncName(funcName(upgrader.Upgrade)))
+				name = fmt.Sprintf("TODOUpgradeFromV%d", upgrader.Version)
+			}
+
+			v.Funcs = append(v.Funcs, name)
+		}
+
+		templateData.StateUpgraders = append(templateData.StateUpgraders, v)
+	}
+}
+
 func (m *migrator) infof(format string, a ...interface{}) {
 	m.Generator.Infof(format, a...)
 }
@@ -199,7 +364,10 @@ type emitter struct {
 	HasTopLevelTagsMap            bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
 	IsDataSource                  bool
+	ModelNames                    map[string]bool // Names of emitted nested models.
+	Models                        []string        // Nested model struct declarations.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
@@ -235,7 +403,7 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 
 	fprintf(e.SchemaWriter, "schema.Schema{\n")
 
-	err := e.emitAttributesAndBlocks(nil, resource.Schema)
+	err := e.emitAttributesAndBlocks(nil, resource.Schema, e.StructWriter)
 
 	if err != nil {
 		return err
@@ -259,9 +427,9 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 }
 
 // emitAttributesAndBlocks generates the Plugin Framework code for a set of Plugin SDK Attributes and Blocks
-// and emits the generated code to the emitter's Writer.
+// and emits the generated code to the emitter's Writer and the corresponding model fields to the specified Writer.
 // Property names are sorted prior to code generation to reduce diffs.
-func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
+func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema, structWriter io.Writer) error {
 	isTopLevelAttribute := len(path) == 0
 
 	// At this point we are emitting code for a schema.Block or Schema.
@@ -294,22 +462,36 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			}
 		}
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(structWriter, "%s ", naming.ToCamelCase(name))
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
-
-		if name == "id" && isTopLevelAttribute {
+		switch {
+		case name == "id" && isTopLevelAttribute:
 			fprintf(e.SchemaWriter, "framework.IDAttribute()")
-		} else {
-			if err := e.emitAttributeProperty(append(path, name), property); err != nil {
+			fprintf(structWriter, "types.String")
+
+		case name == "tags" && isTopLevelAttribute && isMapOfString(property):
+			e.HasTopLevelTagsMap = true
+			e.ImportTags = true
+			if property.Optional && !e.IsDataSource {
+				fprintf(e.SchemaWriter, "tftags.TagsAttribute()")
+			} else {
+				fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			}
+			fprintf(structWriter, "tftags.Map")
+
+		case name == "tags_all" && isTopLevelAttribute && isMapOfString(property):
+			e.HasTopLevelTagsAllMap = true
+			e.ImportTags = true
+			fprintf(e.SchemaWriter, "tftags.TagsAttributeComputedOnly()")
+			fprintf(structWriter, "tftags.Map")
+
+		default:
+			if err := e.emitAttributeProperty(append(path, name), property, structWriter); err != nil {
 				return err
 			}
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(structWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -331,13 +513,16 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(structWriter, "%s ", naming.ToCamelCase(name))
 
-		err := e.emitBlockProperty(append(path, name), property)
+		err := e.emitBlockProperty(append(path, name), property, structWriter)
 
 		if err != nil {
 			return err
 		}
 
+		fprintf(structWriter, " `tfsdk:%q`\n", name)
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -348,14 +533,14 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 }
 
 // emitAttributeProperty generates the Plugin Framework code for a Plugin SDK Attribute's property
-// and emits the generated code to the emitter's Writer.
-func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema) error {
+// and emits the generated code to the emitter's Writer and the model field's type to the specified Writer.
+func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema, structWriter io.Writer) error {
 	attributeName := path[len(path)-1]
 	isComputedOnly := property.Computed && !property.Optional
-	isTopLevelAttribute := len(path) == 1
 	var planModifiers []string
 	var defaultSpec string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
+	var diffSuppressMigrated bool
 
 	// At this point we are emitting code for the values of a schema.Schema's Attributes (map[string]schema.Attribute).
 	switch v := property.Type; v {
@@ -364,51 +549,42 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	//
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(structWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
 
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(structWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
 
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(structWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
 
 	case schema.TypeString:
-		// Computed-only ARN attributes are easiest handled as strings.
-		if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+		fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
+
+		if customType, ok := e.diffSuppressCustomType(property); ok {
+			// Semantic equality of the custom type replaces the DiffSuppressFunc.
+			fprintf(e.SchemaWriter, "CustomType:%s,\n", customType.Type)
+			fprintf(structWriter, customType.Value)
+
+			diffSuppressMigrated = true
+		} else if (attributeName == "arn" || strings.HasSuffix(attributeName, "_arn")) && !isComputedOnly {
+			// Computed-only ARN attributes are easiest handled as strings.
 			e.ImportProviderFrameworkTypes = true
 
-			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(structWriter, "fwtypes.ARN")
 		} else {
-			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
-
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(structWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,17 +594,14 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	// Complex types.
 	//
 	case schema.TypeList, schema.TypeMap, schema.TypeSet:
-		var aggregateSchemaFactory, typeName string
+		var aggregateSchemaFactory, aggregateTypeName, typeName string
 
 		switch v {
 		case schema.TypeList:
 			aggregateSchemaFactory = "schema.ListAttribute{"
+			aggregateTypeName = "List"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
-
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
 			fwValidatorsPackage = "listvalidator"
@@ -436,12 +609,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case schema.TypeMap:
 			aggregateSchemaFactory = "schema.MapAttribute{"
+			aggregateTypeName = "Map"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
-
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
 			fwValidatorsPackage = "mapvalidator"
@@ -449,12 +619,9 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 		case schema.TypeSet:
 			aggregateSchemaFactory = "schema.SetAttribute{"
+			aggregateTypeName = "Set"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
-
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
 			fwValidatorsPackage = "setvalidator"
@@ -477,31 +644,45 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			case schema.TypeString:
 				elementType = "types.StringType"
-				// Special handling for 'tags' and 'tags_all'.
-				if typeName == "map" && isTopLevelAttribute {
-					if attributeName == "tags" {
-						e.HasTopLevelTagsMap = true
-						if property.Optional {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttribute()\n")
-						} else if property.Computed {
-							fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-						}
-					} else if attributeName == "tags_all" {
-						e.HasTopLevelTagsAllMap = true
-						fprintf(e.SchemaWriter, "// TODO tftags.TagsAttributeComputedOnly()\n")
-					}
-				}
 
 			default:
 				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %s", typeName, v.String()))
 			}
 
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+
+			if elementType == "types.StringType" {
+				// Collections of strings use the provider's custom types so that AutoFlex can expand and flatten them.
+				e.ImportProviderFrameworkTypes = true
+
+				fprintf(e.SchemaWriter, "CustomType:fwtypes.%sOfStringType,\n", aggregateTypeName)
+				fprintf(structWriter, "fwtypes.%sOfString", aggregateTypeName)
+			} else {
+				fprintf(structWriter, "types.%s", aggregateTypeName)
+			}
+
 			fprintf(e.SchemaWriter, "ElementType:%s,\n", elementType)
 
 		case *schema.Resource:
 			// We get here for Computed-only nested blocks or when ConfigMode is SchemaConfigModeBlock.
+			if v := property.Type; v == schema.TypeMap {
+				return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
+			}
+
+			// The nested model's schema is the attribute's element type, not nested attributes.
+			schemaWriter := e.SchemaWriter
+			e.SchemaWriter = io.Discard
+			modelName, err := e.emitModel(path, v.Schema)
+			e.SchemaWriter = schemaWriter
+
+			if err != nil {
+				return err
+			}
+
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "%s\n", aggregateSchemaFactory)
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", aggregateTypeName, modelName)
 			fprintf(e.SchemaWriter, "ElementType:")
 
 			if err := e.emitComputedOnlyBlock(path, v.Schema); err != nil {
@@ -509,6 +690,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			}
 
 			fprintf(e.SchemaWriter, ",\n")
+			fprintf(structWriter, "fwtypes.%sNestedObjectValueOf[%s]", aggregateTypeName, modelName)
 
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Attribute) %s of %T", typeName, v))
@@ -609,14 +791,22 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "// TODO Validate,\n")
 	}
 
+	if v := property.DiffSuppressFunc; v != nil && !diffSuppressMigrated {
+		fprintf(e.SchemaWriter, "// TODO DiffSuppressFunc %s: use a custom type with semantic equality,\n", shortFuncName(funcName(v)))
+	}
+
+	if v := property.StateFunc; v != nil {
+		fprintf(e.SchemaWriter, "// TODO StateFunc %s: normalize in Create and Update or use a custom type,\n", shortFuncName(funcName(v)))
+	}
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
 }
 
 // emitBlockProperty generates the Plugin Framework code for a Plugin SDK Block's property
-// and emits the generated code to the emitter's Writer.
-func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) error {
+// and emits the generated code to the emitter's Writer and the model field's type to the specified Writer.
+func (e *emitter) emitBlockProperty(path []string, property *schema.Schema, structWriter io.Writer) error {
 	var planModifiers []string
 	var fwPlanModifierPackage, fwPlanModifierType, fwValidatorsPackage, fwValidatorType string
 
@@ -636,7 +826,7 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			modelName, err := e.emitModel(path, v.Schema)
 
 			if err != nil {
 				return err
@@ -644,6 +834,11 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 
 			fprintf(e.SchemaWriter, "},\n")
 
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", modelName)
+			fprintf(structWriter, "fwtypes.ListNestedObjectValueOf[%s]", modelName)
+
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
 		}
@@ -659,7 +854,7 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			modelName, err := e.emitModel(path, v.Schema)
 
 			if err != nil {
 				return err
@@ -667,6 +862,11 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 
 			fprintf(e.SchemaWriter, "},\n")
 
+			e.ImportProviderFrameworkTypes = true
+
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", modelName)
+			fprintf(structWriter, "fwtypes.SetNestedObjectValueOf[%s]", modelName)
+
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
 		}
@@ -726,11 +926,110 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
 	}
 
+	// Plugin Framework blocks that are not configured are empty, so there is no diff to suppress.
+	if v := property.DiffSuppressFunc; v != nil && !strings.HasSuffix(funcName(v), "/internal/verify.SuppressMissingOptionalConfigurationBlock") {
+		fprintf(e.SchemaWriter, "// TODO DiffSuppressFunc %s: use a custom type with semantic equality,\n", shortFuncName(funcName(v)))
+	}
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
 }
 
+// emitModel generates the Plugin Framework code for a nested block's attributes and blocks,
+// emits the generated code to the emitter's Writer and adds the corresponding model to the emitter's models.
+// The model's type name is returned.
+func (e *emitter) emitModel(path []string, schema map[string]*schema.Schema) (string, error) {
+	name := e.modelName(path)
+	sbStruct := strings.Builder{}
+
+	if err := e.emitAttributesAndBlocks(path, schema, &sbStruct); err != nil {
+		return "", err
+	}
+
+	e.Models = append(e.Models, fmt.Sprintf("type %s struct {\n%s}\n", name, sbStruct.String()))
+
+	return name, nil
+}
+
+// modelName returns a unique type name for the model of the nested block at the specified path.
+// The innermost path elements that give a unique name are used.
+func (e *emitter) modelName(path []string) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		name := naming.ToLowerCamelCase(strings.Join(path[i:], "_")) + "Model"
+
+		if !e.ModelNames[name] {
+			e.ModelNames[name] = true
+			return name
+		}
+	}
+
+	for i := 2; ; i++ {
+		name := fmt.Sprintf("%s%dModel", naming.ToLowerCamelCase(strings.Join(path, "_")), i)
+
+		if !e.ModelNames[name] {
+			e.ModelNames[name] = true
+			return name
+		}
+	}
+}
+
+// diffSuppressCustomType returns the Plugin Framework custom type whose semantic equality
+// replaces the Plugin SDK attribute's DiffSuppressFunc, if any.
+func (e *emitter) diffSuppressCustomType(property *schema.Schema) (customType, bool) {
+	v := property.DiffSuppressFunc
+	if v == nil {
+		return customType{}, false
+	}
+
+	customType, ok := diffSuppressCustomTypes[shortFuncName(funcName(v))]
+	if !ok {
+		return customType, false
+	}
+
+	if customType.Import.Path == "" {
+		e.ImportProviderFrameworkTypes = true
+	} else if !slices.Contains(e.GoImports, customType.Import) {
+		e.GoImports = append(e.GoImports, customType.Import)
+	}
+
+	return customType, true
+}
+
+// customType is a Plugin Framework custom type.
+type customType struct {
+	Import goImport // Zero value for the provider's framework types package.
+	Type   string
+	Value  string
+}
+
+var (
+	iamPolicyCustomType = customType{
+		Type:  "fwtypes.IAMPolicyType",
+		Value: "fwtypes.IAMPolicy",
+	}
+	jsonCustomType = customType{
+		Import: goImport{
+			Path: "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes",
+		},
+		Type:  "jsontypes.NormalizedType{}",
+		Value: "jsontypes.Normalized",
+	}
+	caseInsensitiveStringCustomType = customType{
+		Type:  "fwtypes.CaseInsensitiveStringType",
+		Value: "fwtypes.CaseInsensitiveString",
+	}
+
+	// diffSuppressCustomTypes maps Plugin SDK DiffSuppressFuncs to equivalent Plugin Framework custom types.
+	diffSuppressCustomTypes = map[string]customType{
+		"sdkv2.SuppressEquivalentIAMPolicyDocuments":    iamPolicyCustomType,
+		"sdkv2.SuppressEquivalentJSONDocuments":         jsonCustomType,
+		"sdkv2.SuppressEquivalentStringCaseInsensitive": caseInsensitiveStringCustomType,
+		"verify.SuppressEquivalentJSONDiffs":            jsonCustomType,
+		"verify.SuppressEquivalentPolicyDiffs":          iamPolicyCustomType,
+	}
+)
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -864,6 +1163,17 @@ func fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
 	return io.WriteString(w, fmt.Sprintf(format, a...))
 }
 
+// isMapOfString returns whether or not the specified property is a map of strings.
+func isMapOfString(property *schema.Schema) bool {
+	if property.Type != schema.TypeMap {
+		return false
+	}
+
+	v, ok := property.Elem.(*schema.Schema)
+
+	return ok && v.Type == schema.TypeString
+}
+
 // isAttribute returns whether or not the specified property should be emitted as an Attribute (vs. a Block).
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/helper/schema/core_schema.go#L57.
 func isAttribute(property *schema.Schema) bool {
@@ -902,6 +1212,8 @@ func unsupportedTypeError(path []string, typ string) error {
 }
 
 type templateData struct {
+	ConnMethod                    string // e.g. EC2Client
+	CustomizeDiff                 string // Name of the Plugin SDK CustomizeDiff function, if it must be migrated.
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -915,11 +1227,25 @@ type templateData struct {
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	ImportTags                    bool
+	FinderCall                    string // e.g. findInstanceByID(ctx, conn, data.ID.ValueString())
+	Models                        []string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
+	RecordedSchemaVersion         int64
+	RecordedState                 string // Go string literal.
 	Schema                        string
+	StateUpgraders                []stateUpgrader
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	WaitCreatedCall               string
+	WaitDeletedCall               string
+	WaitUpdatedCall               string
+}
+
+type stateUpgrader struct {
+	Version int64
+	Funcs   []string // Plugin SDK state upgrade functions, in order.
 }
 
 //go:embed datasource.gtpl
@@ -928,6 +1254,29 @@ var datasourceImpl string
 //go:embed resource.gtpl
 var resourceImpl string
 
+//go:embed state_test.gtpl
+var stateTestImpl string
+
+var templateFuncMap = template.FuncMap{
+	"duration": durationExpr,
+}
+
+// durationExpr returns a human-friendly Go expression for the specified duration in nanoseconds.
+func durationExpr(v int64) string {
+	d := time.Duration(v)
+
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Nanosecond", d)
+	}
+}
+
 type goImport struct {
 	Path  string
 	Alias string
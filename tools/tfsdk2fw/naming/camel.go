@@ -45,6 +45,24 @@ func ToCamelCase(s string) string {
 	return s
 }
 
+// ToLowerCamelCase converts a string to lowerCamelCase.
+// A leading initialism is lowercased in its entirety, e.g. "id" -> "id", "vpc_id" -> "vpcID".
+func ToLowerCamelCase(s string) string {
+	s = ToCamelCase(s)
+
+	n := 0
+	for n < len(s) && isCapitalLetter(s[n]) {
+		n++
+	}
+
+	// Keep the last capital letter of a leading initialism that's followed by a lowercase letter.
+	if n > 1 && n < len(s) && isLowercaseLetter(s[n]) {
+		n--
+	}
+
+	return strings.ToLower(s[:n]) + s[n:]
+}
+
 func isCapitalLetter(ch byte) bool {
 	return ch >= 'A' && ch <= 'Z'
 }
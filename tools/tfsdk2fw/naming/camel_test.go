@@ -67,3 +67,47 @@ func TestToCamelCase(t *testing.T) {
 		})
 	}
 }
+
+func TestToLowerCamelCase(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Value         string
+		ExpectedValue string
+	}{
+		{
+			TestName:      "empty string",
+			Value:         "",
+			ExpectedValue: "",
+		},
+		{
+			TestName:      "multiple words",
+			Value:         "health_check_config",
+			ExpectedValue: "healthCheckConfig",
+		},
+		{
+			TestName:      "ID",
+			Value:         "id",
+			ExpectedValue: "id",
+		},
+		{
+			TestName:      "something ID",
+			Value:         "vpc_id",
+			ExpectedValue: "vpcID",
+		},
+		{
+			TestName:      "ARN",
+			Value:         "arn",
+			ExpectedValue: "arn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := naming.ToLowerCamelCase(testCase.Value)
+
+			if got != testCase.ExpectedValue {
+				t.Errorf("expected: %s, got: %s", testCase.ExpectedValue, got)
+			}
+		})
+	}
+}